@@ -28,8 +28,10 @@ import (
 	tcli "github.com/traefik/traefik/v3/pkg/cli"
 	"github.com/traefik/traefik/v3/pkg/collector"
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/config/history"
 	"github.com/traefik/traefik/v3/pkg/config/runtime"
 	"github.com/traefik/traefik/v3/pkg/config/static"
+	"github.com/traefik/traefik/v3/pkg/geoip"
 	"github.com/traefik/traefik/v3/pkg/middlewares/accesslog"
 	"github.com/traefik/traefik/v3/pkg/observability/logs"
 	"github.com/traefik/traefik/v3/pkg/observability/metrics"
@@ -37,6 +39,7 @@ import (
 	otypes "github.com/traefik/traefik/v3/pkg/observability/types"
 	"github.com/traefik/traefik/v3/pkg/provider/acme"
 	"github.com/traefik/traefik/v3/pkg/provider/aggregator"
+	"github.com/traefik/traefik/v3/pkg/provider/snapshot"
 	"github.com/traefik/traefik/v3/pkg/provider/tailscale"
 	"github.com/traefik/traefik/v3/pkg/provider/traefik"
 	"github.com/traefik/traefik/v3/pkg/proxy"
@@ -202,6 +205,18 @@ func setupServer(staticConfiguration *static.Configuration) (*server.Server, err
 
 	tsProviders := initTailscaleProviders(staticConfiguration, providerAggregator)
 
+	// GeoIP
+
+	var geoIP *geoip.GeoIP
+	if staticConfiguration.GeoIP != nil {
+		geoIP, err = geoip.New(staticConfiguration.GeoIP.CountryDatabase, staticConfiguration.GeoIP.ASNDatabase, staticConfiguration.GeoIP.IPStrategy)
+		if err != nil {
+			return nil, fmt.Errorf("initializing GeoIP: %w", err)
+		}
+
+		routinesPool.GoCtx(geoIP.Watch)
+	}
+
 	// Observability
 
 	metricRegistries := registerMetricClients(staticConfiguration.Metrics)
@@ -213,7 +228,14 @@ func setupServer(staticConfiguration *static.Configuration) (*server.Server, err
 		}
 	}
 	metricsRegistry := metrics.NewMultiRegistry(metricRegistries)
+	if staticConfiguration.CSPReport != nil {
+		staticConfiguration.CSPReport.SetCounter(metricsRegistry.CSPViolationsCounter())
+	}
+
 	accessLog := setupAccessLog(ctx, staticConfiguration.AccessLog)
+	if accessLog != nil && geoIP != nil {
+		accessLog.SetGeoIP(geoIP)
+	}
 	tracer, tracerCloser := setupTracing(ctx, staticConfiguration.Tracing)
 	observabilityMgr := middleware.NewObservabilityMgr(*staticConfiguration, metricsRegistry, semConvMetricRegistry, accessLog, tracer, tracerCloser)
 
@@ -298,16 +320,37 @@ func setupServer(staticConfiguration *static.Configuration) (*server.Server, err
 
 	var proxyBuilder service.ProxyBuilder = httputil.NewProxyBuilder(transportManager, semConvMetricRegistry)
 	if staticConfiguration.Experimental != nil && staticConfiguration.Experimental.FastProxy != nil {
-		proxyBuilder = proxy.NewSmartBuilder(transportManager, proxyBuilder, *staticConfiguration.Experimental.FastProxy)
+		proxyBuilder = proxy.NewSmartBuilder(transportManager, proxyBuilder, *staticConfiguration.Experimental.FastProxy, semConvMetricRegistry)
 	}
 
 	dialerManager := tcp.NewDialerManager(spiffeX509Source)
 	acmeHTTPHandler := getHTTPChallengeHandler(acmeProviders, httpChallengeProvider)
-	managerFactory := service.NewManagerFactory(*staticConfiguration, routinesPool, observabilityMgr, transportManager, proxyBuilder, acmeHTTPHandler, tlsManager)
+
+	// Configuration snapshots and history
+
+	var snapshotStore *snapshot.Store
+	if opts := staticConfiguration.Providers.Snapshot; opts != nil {
+		snapshotStore, err = snapshot.NewStore(opts.Directory, opts.Providers)
+		if err != nil {
+			return nil, fmt.Errorf("creating configuration snapshots store: %w", err)
+		}
+
+		snapshotStore.SetGauge(metricsRegistry.ConfigSnapshotTimestampGauge())
+	}
+
+	var historyStore *history.Store
+	if staticConfiguration.API != nil && staticConfiguration.API.History != nil {
+		historyStore, err = history.NewStore(staticConfiguration.API.History.Size)
+		if err != nil {
+			return nil, fmt.Errorf("creating configuration history: %w", err)
+		}
+	}
+
+	managerFactory := service.NewManagerFactory(*staticConfiguration, routinesPool, observabilityMgr, transportManager, proxyBuilder, acmeHTTPHandler, tlsManager, historyStore, snapshotStore)
 
 	// Router factory
 
-	routerFactory, err := server.NewRouterFactory(*staticConfiguration, managerFactory, tlsManager, observabilityMgr, pluginBuilder, dialerManager)
+	routerFactory, err := server.NewRouterFactory(*staticConfiguration, managerFactory, tlsManager, observabilityMgr, pluginBuilder, dialerManager, geoIP)
 	if err != nil {
 		return nil, fmt.Errorf("creating router factory: %w", err)
 	}
@@ -321,6 +364,16 @@ func setupServer(staticConfiguration *static.Configuration) (*server.Server, err
 		"internal",
 	)
 
+	watcher.AddTransformer(server.NewOverlayTransformer(staticConfiguration.Providers.Precedence, getDefaultsEntrypoints(staticConfiguration)))
+
+	if snapshotStore != nil {
+		watcher.SetSnapshotStore(snapshotStore)
+	}
+
+	if historyStore != nil {
+		watcher.SetHistory(historyStore)
+	}
+
 	// TLS
 	watcher.AddListener(func(conf dynamic.Configuration) {
 		ctx := context.Background()
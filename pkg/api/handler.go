@@ -9,8 +9,11 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/rs/zerolog/log"
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/config/history"
 	"github.com/traefik/traefik/v3/pkg/config/runtime"
 	"github.com/traefik/traefik/v3/pkg/config/static"
+	"github.com/traefik/traefik/v3/pkg/middlewares/tap"
+	"github.com/traefik/traefik/v3/pkg/provider/snapshot"
 	"github.com/traefik/traefik/v3/pkg/tls"
 	"github.com/traefik/traefik/v3/pkg/version"
 )
@@ -61,12 +64,22 @@ type Handler struct {
 	runtimeConfiguration *runtime.Configuration
 
 	tlsManager *tls.Manager
+
+	tapHub *tap.Hub
+
+	history   *history.Store
+	snapshots *snapshot.Store
 }
 
 // NewBuilder returns a http.Handler builder based on runtime.Configuration.
-func NewBuilder(staticConfig static.Configuration, tlsManager *tls.Manager) func(*runtime.Configuration) http.Handler {
+func NewBuilder(staticConfig static.Configuration, tlsManager *tls.Manager, tapHub *tap.Hub, historyStore *history.Store, snapshotStore *snapshot.Store) func(*runtime.Configuration) http.Handler {
 	return func(configuration *runtime.Configuration) http.Handler {
-		return New(staticConfig, configuration).WithTLSManager(tlsManager).createRouter()
+		return New(staticConfig, configuration).
+			WithTLSManager(tlsManager).
+			WithTapHub(tapHub).
+			WithHistory(historyStore).
+			WithSnapshots(snapshotStore).
+			createRouter()
 	}
 }
 
@@ -90,6 +103,24 @@ func (h *Handler) WithTLSManager(tlsManager *tls.Manager) *Handler {
 	return h
 }
 
+// WithTapHub sets the request tap hub on the handler, enabling the tap API endpoint.
+func (h *Handler) WithTapHub(tapHub *tap.Hub) *Handler {
+	h.tapHub = tapHub
+	return h
+}
+
+// WithHistory sets the configuration history on the handler, enabling the history API endpoints.
+func (h *Handler) WithHistory(store *history.Store) *Handler {
+	h.history = store
+	return h
+}
+
+// WithSnapshots sets the configuration snapshots store on the handler, enabling the snapshots API endpoint.
+func (h *Handler) WithSnapshots(store *snapshot.Store) *Handler {
+	h.snapshots = store
+	return h
+}
+
 // createRouter creates API routes and router.
 func (h *Handler) createRouter() *mux.Router {
 	router := mux.NewRouter().UseEncodedPath()
@@ -132,6 +163,17 @@ func (h *Handler) createRouter() *mux.Router {
 	apiRouter.Methods(http.MethodGet).Path("/api/certificates").HandlerFunc(h.getCertificates)
 	apiRouter.Methods(http.MethodGet).Path("/api/certificates/{certificateID}").HandlerFunc(h.getCertificate)
 
+	apiRouter.Methods(http.MethodGet).Path("/api/tap").HandlerFunc(h.getTap)
+
+	apiRouter.Methods(http.MethodGet).Path("/api/providers/git").HandlerFunc(h.getGitRevision)
+	apiRouter.Methods(http.MethodGet).Path("/api/providers/snapshots").HandlerFunc(h.getSnapshots)
+
+	apiRouter.Methods(http.MethodGet).Path("/api/config/history").HandlerFunc(h.getHistory)
+	apiRouter.Methods(http.MethodGet).Path("/api/config/history/{entryID}/diff").HandlerFunc(h.getHistoryDiff)
+	apiRouter.Methods(http.MethodGet).Path("/api/config/pins").HandlerFunc(h.getPins)
+	apiRouter.Methods(http.MethodPut).Path("/api/config/pins/{providerName}").HandlerFunc(h.putPin)
+	apiRouter.Methods(http.MethodDelete).Path("/api/config/pins/{providerName}").HandlerFunc(h.deletePin)
+
 	version.Handler{}.Append(apiRouter)
 
 	return router
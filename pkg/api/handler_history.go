@@ -0,0 +1,141 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/config/history"
+)
+
+type pinRequest struct {
+	ID uint64 `json:"id"`
+}
+
+// getHistory returns the history of the applied configurations, the most recent first.
+func (h *Handler) getHistory(rw http.ResponseWriter, request *http.Request) {
+	store := h.history
+	if store == nil {
+		writeError(rw, "configuration history is not enabled", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(rw, request, store.Entries())
+}
+
+// getHistoryDiff returns the changes introduced by an applied configuration.
+func (h *Handler) getHistoryDiff(rw http.ResponseWriter, request *http.Request) {
+	store := h.history
+	if store == nil {
+		writeError(rw, "configuration history is not enabled", http.StatusNotFound)
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(request)["entryID"], 10, 64)
+	if err != nil {
+		writeError(rw, fmt.Sprintf("invalid history entry ID: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	changes, err := store.Diff(id)
+	if err != nil {
+		writeError(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if changes == nil {
+		changes = []history.Change{}
+	}
+
+	writeJSON(rw, request, changes)
+}
+
+// getPins returns the providers pinned to a previous configuration.
+func (h *Handler) getPins(rw http.ResponseWriter, request *http.Request) {
+	store := h.history
+	if store == nil {
+		writeError(rw, "configuration history is not enabled", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(rw, request, store.Pins())
+}
+
+// putPin pins a provider to the configuration it had in a history entry.
+func (h *Handler) putPin(rw http.ResponseWriter, request *http.Request) {
+	store, providerName, ok := h.pinTarget(rw, request)
+	if !ok {
+		return
+	}
+
+	var pin pinRequest
+	if err := json.NewDecoder(request.Body).Decode(&pin); err != nil {
+		writeError(rw, fmt.Sprintf("invalid pin request: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	err := store.Pin(providerName, pin.ID)
+	switch {
+	case errors.Is(err, history.ErrEntryNotFound):
+		writeError(rw, err.Error(), http.StatusNotFound)
+		return
+	case err != nil:
+		writeError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writeJSON(rw, request, store.Pins())
+}
+
+// deletePin releases a pinned provider.
+func (h *Handler) deletePin(rw http.ResponseWriter, request *http.Request) {
+	store, providerName, ok := h.pinTarget(rw, request)
+	if !ok {
+		return
+	}
+
+	if err := store.Unpin(providerName); err != nil {
+		writeError(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	writeJSON(rw, request, store.Pins())
+}
+
+func (h *Handler) pinTarget(rw http.ResponseWriter, request *http.Request) (*history.Store, string, bool) {
+	store := h.history
+	if store == nil {
+		writeError(rw, "configuration history is not enabled", http.StatusNotFound)
+		return nil, "", false
+	}
+
+	if h.staticConfig.API == nil || h.staticConfig.API.History == nil || !h.staticConfig.API.History.AllowPin {
+		writeError(rw, "pinning providers is not allowed", http.StatusForbidden)
+		return nil, "", false
+	}
+
+	scapedProviderName := mux.Vars(request)["providerName"]
+
+	providerName, err := url.PathUnescape(scapedProviderName)
+	if err != nil {
+		writeError(rw, fmt.Sprintf("unable to decode providerName %q: %s", scapedProviderName, err), http.StatusBadRequest)
+		return nil, "", false
+	}
+
+	return store, providerName, true
+}
+
+func writeJSON(rw http.ResponseWriter, request *http.Request, data any) {
+	rw.Header().Set("Content-Type", "application/json")
+
+	err := json.NewEncoder(rw).Encode(data)
+	if err != nil {
+		log.Ctx(request.Context()).Error().Err(err).Send()
+		writeError(rw, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/config/history"
+	"github.com/traefik/traefik/v3/pkg/config/static"
+)
+
+func TestHandler_History(t *testing.T) {
+	newStore := func(t *testing.T) *history.Store {
+		t.Helper()
+
+		store, err := history.NewStore(10)
+		require.NoError(t, err)
+
+		conf := &dynamic.Configuration{
+			HTTP: &dynamic.HTTPConfiguration{
+				Routers: map[string]*dynamic.Router{"foo": {Service: "bar", Rule: "Host(`foo`)"}},
+			},
+		}
+		store.Record([]string{"file"}, dynamic.Configurations{"file": conf}, conf)
+
+		return store
+	}
+
+	testCases := []struct {
+		desc           string
+		store          *history.Store
+		allowPin       bool
+		method         string
+		path           string
+		body           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			desc:           "history not enabled",
+			method:         http.MethodGet,
+			path:           "/api/config/history",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			desc:           "diff",
+			store:          newStore(t),
+			method:         http.MethodGet,
+			path:           "/api/config/history/1/diff",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `[{"section":"http.routers","name":"foo","action":"added","after":{"service":"bar","rule":"Host(` + "`foo`" + `)"}}]`,
+		},
+		{
+			desc:           "diff of an unknown entry",
+			store:          newStore(t),
+			method:         http.MethodGet,
+			path:           "/api/config/history/42/diff",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			desc:           "diff of an invalid entry ID",
+			store:          newStore(t),
+			method:         http.MethodGet,
+			path:           "/api/config/history/foo/diff",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			desc:           "pin not allowed",
+			store:          newStore(t),
+			method:         http.MethodPut,
+			path:           "/api/config/pins/file",
+			body:           `{"id":1}`,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "pin",
+			store:          newStore(t),
+			allowPin:       true,
+			method:         http.MethodPut,
+			path:           "/api/config/pins/file",
+			body:           `{"id":1}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "pin of an unknown provider",
+			store:          newStore(t),
+			allowPin:       true,
+			method:         http.MethodPut,
+			path:           "/api/config/pins/docker",
+			body:           `{"id":1}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			desc:           "unpin of a provider not pinned",
+			store:          newStore(t),
+			allowPin:       true,
+			method:         http.MethodDelete,
+			path:           "/api/config/pins/file",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			conf := static.Configuration{
+				API:    &static.API{History: &static.History{Size: 10, AllowPin: test.allowPin}},
+				Global: &static.Global{},
+			}
+
+			handler := New(conf, nil).WithHistory(test.store)
+			server := httptest.NewServer(handler.createRouter())
+			t.Cleanup(server.Close)
+
+			req, err := http.NewRequest(test.method, server.URL+test.path, strings.NewReader(test.body))
+			require.NoError(t, err)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, test.expectedStatus, resp.StatusCode)
+
+			if test.expectedBody != "" {
+				var body json.RawMessage
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+				assert.JSONEq(t, test.expectedBody, string(body))
+			}
+		})
+	}
+}
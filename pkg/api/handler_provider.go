@@ -0,0 +1,46 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+)
+
+// getGitRevision returns the repository revision the git provider configuration comes from.
+func (h *Handler) getGitRevision(rw http.ResponseWriter, request *http.Request) {
+	if h.staticConfig.Providers == nil || h.staticConfig.Providers.Git == nil {
+		writeError(rw, "git provider is not enabled", http.StatusNotFound)
+		return
+	}
+
+	revision := h.staticConfig.Providers.Git.Revision()
+	if revision == nil {
+		writeError(rw, "no configuration has been loaded from the git repository yet", http.StatusNotFound)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+
+	err := json.NewEncoder(rw).Encode(revision)
+	if err != nil {
+		log.Ctx(request.Context()).Error().Err(err).Send()
+		writeError(rw, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// getSnapshots returns the configuration snapshots of the providers, and whether they are still served.
+func (h *Handler) getSnapshots(rw http.ResponseWriter, request *http.Request) {
+	if h.snapshots == nil {
+		writeError(rw, "configuration snapshots are not enabled", http.StatusNotFound)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+
+	err := json.NewEncoder(rw).Encode(h.snapshots.Snapshots())
+	if err != nil {
+		log.Ctx(request.Context()).Error().Err(err).Send()
+		writeError(rw, err.Error(), http.StatusInternalServerError)
+	}
+}
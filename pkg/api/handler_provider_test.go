@@ -0,0 +1,104 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/config/static"
+	"github.com/traefik/traefik/v3/pkg/provider/git"
+	"github.com/traefik/traefik/v3/pkg/provider/snapshot"
+)
+
+func TestHandler_GitRevision(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		conf     static.Configuration
+		expected int
+	}{
+		{
+			desc:     "git provider not enabled",
+			conf:     static.Configuration{API: &static.API{}, Global: &static.Global{}},
+			expected: http.StatusNotFound,
+		},
+		{
+			desc: "no configuration loaded yet",
+			conf: static.Configuration{
+				API:       &static.API{},
+				Global:    &static.Global{},
+				Providers: &static.Providers{Git: &git.Provider{}},
+			},
+			expected: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			handler := New(test.conf, nil)
+			server := httptest.NewServer(handler.createRouter())
+			t.Cleanup(server.Close)
+
+			resp, err := http.Get(server.URL + "/api/providers/git")
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+
+			assert.Equal(t, test.expected, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandler_Snapshots(t *testing.T) {
+	store, err := snapshot.NewStore(t.TempDir(), []string{"consul"})
+	require.NoError(t, err)
+
+	store.Update(t.Context(), "consul", &dynamic.Configuration{HTTP: &dynamic.HTTPConfiguration{}})
+
+	testCases := []struct {
+		desc             string
+		store            *snapshot.Store
+		expectedStatus   int
+		expectedProvider string
+	}{
+		{
+			desc:           "snapshots not enabled",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			desc:             "snapshots enabled",
+			store:            store,
+			expectedStatus:   http.StatusOK,
+			expectedProvider: "consul",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			handler := New(static.Configuration{API: &static.API{}, Global: &static.Global{}}, nil).WithSnapshots(test.store)
+			server := httptest.NewServer(handler.createRouter())
+			t.Cleanup(server.Close)
+
+			resp, err := http.Get(server.URL + "/api/providers/snapshots")
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, test.expectedStatus, resp.StatusCode)
+			if test.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var infos []snapshot.Info
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&infos))
+			require.Len(t, infos, 1)
+			assert.Equal(t, test.expectedProvider, infos[0].Provider)
+			assert.False(t, infos[0].Serving)
+		})
+	}
+}
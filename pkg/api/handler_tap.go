@@ -0,0 +1,121 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/ip"
+	"github.com/traefik/traefik/v3/pkg/middlewares/tap"
+	"github.com/traefik/traefik/v3/pkg/types"
+	"golang.org/x/time/rate"
+)
+
+// getTap streams the summaries of the requests matching the query filters, as Server-Sent Events.
+func (h *Handler) getTap(rw http.ResponseWriter, request *http.Request) {
+	if h.tapHub == nil {
+		writeError(rw, "request tap is not available", http.StatusNotFound)
+		return
+	}
+
+	filter, limit, err := parseTapQuery(request.URL.Query())
+	if err != nil {
+		writeError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		writeError(rw, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	sub, err := h.tapHub.Subscribe(filter, limit)
+	if errors.Is(err, tap.ErrTooManySubscribers) {
+		writeError(rw, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+	if err != nil {
+		writeError(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer h.tapHub.Unsubscribe(sub)
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-request.Context().Done():
+			return
+		case summary, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+
+			data, err := json.Marshal(summary)
+			if err != nil {
+				log.Error().Err(err).Msg("Unable to encode request summary")
+				continue
+			}
+
+			if _, err := fmt.Fprintf(rw, "data: %s\n\n", data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// parseTapQuery builds the tap filter and rate limit from the query parameters.
+// The status and clientIP parameters accept comma separated values.
+func parseTapQuery(query url.Values) (tap.Filter, rate.Limit, error) {
+	filter := tap.Filter{Router: query.Get("router")}
+
+	if statuses := splitQueryValues(query["status"]); len(statuses) > 0 {
+		statusCodes, err := types.NewHTTPCodeRanges(statuses)
+		if err != nil {
+			return tap.Filter{}, 0, fmt.Errorf("invalid status filter: %w", err)
+		}
+		filter.StatusCodes = statusCodes
+	}
+
+	if clientIPs := splitQueryValues(query["clientIP"]); len(clientIPs) > 0 {
+		checker, err := ip.NewChecker(clientIPs)
+		if err != nil {
+			return tap.Filter{}, 0, fmt.Errorf("invalid clientIP filter: %w", err)
+		}
+		filter.ClientIP = checker
+	}
+
+	limit := rate.Limit(tap.MaxRate)
+	if value := query.Get("rate"); value != "" {
+		r, err := strconv.ParseFloat(value, 64)
+		if err != nil || r <= 0 {
+			return tap.Filter{}, 0, fmt.Errorf("invalid rate: %s", value)
+		}
+		limit = rate.Limit(r)
+	}
+
+	return filter, limit, nil
+}
+
+func splitQueryValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for v := range strings.SplitSeq(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				result = append(result, v)
+			}
+		}
+	}
+
+	return result
+}
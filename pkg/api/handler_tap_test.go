@@ -0,0 +1,108 @@
+package api
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/static"
+	"github.com/traefik/traefik/v3/pkg/middlewares/tap"
+)
+
+func TestHandler_Tap(t *testing.T) {
+	hub := tap.NewHub()
+
+	handler := New(static.Configuration{API: &static.API{}, Global: &static.Global{}}, nil).WithTapHub(hub)
+	server := httptest.NewServer(handler.createRouter())
+	t.Cleanup(server.Close)
+
+	resp, err := http.Get(server.URL + "/api/tap?router=foo@file&status=500-599")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	require.Eventually(t, hub.HasSubscribers, time.Second, 10*time.Millisecond)
+
+	hub.Publish(tap.Summary{Router: "bar@file", Status: http.StatusBadGateway})
+	hub.Publish(tap.Summary{Router: "foo@file", Status: http.StatusOK})
+	hub.Publish(tap.Summary{Router: "foo@file", Status: http.StatusBadGateway, Path: "/foo"})
+
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	require.NoError(t, err)
+
+	var summary tap.Summary
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &summary))
+	assert.Equal(t, "/foo", summary.Path)
+}
+
+func TestHandler_Tap_unavailable(t *testing.T) {
+	handler := New(static.Configuration{API: &static.API{}, Global: &static.Global{}}, nil)
+	server := httptest.NewServer(handler.createRouter())
+	t.Cleanup(server.Close)
+
+	resp, err := http.Get(server.URL + "/api/tap")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestParseTapQuery(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		query    string
+		expected float64
+		wantErr  bool
+	}{
+		{
+			desc:     "default rate",
+			query:    "router=foo@file",
+			expected: tap.MaxRate,
+		},
+		{
+			desc:     "custom rate",
+			query:    "rate=5&status=404,500-599&clientIP=10.0.0.0/8",
+			expected: 5,
+		},
+		{
+			desc:    "invalid rate",
+			query:   "rate=foo",
+			wantErr: true,
+		},
+		{
+			desc:    "invalid status",
+			query:   "status=foo",
+			wantErr: true,
+		},
+		{
+			desc:    "invalid client IP",
+			query:   "clientIP=foo",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/tap?"+test.query, nil)
+
+			_, limit, err := parseTapQuery(req.URL.Query())
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.InDelta(t, test.expected, float64(limit), 0)
+		})
+	}
+}
@@ -43,6 +43,7 @@ type HTTPConfiguration struct {
 	Middlewares       map[string]*Middleware       `json:"middlewares,omitempty" toml:"middlewares,omitempty" yaml:"middlewares,omitempty" export:"true"`
 	Models            map[string]*Model            `json:"models,omitempty" toml:"models,omitempty" yaml:"models,omitempty" export:"true"`
 	ServersTransports map[string]*ServersTransport `json:"serversTransports,omitempty" toml:"serversTransports,omitempty" yaml:"serversTransports,omitempty" label:"-" export:"true"`
+	Overlays          map[string]*Overlay          `json:"overlays,omitempty" toml:"overlays,omitempty" yaml:"overlays,omitempty" label:"-" export:"true"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -58,6 +59,26 @@ type Model struct {
 
 // +k8s:deepcopy-gen=true
 
+// Overlay holds the configuration applied on top of the routers matching its selector, whatever their provider.
+type Overlay struct {
+	Selector    OverlaySelector  `json:"selector,omitempty" toml:"selector,omitempty" yaml:"selector,omitempty" export:"true"`
+	Middlewares []string         `json:"middlewares,omitempty" toml:"middlewares,omitempty" yaml:"middlewares,omitempty" export:"true"`
+	TLS         *RouterTLSConfig `json:"tls,omitempty" toml:"tls,omitempty" yaml:"tls,omitempty" label:"allowEmpty" file:"allowEmpty" kv:"allowEmpty" export:"true"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// OverlaySelector selects the routers an overlay is applied to.
+// A router is selected when it matches all the defined criteria.
+type OverlaySelector struct {
+	Providers   []string          `json:"providers,omitempty" toml:"providers,omitempty" yaml:"providers,omitempty" export:"true"`
+	Routers     []string          `json:"routers,omitempty" toml:"routers,omitempty" yaml:"routers,omitempty" export:"true"`
+	EntryPoints []string          `json:"entryPoints,omitempty" toml:"entryPoints,omitempty" yaml:"entryPoints,omitempty" export:"true"`
+	Labels      map[string]string `json:"labels,omitempty" toml:"labels,omitempty" yaml:"labels,omitempty" export:"true"`
+}
+
+// +k8s:deepcopy-gen=true
+
 // Service holds a service configuration (can only be of one type at the same time).
 type Service struct {
 	Middlewares         []string             `json:"middlewares,omitempty" toml:"middlewares,omitempty" yaml:"middlewares,omitempty" export:"true"`
@@ -89,6 +110,8 @@ type Router struct {
 	Service     string   `json:"service,omitempty" toml:"service,omitempty" yaml:"service,omitempty" export:"true"`
 	Rule        string   `json:"rule,omitempty" toml:"rule,omitempty" yaml:"rule,omitempty"`
 	ParentRefs  []string `json:"parentRefs,omitempty" toml:"parentRefs,omitempty" yaml:"parentRefs,omitempty" label:"-" export:"true"`
+	// Labels are only used to select the router in overlays.
+	Labels map[string]string `json:"labels,omitempty" toml:"labels,omitempty" yaml:"labels,omitempty" export:"true"`
 	// Deprecated: Please do not use this field and rewrite the router rules to use the v3 syntax.
 	RuleSyntax                  string                             `json:"ruleSyntax,omitempty" toml:"ruleSyntax,omitempty" yaml:"ruleSyntax,omitempty" export:"true"`
 	Priority                    int                                `json:"priority,omitempty" toml:"priority,omitempty,omitzero" yaml:"priority,omitempty" export:"true"`
@@ -325,6 +348,18 @@ type GRPCStatus struct {
 type Sticky struct {
 	// Cookie defines the sticky cookie configuration.
 	Cookie *Cookie `json:"cookie,omitempty" toml:"cookie,omitempty" yaml:"cookie,omitempty" label:"allowEmpty" file:"allowEmpty" kv:"allowEmpty" export:"true"`
+	// Header defines the sticky header configuration.
+	// This option is exposed only for the Kubernetes Gateway API provider.
+	Header *StickyHeader `json:"header,omitempty" toml:"-" yaml:"-" label:"-" file:"-" kv:"-" export:"true"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// StickyHeader holds the sticky configuration based on a header.
+// The handler is selected from the request header, and the header is set on the response when a new handler is picked.
+type StickyHeader struct {
+	// Name defines the header name.
+	Name string `json:"name,omitempty" export:"true"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -356,6 +391,10 @@ type Cookie struct {
 	// Expires defines the number of seconds to add to the current time to calculate the expiration date of the cookie.
 	// This option is exposed only for the Ingress NGINX provider.
 	Expires int `json:"-" toml:"-" yaml:"-" label:"-" file:"-" kv:"-" export:"true"`
+	// IdleTimeout defines the number of seconds of inactivity after which the cookie expires.
+	// The cookie expiration is refreshed on every response.
+	// This option is exposed only for the Kubernetes Gateway API provider.
+	IdleTimeout int `json:"idleTimeout,omitempty" toml:"-" yaml:"-" label:"-" file:"-" kv:"-" export:"true"`
 }
 
 // SetDefaults set the default values for a Cookie.
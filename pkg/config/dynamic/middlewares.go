@@ -30,10 +30,17 @@ type Middleware struct {
 	// Deprecated: please use IPAllowList instead.
 	IPWhiteList       *IPWhiteList       `json:"ipWhiteList,omitempty" toml:"ipWhiteList,omitempty" yaml:"ipWhiteList,omitempty" export:"true"`
 	IPAllowList       *IPAllowList       `json:"ipAllowList,omitempty" toml:"ipAllowList,omitempty" yaml:"ipAllowList,omitempty" export:"true"`
+	IPDenyList        *IPDenyList        `json:"ipDenyList,omitempty" toml:"ipDenyList,omitempty" yaml:"ipDenyList,omitempty" export:"true"`
+	GeoIPFilter       *GeoIPFilter       `json:"geoIPFilter,omitempty" toml:"geoIPFilter,omitempty" yaml:"geoIPFilter,omitempty" export:"true"`
+	WAF               *WAF               `json:"waf,omitempty" toml:"waf,omitempty" yaml:"waf,omitempty" export:"true"`
+	CSRF              *CSRF              `json:"csrf,omitempty" toml:"csrf,omitempty" yaml:"csrf,omitempty" export:"true"`
+	HMACSignature     *HMACSignature     `json:"hmacSignature,omitempty" toml:"hmacSignature,omitempty" yaml:"hmacSignature,omitempty" export:"true"`
+	OpenAPIValidation *OpenAPIValidation `json:"openAPIValidation,omitempty" toml:"openAPIValidation,omitempty" yaml:"openAPIValidation,omitempty" export:"true"`
 	Headers           *Headers           `json:"headers,omitempty" toml:"headers,omitempty" yaml:"headers,omitempty" export:"true"`
 	EncodedCharacters *EncodedCharacters `json:"encodedCharacters,omitempty" toml:"encodedCharacters,omitempty" yaml:"encodedCharacters,omitempty" export:"true"`
 	Errors            *ErrorPage         `json:"errors,omitempty" toml:"errors,omitempty" yaml:"errors,omitempty" export:"true"`
 	RateLimit         *RateLimit         `json:"rateLimit,omitempty" toml:"rateLimit,omitempty" yaml:"rateLimit,omitempty" export:"true"`
+	Challenge         *Challenge         `json:"challenge,omitempty" toml:"challenge,omitempty" yaml:"challenge,omitempty" export:"true"`
 	RedirectRegex     *RedirectRegex     `json:"redirectRegex,omitempty" toml:"redirectRegex,omitempty" yaml:"redirectRegex,omitempty" export:"true"`
 	RedirectScheme    *RedirectScheme    `json:"redirectScheme,omitempty" toml:"redirectScheme,omitempty" yaml:"redirectScheme,omitempty" export:"true"`
 	BasicAuth         *BasicAuth         `json:"basicAuth,omitempty" toml:"basicAuth,omitempty" yaml:"basicAuth,omitempty" export:"true"`
@@ -41,6 +48,7 @@ type Middleware struct {
 	ForwardAuth       *ForwardAuth       `json:"forwardAuth,omitempty" toml:"forwardAuth,omitempty" yaml:"forwardAuth,omitempty" export:"true"`
 	InFlightReq       *InFlightReq       `json:"inFlightReq,omitempty" toml:"inFlightReq,omitempty" yaml:"inFlightReq,omitempty" export:"true"`
 	Buffering         *Buffering         `json:"buffering,omitempty" toml:"buffering,omitempty" yaml:"buffering,omitempty" export:"true"`
+	RequestLimits     *RequestLimits     `json:"requestLimits,omitempty" toml:"requestLimits,omitempty" yaml:"requestLimits,omitempty" export:"true"`
 	CircuitBreaker    *CircuitBreaker    `json:"circuitBreaker,omitempty" toml:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty" export:"true"`
 	Compress          *Compress          `json:"compress,omitempty" toml:"compress,omitempty" yaml:"compress,omitempty" label:"allowEmpty" file:"allowEmpty" kv:"allowEmpty" export:"true"`
 	PassTLSClientCert *PassTLSClientCert `json:"passTLSClientCert,omitempty" toml:"passTLSClientCert,omitempty" yaml:"passTLSClientCert,omitempty" export:"true"`
@@ -55,6 +63,7 @@ type Middleware struct {
 	ResponseHeaderModifier *HeaderModifier  `json:"responseHeaderModifier,omitempty" toml:"-" yaml:"-" label:"-" file:"-" kv:"-" export:"true"`
 	RequestRedirect        *RequestRedirect `json:"requestRedirect,omitempty" toml:"-" yaml:"-" label:"-" file:"-" kv:"-" export:"true"`
 	URLRewrite             *URLRewrite      `json:"URLRewrite,omitempty" toml:"-" yaml:"-" label:"-" file:"-" kv:"-" export:"true"`
+	RequestTimeout         *RequestTimeout  `json:"requestTimeout,omitempty" toml:"-" yaml:"-" label:"-" file:"-" kv:"-" export:"true"`
 
 	// ingress-nginx middlewares.
 	AuthTLSPassCertificateToUpstream *AuthTLSPassCertificateToUpstream `json:"authTLSPassCertificateToUpstream,omitempty" toml:"-" yaml:"-" label:"-" file:"-" kv:"-" export:"true"`
@@ -127,12 +136,12 @@ type BasicAuth struct {
 // This middleware retries or limits the size of requests that can be forwarded to backends.
 // More info: https://doc.traefik.io/traefik/v3.7/middlewares/http/buffering/#maxrequestbodybytes
 type Buffering struct {
-	// MaxRequestBodyBytes defines the maximum allowed body size for the request (in bytes).
+	// MaxRequestBodyBytes defines the maximum allowed body size for the request (in bytes), up to 1Gi.
 	// If the request exceeds the allowed size, it is not forwarded to the service, and the client gets a 413 (Request Entity Too Large) response.
-	// Default: 0 (no maximum).
+	// It overrides the SecRequestBodyLimit directive, which defaults to 134217728 (128Mi).
 	MaxRequestBodyBytes int64 `json:"maxRequestBodyBytes,omitempty" toml:"maxRequestBodyBytes,omitempty" yaml:"maxRequestBodyBytes,omitempty" export:"true"`
-	// MemRequestBodyBytes defines the threshold (in bytes) from which the request will be buffered on disk instead of in memory.
-	// Default: 1048576 (1Mi).
+	// MemRequestBodyBytes defines the threshold (in bytes) from which the request body is buffered on disk instead of in memory.
+	// It overrides the SecRequestBodyInMemoryLimit directive, which defaults to the maximum allowed body size.
 	MemRequestBodyBytes int64 `json:"memRequestBodyBytes,omitempty" toml:"memRequestBodyBytes,omitempty" yaml:"memRequestBodyBytes,omitempty" export:"true"`
 	// MaxResponseBodyBytes defines the maximum allowed response size from the service (in bytes).
 	// If the response exceeds the allowed size, it is not forwarded to the client. The client gets a 500 (Internal Server Error) response instead.
@@ -162,6 +171,66 @@ type Chain struct {
 
 // +k8s:deepcopy-gen=true
 
+// Challenge holds the challenge middleware configuration.
+// This middleware serves a proof-of-work challenge page to the clients without a valid clearance cookie,
+// and issues a signed clearance cookie to the clients solving it.
+type Challenge struct {
+	// Secret defines the key used to sign the challenges and the clearance cookies.
+	Secret string `json:"secret,omitempty" toml:"secret,omitempty" yaml:"secret,omitempty" loggable:"false"`
+	// Difficulty defines the number of leading zero bits required in the SHA-256 hash of a challenge solution.
+	// Each additional bit doubles the average work of the clients. 0 serves a JavaScript challenge without proof of work.
+	// Default: 16.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32
+	Difficulty *int `json:"difficulty,omitempty" toml:"difficulty,omitempty" yaml:"difficulty,omitempty" export:"true"`
+	// ClearanceTTL defines the duration for which a clearance cookie is valid.
+	// Default: 1h.
+	ClearanceTTL ptypes.Duration `json:"clearanceTTL,omitempty" toml:"clearanceTTL,omitempty" yaml:"clearanceTTL,omitempty" export:"true"`
+	// CookieName defines the name of the clearance cookie.
+	// If not set, the default is _traefik_clearance.
+	CookieName string `json:"cookieName,omitempty" toml:"cookieName,omitempty" yaml:"cookieName,omitempty" export:"true"`
+	// CookieDomain defines the host to which the clearance cookie will be sent.
+	CookieDomain string `json:"cookieDomain,omitempty" toml:"cookieDomain,omitempty" yaml:"cookieDomain,omitempty"`
+	// CookieSecure defines whether the clearance cookie can only be transmitted over an encrypted connection (i.e. HTTPS).
+	CookieSecure bool `json:"cookieSecure,omitempty" toml:"cookieSecure,omitempty" yaml:"cookieSecure,omitempty" export:"true"`
+	// SourceCriterion defines what criterion is used to group requests as originating from a common source.
+	// The clearance cookies are only valid for the source they were issued to.
+	// If several strategies are defined at the same time, an error will be raised.
+	// If none are set, the default is to use the request's remote address field (as an ipStrategy).
+	SourceCriterion *SourceCriterion `json:"sourceCriterion,omitempty" toml:"sourceCriterion,omitempty" yaml:"sourceCriterion,omitempty" export:"true"`
+	// Threshold defines the rate above which the requests of a source are challenged.
+	// If not set, the requests without a valid clearance cookie are always challenged.
+	Threshold *ChallengeThreshold `json:"threshold,omitempty" toml:"threshold,omitempty" yaml:"threshold,omitempty" export:"true"`
+}
+
+// SetDefaults sets the default values on a Challenge.
+func (c *Challenge) SetDefaults() {
+	c.ClearanceTTL = ptypes.Duration(time.Hour)
+}
+
+// +k8s:deepcopy-gen=true
+
+// ChallengeThreshold holds the rate above which the requests of a source are challenged.
+type ChallengeThreshold struct {
+	// Average is the maximum rate, by default in requests/s, of the requests of a source served without a challenge.
+	// The rate is actually defined by dividing Average by Period.
+	Average int64 `json:"average,omitempty" toml:"average,omitempty" yaml:"average,omitempty" export:"true"`
+	// Period, in combination with Average, defines the actual maximum rate, such as:
+	// r = Average / Period. It defaults to a second.
+	Period ptypes.Duration `json:"period,omitempty" toml:"period,omitempty" yaml:"period,omitempty" export:"true"`
+	// Burst is the maximum number of requests of a source served without a challenge in the same arbitrarily small period of time.
+	// It defaults to 1.
+	Burst int64 `json:"burst,omitempty" toml:"burst,omitempty" yaml:"burst,omitempty" export:"true"`
+}
+
+// SetDefaults sets the default values on a ChallengeThreshold.
+func (c *ChallengeThreshold) SetDefaults() {
+	c.Burst = 1
+	c.Period = ptypes.Duration(time.Second)
+}
+
+// +k8s:deepcopy-gen=true
+
 // CircuitBreaker holds the circuit breaker middleware configuration.
 // This middleware protects the system from stacking requests to unhealthy services, resulting in cascading failures.
 // More info: https://doc.traefik.io/traefik/v3.7/middlewares/http/circuitbreaker/
@@ -212,6 +281,62 @@ func (c *Compress) SetDefaults() {
 
 // +k8s:deepcopy-gen=true
 
+// CSRF holds the CSRF protection middleware configuration.
+// This middleware rejects the cross-site requests using state-changing methods,
+// based on the Fetch-Metadata, Origin and Referer headers, and optionally on a double-submit cookie token.
+type CSRF struct {
+	// AllowedOriginList defines the origins (scheme://host[:port]) allowed to send state-changing requests,
+	// in addition to the origin of the request itself.
+	AllowedOriginList []string `json:"allowedOriginList,omitempty" toml:"allowedOriginList,omitempty" yaml:"allowedOriginList,omitempty"`
+	// AllowedOriginListRegex defines the regular expressions of the origins allowed to send state-changing requests.
+	AllowedOriginListRegex []string `json:"allowedOriginListRegex,omitempty" toml:"allowedOriginListRegex,omitempty" yaml:"allowedOriginListRegex,omitempty"`
+	// AllowSameSite defines whether the requests sent from the same site, but from another origin, are allowed.
+	AllowSameSite bool `json:"allowSameSite,omitempty" toml:"allowSameSite,omitempty" yaml:"allowSameSite,omitempty" export:"true"`
+	// ExemptPaths defines the request paths which are not protected.
+	// A path ending with "*" matches all the paths starting with the given prefix.
+	ExemptPaths []string `json:"exemptPaths,omitempty" toml:"exemptPaths,omitempty" yaml:"exemptPaths,omitempty" export:"true"`
+	// Token defines the signed double-submit cookie token check.
+	// If not set, the token is not checked.
+	Token *CSRFToken `json:"token,omitempty" toml:"token,omitempty" yaml:"token,omitempty" label:"allowEmpty" file:"allowEmpty" kv:"allowEmpty" export:"true"`
+	// RejectStatusCode defines the HTTP status code used for refused requests.
+	// If not set, the default is 403 (Forbidden).
+	RejectStatusCode int `json:"rejectStatusCode,omitempty" toml:"rejectStatusCode,omitempty" yaml:"rejectStatusCode,omitempty" export:"true"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// CSRFToken holds the signed double-submit cookie token configuration.
+// The token is issued in a cookie, and must be sent back in a header or a form field with the state-changing requests.
+// The token is signed with a secret and bound to the session of the client.
+type CSRFToken struct {
+	// Secret defines the key used to sign the tokens.
+	Secret string `json:"secret,omitempty" toml:"secret,omitempty" yaml:"secret,omitempty" loggable:"false"`
+	// SessionCookieName defines the name of the cookie holding the session identifier the tokens are bound to.
+	SessionCookieName string `json:"sessionCookieName,omitempty" toml:"sessionCookieName,omitempty" yaml:"sessionCookieName,omitempty" export:"true"`
+	// CookieName defines the name of the cookie holding the token.
+	// If not set, the default is _csrf.
+	CookieName string `json:"cookieName,omitempty" toml:"cookieName,omitempty" yaml:"cookieName,omitempty" export:"true"`
+	// CookieDomain defines the host to which the cookie will be sent.
+	CookieDomain string `json:"cookieDomain,omitempty" toml:"cookieDomain,omitempty" yaml:"cookieDomain,omitempty"`
+	// CookiePath defines the path that must exist in the requested URL for the browser to send the cookie.
+	// If not set, the default is /.
+	CookiePath string `json:"cookiePath,omitempty" toml:"cookiePath,omitempty" yaml:"cookiePath,omitempty" export:"true"`
+	// CookieSecure defines whether the cookie can only be transmitted over an encrypted connection (i.e. HTTPS).
+	CookieSecure bool `json:"cookieSecure,omitempty" toml:"cookieSecure,omitempty" yaml:"cookieSecure,omitempty" export:"true"`
+	// CookieSameSite defines the same site policy of the cookie.
+	// If not set, the default is lax.
+	// +kubebuilder:validation:Enum=none;lax;strict;None;Lax;Strict
+	CookieSameSite string `json:"cookieSameSite,omitempty" toml:"cookieSameSite,omitempty" yaml:"cookieSameSite,omitempty" export:"true"`
+	// HeaderName defines the name of the request header holding the token.
+	// If not set, the default is X-CSRF-Token.
+	HeaderName string `json:"headerName,omitempty" toml:"headerName,omitempty" yaml:"headerName,omitempty" export:"true"`
+	// FormFieldName defines the name of the form field holding the token, used when the header is not set.
+	// If not set, the token is only read from the header.
+	FormFieldName string `json:"formFieldName,omitempty" toml:"formFieldName,omitempty" yaml:"formFieldName,omitempty" export:"true"`
+}
+
+// +k8s:deepcopy-gen=true
+
 // DigestAuth holds the digest auth middleware configuration.
 // This middleware restricts access to your services to known users.
 // More info: https://doc.traefik.io/traefik/v3.7/middlewares/http/digestauth/
@@ -399,9 +524,15 @@ type Headers struct {
 	// This overrides the BrowserXssFilter option.
 	CustomBrowserXSSValue string `json:"customBrowserXSSValue,omitempty" toml:"customBrowserXSSValue,omitempty" yaml:"customBrowserXSSValue,omitempty"`
 	// ContentSecurityPolicy defines the Content-Security-Policy header value.
+	// The $NONCE placeholder is replaced by a nonce generated for each request.
 	ContentSecurityPolicy string `json:"contentSecurityPolicy,omitempty" toml:"contentSecurityPolicy,omitempty" yaml:"contentSecurityPolicy,omitempty"`
 	// ContentSecurityPolicyReportOnly defines the Content-Security-Policy-Report-Only header value.
+	// The $NONCE placeholder is replaced by a nonce generated for each request.
 	ContentSecurityPolicyReportOnly string `json:"contentSecurityPolicyReportOnly,omitempty" toml:"contentSecurityPolicyReportOnly,omitempty" yaml:"contentSecurityPolicyReportOnly,omitempty"`
+	// ContentSecurityPolicyNonceHeader defines the name of the request header forwarding the generated nonce to the service.
+	ContentSecurityPolicyNonceHeader string `json:"contentSecurityPolicyNonceHeader,omitempty" toml:"contentSecurityPolicyNonceHeader,omitempty" yaml:"contentSecurityPolicyNonceHeader,omitempty"`
+	// ContentSecurityPolicyNoncePlaceholder defines the placeholder, written by the service in the HTML responses, replaced by the generated nonce.
+	ContentSecurityPolicyNoncePlaceholder string `json:"contentSecurityPolicyNoncePlaceholder,omitempty" toml:"contentSecurityPolicyNoncePlaceholder,omitempty" yaml:"contentSecurityPolicyNoncePlaceholder,omitempty"`
 	// PublicKey is the public key that implements HPKP to prevent MITM attacks with forged certificates.
 	PublicKey string `json:"publicKey,omitempty" toml:"publicKey,omitempty" yaml:"publicKey,omitempty"`
 	// ReferrerPolicy defines the Referrer-Policy header value.
@@ -466,6 +597,8 @@ func (h *Headers) HasSecureHeadersDefined() bool {
 		h.CustomBrowserXSSValue != "" ||
 		h.ContentSecurityPolicy != "" ||
 		h.ContentSecurityPolicyReportOnly != "" ||
+		h.ContentSecurityPolicyNonceHeader != "" ||
+		h.ContentSecurityPolicyNoncePlaceholder != "" ||
 		h.PublicKey != "" ||
 		h.ReferrerPolicy != "" ||
 		(h.FeaturePolicy != nil && *h.FeaturePolicy != "") ||
@@ -475,6 +608,70 @@ func (h *Headers) HasSecureHeadersDefined() bool {
 
 // +k8s:deepcopy-gen=true
 
+// HMACSignature holds the HMAC signature verification middleware configuration.
+// This middleware rejects the requests, or the signed URLs, which do not hold a valid and unexpired HMAC signature.
+type HMACSignature struct {
+	// Keys defines the keys used to verify the signatures.
+	// Several keys can be active at the same time, to rotate them.
+	Keys []HMACKey `json:"keys,omitempty" toml:"keys,omitempty" yaml:"keys,omitempty"`
+	// Algorithm defines the hash algorithm of the HMAC, among sha256, sha384 and sha512.
+	// If not set, the default is sha256.
+	// +kubebuilder:validation:Enum=sha256;sha384;sha512
+	Algorithm string `json:"algorithm,omitempty" toml:"algorithm,omitempty" yaml:"algorithm,omitempty" export:"true"`
+	// SignedComponents defines the components of the request included in the signed string, among method, path, query and body.
+	// If not set, the default is method, path and query.
+	SignedComponents []string `json:"signedComponents,omitempty" toml:"signedComponents,omitempty" yaml:"signedComponents,omitempty" export:"true"`
+	// SignedHeaders defines the request headers included in the signed string.
+	SignedHeaders []string `json:"signedHeaders,omitempty" toml:"signedHeaders,omitempty" yaml:"signedHeaders,omitempty" export:"true"`
+	// SignatureHeader defines the name of the request header holding the signature.
+	// If not set, the default is X-Signature.
+	SignatureHeader string `json:"signatureHeader,omitempty" toml:"signatureHeader,omitempty" yaml:"signatureHeader,omitempty" export:"true"`
+	// SignatureParam defines the name of the query parameter holding the signature, used when the header is not set.
+	// If not set, the default is signature.
+	SignatureParam string `json:"signatureParam,omitempty" toml:"signatureParam,omitempty" yaml:"signatureParam,omitempty" export:"true"`
+	// KeyIDHeader defines the name of the request header holding the ID of the key used to sign the request.
+	// If not set, the default is X-Signature-Key-Id.
+	KeyIDHeader string `json:"keyIdHeader,omitempty" toml:"keyIdHeader,omitempty" yaml:"keyIdHeader,omitempty" export:"true"`
+	// KeyIDParam defines the name of the query parameter holding the ID of the key used to sign the request, used when the header is not set.
+	// If not set, the default is keyId.
+	KeyIDParam string `json:"keyIdParam,omitempty" toml:"keyIdParam,omitempty" yaml:"keyIdParam,omitempty" export:"true"`
+	// ExpiresHeader defines the name of the request header holding the expiration time of the signature, as a Unix timestamp.
+	// If not set, the default is X-Signature-Expires.
+	ExpiresHeader string `json:"expiresHeader,omitempty" toml:"expiresHeader,omitempty" yaml:"expiresHeader,omitempty" export:"true"`
+	// ExpiresParam defines the name of the query parameter holding the expiration time of the signature, used when the header is not set.
+	// If not set, the default is expires.
+	ExpiresParam string `json:"expiresParam,omitempty" toml:"expiresParam,omitempty" yaml:"expiresParam,omitempty" export:"true"`
+	// ClockSkew defines the tolerated difference between the clocks of the signer and of Traefik.
+	// Default: 30s.
+	ClockSkew ptypes.Duration `json:"clockSkew,omitempty" toml:"clockSkew,omitempty" yaml:"clockSkew,omitempty" export:"true"`
+	// MaxLifetime defines the maximum duration between now and the expiration time of a signature.
+	// Default: 0 (no maximum).
+	MaxLifetime ptypes.Duration `json:"maxLifetime,omitempty" toml:"maxLifetime,omitempty" yaml:"maxLifetime,omitempty" export:"true"`
+	// MaxBodyBytes defines the maximum size of the signed request body (in bytes).
+	// Default: 10485760 (10Mi).
+	MaxBodyBytes int64 `json:"maxBodyBytes,omitempty" toml:"maxBodyBytes,omitempty" yaml:"maxBodyBytes,omitempty" export:"true"`
+	// RejectStatusCode defines the HTTP status code used for refused requests.
+	// If not set, the default is 401 (Unauthorized).
+	RejectStatusCode int `json:"rejectStatusCode,omitempty" toml:"rejectStatusCode,omitempty" yaml:"rejectStatusCode,omitempty" export:"true"`
+}
+
+// SetDefaults sets the default values on a HMACSignature.
+func (h *HMACSignature) SetDefaults() {
+	h.ClockSkew = ptypes.Duration(30 * time.Second)
+}
+
+// +k8s:deepcopy-gen=true
+
+// HMACKey holds a key used to verify HMAC signatures.
+type HMACKey struct {
+	// ID defines the identifier of the key, sent by the signer to select it.
+	ID string `json:"id,omitempty" toml:"id,omitempty" yaml:"id,omitempty" export:"true"`
+	// Secret defines the shared secret of the key.
+	Secret string `json:"secret,omitempty" toml:"secret,omitempty" yaml:"secret,omitempty" loggable:"false"`
+}
+
+// +k8s:deepcopy-gen=true
+
 // IPStrategy holds the IP strategy configuration used by Traefik to determine the client IP.
 // More info: https://doc.traefik.io/traefik/v3.7/middlewares/http/ipallowlist/#ipstrategy
 type IPStrategy struct {
@@ -556,6 +753,49 @@ type IPAllowList struct {
 
 // +k8s:deepcopy-gen=true
 
+// IPDenyList holds the IP denylist middleware configuration.
+// This middleware rejects requests based on the IP of the client,
+// loading the denied IPs from static ranges, files and URLs.
+type IPDenyList struct {
+	// SourceRange defines the set of denied IPs (or ranges of denied IPs by using CIDR notation).
+	SourceRange []string `json:"sourceRange,omitempty" toml:"sourceRange,omitempty" yaml:"sourceRange,omitempty"`
+	// Files defines the paths of local files listing denied IPs (or ranges of denied IPs by using CIDR notation), one per line.
+	Files []string `json:"files,omitempty" toml:"files,omitempty" yaml:"files,omitempty"`
+	// URLs defines the HTTP(S) URLs of lists of denied IPs (or ranges of denied IPs by using CIDR notation), one per line.
+	URLs []string `json:"urls,omitempty" toml:"urls,omitempty" yaml:"urls,omitempty"`
+	// RefreshInterval defines how often the files and URLs are reloaded.
+	// If not set, the default is 1h.
+	RefreshInterval ptypes.Duration `json:"refreshInterval,omitempty" toml:"refreshInterval,omitempty" yaml:"refreshInterval,omitempty" export:"true"`
+	IPStrategy      *IPStrategy     `json:"ipStrategy,omitempty" toml:"ipStrategy,omitempty" yaml:"ipStrategy,omitempty" label:"allowEmpty" file:"allowEmpty" kv:"allowEmpty" export:"true"`
+	// RejectStatusCode defines the HTTP status code used for refused requests.
+	// If not set, the default is 403 (Forbidden).
+	RejectStatusCode int `json:"rejectStatusCode,omitempty" toml:"rejectStatusCode,omitempty" yaml:"rejectStatusCode,omitempty" label:"allowEmpty" file:"allowEmpty" kv:"allowEmpty" export:"true"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// GeoIPFilter holds the GeoIP filter middleware configuration.
+// This middleware rejects requests based on the country and the autonomous system of the client IP,
+// resolved from the GeoIP databases of the static configuration.
+type GeoIPFilter struct {
+	// AllowedCountries defines the ISO 3166-1 alpha-2 codes of the allowed countries.
+	AllowedCountries []string `json:"allowedCountries,omitempty" toml:"allowedCountries,omitempty" yaml:"allowedCountries,omitempty"`
+	// DeniedCountries defines the ISO 3166-1 alpha-2 codes of the denied countries.
+	DeniedCountries []string `json:"deniedCountries,omitempty" toml:"deniedCountries,omitempty" yaml:"deniedCountries,omitempty"`
+	// AllowedASNs defines the numbers of the allowed autonomous systems.
+	AllowedASNs []uint64 `json:"allowedASNs,omitempty" toml:"allowedASNs,omitempty" yaml:"allowedASNs,omitempty"`
+	// DeniedASNs defines the numbers of the denied autonomous systems.
+	DeniedASNs []uint64 `json:"deniedASNs,omitempty" toml:"deniedASNs,omitempty" yaml:"deniedASNs,omitempty"`
+	// IPStrategy defines how the client IP is determined.
+	// If not set, the IP strategy of the GeoIP static configuration is used.
+	IPStrategy *IPStrategy `json:"ipStrategy,omitempty" toml:"ipStrategy,omitempty" yaml:"ipStrategy,omitempty" label:"allowEmpty" file:"allowEmpty" kv:"allowEmpty" export:"true"`
+	// RejectStatusCode defines the HTTP status code used for refused requests.
+	// If not set, the default is 403 (Forbidden).
+	RejectStatusCode int `json:"rejectStatusCode,omitempty" toml:"rejectStatusCode,omitempty" yaml:"rejectStatusCode,omitempty" label:"allowEmpty" file:"allowEmpty" kv:"allowEmpty" export:"true"`
+}
+
+// +k8s:deepcopy-gen=true
+
 // InFlightReq holds the in-flight request middleware configuration.
 // This middleware limits the number of requests being processed and served concurrently.
 // More info: https://doc.traefik.io/traefik/v3.7/middlewares/http/inflightreq/
@@ -573,6 +813,28 @@ type InFlightReq struct {
 
 // +k8s:deepcopy-gen=true
 
+// OpenAPIValidation holds the OpenAPI request validation middleware configuration.
+// This middleware validates the requests against the operations of an OpenAPI 3 document.
+type OpenAPIValidation struct {
+	// File defines the path to the OpenAPI 3 document, in JSON or YAML.
+	// Only the path of the servers of the document is used to match the requests.
+	File string `json:"file,omitempty" toml:"file,omitempty" yaml:"file,omitempty" export:"true"`
+	// AllowExternalRefs defines whether the document can reference other files,
+	// located in the directory of the document or in its subdirectories.
+	AllowExternalRefs bool `json:"allowExternalRefs,omitempty" toml:"allowExternalRefs,omitempty" yaml:"allowExternalRefs,omitempty" export:"true"`
+	// ReportOnly defines whether the invalid requests are only logged, and still forwarded to the service.
+	ReportOnly bool `json:"reportOnly,omitempty" toml:"reportOnly,omitempty" yaml:"reportOnly,omitempty" export:"true"`
+	// AllowUnknownOperations defines whether the requests which do not match any operation of the document are forwarded to the service.
+	AllowUnknownOperations bool `json:"allowUnknownOperations,omitempty" toml:"allowUnknownOperations,omitempty" yaml:"allowUnknownOperations,omitempty" export:"true"`
+	// MaxRequestBodyBytes defines the maximum size of the validated request bodies (in bytes).
+	// Larger requests are refused with a 413 (Request Entity Too Large) response,
+	// or forwarded without validating their body when ReportOnly is enabled.
+	// Default: 10485760 (10Mi).
+	MaxRequestBodyBytes int64 `json:"maxRequestBodyBytes,omitempty" toml:"maxRequestBodyBytes,omitempty" yaml:"maxRequestBodyBytes,omitempty" export:"true"`
+}
+
+// +k8s:deepcopy-gen=true
+
 // PassTLSClientCert holds the pass TLS client cert middleware configuration.
 // This middleware adds the selected data from the passed client TLS certificate to a header.
 // More info: https://doc.traefik.io/traefik/v3.7/middlewares/http/passtlsclientcert/
@@ -751,6 +1013,43 @@ type ReplacePathRegex struct {
 
 // +k8s:deepcopy-gen=true
 
+// RequestLimits holds the request limits middleware configuration.
+// This middleware rejects the requests exceeding the size and complexity limits of their headers, URL, and body.
+// A limit is disabled when not set, or set to 0.
+type RequestLimits struct {
+	// MaxHeaderCount defines the maximum number of request header values.
+	MaxHeaderCount int `json:"maxHeaderCount,omitempty" toml:"maxHeaderCount,omitempty" yaml:"maxHeaderCount,omitempty" export:"true"`
+	// MaxHeaderLength defines the maximum length of a request header (in bytes), name and value included.
+	MaxHeaderLength int `json:"maxHeaderLength,omitempty" toml:"maxHeaderLength,omitempty" yaml:"maxHeaderLength,omitempty" export:"true"`
+	// MaxURLLength defines the maximum length of the request URL (in bytes), path and query included.
+	MaxURLLength int `json:"maxURLLength,omitempty" toml:"maxURLLength,omitempty" yaml:"maxURLLength,omitempty" export:"true"`
+	// MaxQueryParams defines the maximum number of query parameters.
+	MaxQueryParams int `json:"maxQueryParams,omitempty" toml:"maxQueryParams,omitempty" yaml:"maxQueryParams,omitempty" export:"true"`
+	// MaxMultipartParts defines the maximum number of parts of the multipart request bodies.
+	MaxMultipartParts int `json:"maxMultipartParts,omitempty" toml:"maxMultipartParts,omitempty" yaml:"maxMultipartParts,omitempty" export:"true"`
+	// MaxJSONDepth defines the maximum nesting depth of the objects and arrays of the JSON request bodies.
+	MaxJSONDepth int `json:"maxJSONDepth,omitempty" toml:"maxJSONDepth,omitempty" yaml:"maxJSONDepth,omitempty" export:"true"`
+	// MaxJSONArrayLength defines the maximum number of elements of the arrays of the JSON request bodies.
+	MaxJSONArrayLength int `json:"maxJSONArrayLength,omitempty" toml:"maxJSONArrayLength,omitempty" yaml:"maxJSONArrayLength,omitempty" export:"true"`
+	// MaxRequestBodyBytes defines the maximum size (in bytes) of the inspected request bodies.
+	// Default: 10485760 (10Mi).
+	MaxRequestBodyBytes int64 `json:"maxRequestBodyBytes,omitempty" toml:"maxRequestBodyBytes,omitempty" yaml:"maxRequestBodyBytes,omitempty" export:"true"`
+	// MemRequestBodyBytes defines the threshold (in bytes) from which the inspected request bodies are buffered on disk instead of in memory.
+	// Default: 1048576 (1Mi).
+	MemRequestBodyBytes int64 `json:"memRequestBodyBytes,omitempty" toml:"memRequestBodyBytes,omitempty" yaml:"memRequestBodyBytes,omitempty" export:"true"`
+	// HeaderStatusCode defines the status code of the response to the requests exceeding the header limits.
+	// Default: 431 (Request Header Fields Too Large).
+	HeaderStatusCode int `json:"headerStatusCode,omitempty" toml:"headerStatusCode,omitempty" yaml:"headerStatusCode,omitempty" export:"true"`
+	// URLStatusCode defines the status code of the response to the requests exceeding the URL and query limits.
+	// Default: 414 (URI Too Long).
+	URLStatusCode int `json:"urlStatusCode,omitempty" toml:"urlStatusCode,omitempty" yaml:"urlStatusCode,omitempty" export:"true"`
+	// BodyStatusCode defines the status code of the response to the requests exceeding the body size, multipart and JSON limits.
+	// Default: 413 (Request Entity Too Large).
+	BodyStatusCode int `json:"bodyStatusCode,omitempty" toml:"bodyStatusCode,omitempty" yaml:"bodyStatusCode,omitempty" export:"true"`
+}
+
+// +k8s:deepcopy-gen=true
+
 // Retry holds the retry middleware configuration.
 // This middleware reissues requests a given number of times to a backend server if that server does not reply.
 // As soon as the server answers, the middleware stops retrying, regardless of the response status.
@@ -875,6 +1174,28 @@ type Users []string
 
 // +k8s:deepcopy-gen=true
 
+// WAF holds the web application firewall middleware configuration.
+// This middleware evaluates ModSecurity SecLang rule sets, such as the OWASP Core Rule Set, against the requests and their responses.
+type WAF struct {
+	// RuleFiles defines the paths, or glob patterns, of the files holding the SecLang directives.
+	RuleFiles []string `json:"ruleFiles,omitempty" toml:"ruleFiles,omitempty" yaml:"ruleFiles,omitempty"`
+	// Rules defines inline SecLang directives, evaluated after the ones of the rule files.
+	Rules string `json:"rules,omitempty" toml:"rules,omitempty" yaml:"rules,omitempty"`
+	// Mode defines whether the disruptive actions of the rules are applied (blocking), or only logged (detection).
+	// If not set, the mode is defined by the SecRuleEngine directive, and defaults to blocking.
+	Mode string `json:"mode,omitempty" toml:"mode,omitempty" yaml:"mode,omitempty" export:"true"`
+	// MaxRequestBodyBytes defines the maximum allowed body size for the request (in bytes).
+	// If the request exceeds the allowed size, it is not forwarded to the service, and the client gets a 413 (Request Entity Too Large) response.
+	// Default: 0 (no maximum).
+	MaxRequestBodyBytes int64 `json:"maxRequestBodyBytes,omitempty" toml:"maxRequestBodyBytes,omitempty" yaml:"maxRequestBodyBytes,omitempty" export:"true"`
+	// MemRequestBodyBytes defines the threshold (in bytes) from which the request body is buffered on disk instead of in memory.
+	// Only this first part of the body is inspected by the rules.
+	// Default: 1048576 (1Mi).
+	MemRequestBodyBytes int64 `json:"memRequestBodyBytes,omitempty" toml:"memRequestBodyBytes,omitempty" yaml:"memRequestBodyBytes,omitempty" export:"true"`
+}
+
+// +k8s:deepcopy-gen=true
+
 // HeaderModifier holds the request/response header modifier configuration.
 type HeaderModifier struct {
 	Set    map[string]string `json:"set,omitempty"`
@@ -905,6 +1226,14 @@ type URLRewrite struct {
 
 // +k8s:deepcopy-gen=true
 
+// RequestTimeout holds the request timeout middleware configuration.
+type RequestTimeout struct {
+	// Timeout defines the maximum duration allowed to handle the request.
+	Timeout ptypes.Duration `json:"timeout,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
 type Auth struct {
 	// Address defines the authentication server address.
 	Address string `json:"address,omitempty" toml:"address,omitempty" yaml:"address,omitempty"`
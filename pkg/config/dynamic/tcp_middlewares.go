@@ -1,5 +1,7 @@
 package dynamic
 
+import ptypes "github.com/traefik/paerser/types"
+
 // +k8s:deepcopy-gen=true
 
 // TCPMiddleware holds the TCPMiddleware configuration.
@@ -8,6 +10,7 @@ type TCPMiddleware struct {
 	// Deprecated: please use IPAllowList instead.
 	IPWhiteList *TCPIPWhiteList `json:"ipWhiteList,omitempty" toml:"ipWhiteList,omitempty" yaml:"ipWhiteList,omitempty" export:"true"`
 	IPAllowList *TCPIPAllowList `json:"ipAllowList,omitempty" toml:"ipAllowList,omitempty" yaml:"ipAllowList,omitempty" export:"true"`
+	IPDenyList  *TCPIPDenyList  `json:"ipDenyList,omitempty" toml:"ipDenyList,omitempty" yaml:"ipDenyList,omitempty" export:"true"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -42,3 +45,18 @@ type TCPIPAllowList struct {
 	// SourceRange defines the allowed IPs (or ranges of allowed IPs by using CIDR notation).
 	SourceRange []string `json:"sourceRange,omitempty" toml:"sourceRange,omitempty" yaml:"sourceRange,omitempty"`
 }
+
+// +k8s:deepcopy-gen=true
+
+// TCPIPDenyList holds the TCP IPDenyList middleware configuration.
+type TCPIPDenyList struct {
+	// SourceRange defines the denied IPs (or ranges of denied IPs by using CIDR notation).
+	SourceRange []string `json:"sourceRange,omitempty" toml:"sourceRange,omitempty" yaml:"sourceRange,omitempty"`
+	// Files defines the paths of local files listing denied IPs (or ranges of denied IPs by using CIDR notation), one per line.
+	Files []string `json:"files,omitempty" toml:"files,omitempty" yaml:"files,omitempty"`
+	// URLs defines the HTTP(S) URLs of lists of denied IPs (or ranges of denied IPs by using CIDR notation), one per line.
+	URLs []string `json:"urls,omitempty" toml:"urls,omitempty" yaml:"urls,omitempty"`
+	// RefreshInterval defines how often the files and URLs are reloaded.
+	// If not set, the default is 1h.
+	RefreshInterval ptypes.Duration `json:"refreshInterval,omitempty" toml:"refreshInterval,omitempty" yaml:"refreshInterval,omitempty" export:"true"`
+}
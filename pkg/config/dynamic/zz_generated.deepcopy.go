@@ -148,6 +148,58 @@ func (in *Buffering) DeepCopy() *Buffering {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSRF) DeepCopyInto(out *CSRF) {
+	*out = *in
+	if in.AllowedOriginList != nil {
+		in, out := &in.AllowedOriginList, &out.AllowedOriginList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedOriginListRegex != nil {
+		in, out := &in.AllowedOriginListRegex, &out.AllowedOriginListRegex
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExemptPaths != nil {
+		in, out := &in.ExemptPaths, &out.ExemptPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(CSRFToken)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRF.
+func (in *CSRF) DeepCopy() *CSRF {
+	if in == nil {
+		return nil
+	}
+	out := new(CSRF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSRFToken) DeepCopyInto(out *CSRFToken) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRFToken.
+func (in *CSRFToken) DeepCopy() *CSRFToken {
+	if in == nil {
+		return nil
+	}
+	out := new(CSRFToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chain) DeepCopyInto(out *Chain) {
 	*out = *in
@@ -169,6 +221,53 @@ func (in *Chain) DeepCopy() *Chain {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Challenge) DeepCopyInto(out *Challenge) {
+	*out = *in
+	if in.Difficulty != nil {
+		in, out := &in.Difficulty, &out.Difficulty
+		*out = new(int)
+		**out = **in
+	}
+	if in.SourceCriterion != nil {
+		in, out := &in.SourceCriterion, &out.SourceCriterion
+		*out = new(SourceCriterion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(ChallengeThreshold)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Challenge.
+func (in *Challenge) DeepCopy() *Challenge {
+	if in == nil {
+		return nil
+	}
+	out := new(Challenge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChallengeThreshold) DeepCopyInto(out *ChallengeThreshold) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChallengeThreshold.
+func (in *ChallengeThreshold) DeepCopy() *ChallengeThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(ChallengeThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
 	*out = *in
@@ -569,6 +668,47 @@ func (in *GRPCStatus) DeepCopy() *GRPCStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeoIPFilter) DeepCopyInto(out *GeoIPFilter) {
+	*out = *in
+	if in.AllowedCountries != nil {
+		in, out := &in.AllowedCountries, &out.AllowedCountries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedCountries != nil {
+		in, out := &in.DeniedCountries, &out.DeniedCountries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedASNs != nil {
+		in, out := &in.AllowedASNs, &out.AllowedASNs
+		*out = make([]uint64, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedASNs != nil {
+		in, out := &in.DeniedASNs, &out.DeniedASNs
+		*out = make([]uint64, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPStrategy != nil {
+		in, out := &in.IPStrategy, &out.IPStrategy
+		*out = new(IPStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeoIPFilter.
+func (in *GeoIPFilter) DeepCopy() *GeoIPFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(GeoIPFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrpcWeb) DeepCopyInto(out *GrpcWeb) {
 	*out = *in
@@ -590,6 +730,53 @@ func (in *GrpcWeb) DeepCopy() *GrpcWeb {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACKey) DeepCopyInto(out *HMACKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACKey.
+func (in *HMACKey) DeepCopy() *HMACKey {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACSignature) DeepCopyInto(out *HMACSignature) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]HMACKey, len(*in))
+		copy(*out, *in)
+	}
+	if in.SignedComponents != nil {
+		in, out := &in.SignedComponents, &out.SignedComponents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SignedHeaders != nil {
+		in, out := &in.SignedHeaders, &out.SignedHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACSignature.
+func (in *HMACSignature) DeepCopy() *HMACSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HRWService) DeepCopyInto(out *HRWService) {
 	*out = *in
@@ -690,6 +877,21 @@ func (in *HTTPConfiguration) DeepCopyInto(out *HTTPConfiguration) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.Overlays != nil {
+		in, out := &in.Overlays, &out.Overlays
+		*out = make(map[string]*Overlay, len(*in))
+		for key, val := range *in {
+			var outVal *Overlay
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(Overlay)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	return
 }
 
@@ -915,6 +1117,42 @@ func (in *IPAllowList) DeepCopy() *IPAllowList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPDenyList) DeepCopyInto(out *IPDenyList) {
+	*out = *in
+	if in.SourceRange != nil {
+		in, out := &in.SourceRange, &out.SourceRange
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.URLs != nil {
+		in, out := &in.URLs, &out.URLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPStrategy != nil {
+		in, out := &in.IPStrategy, &out.IPStrategy
+		*out = new(IPStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPDenyList.
+func (in *IPDenyList) DeepCopy() *IPDenyList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPDenyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPStrategy) DeepCopyInto(out *IPStrategy) {
 	*out = *in
@@ -1068,6 +1306,36 @@ func (in *Middleware) DeepCopyInto(out *Middleware) {
 		*out = new(IPAllowList)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IPDenyList != nil {
+		in, out := &in.IPDenyList, &out.IPDenyList
+		*out = new(IPDenyList)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GeoIPFilter != nil {
+		in, out := &in.GeoIPFilter, &out.GeoIPFilter
+		*out = new(GeoIPFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WAF != nil {
+		in, out := &in.WAF, &out.WAF
+		*out = new(WAF)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CSRF != nil {
+		in, out := &in.CSRF, &out.CSRF
+		*out = new(CSRF)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HMACSignature != nil {
+		in, out := &in.HMACSignature, &out.HMACSignature
+		*out = new(HMACSignature)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenAPIValidation != nil {
+		in, out := &in.OpenAPIValidation, &out.OpenAPIValidation
+		*out = new(OpenAPIValidation)
+		**out = **in
+	}
 	if in.Headers != nil {
 		in, out := &in.Headers, &out.Headers
 		*out = new(Headers)
@@ -1088,6 +1356,11 @@ func (in *Middleware) DeepCopyInto(out *Middleware) {
 		*out = new(RateLimit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Challenge != nil {
+		in, out := &in.Challenge, &out.Challenge
+		*out = new(Challenge)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RedirectRegex != nil {
 		in, out := &in.RedirectRegex, &out.RedirectRegex
 		*out = new(RedirectRegex)
@@ -1123,6 +1396,11 @@ func (in *Middleware) DeepCopyInto(out *Middleware) {
 		*out = new(Buffering)
 		**out = **in
 	}
+	if in.RequestLimits != nil {
+		in, out := &in.RequestLimits, &out.RequestLimits
+		*out = new(RequestLimits)
+		**out = **in
+	}
 	if in.CircuitBreaker != nil {
 		in, out := &in.CircuitBreaker, &out.CircuitBreaker
 		*out = new(CircuitBreaker)
@@ -1180,6 +1458,11 @@ func (in *Middleware) DeepCopyInto(out *Middleware) {
 		*out = new(URLRewrite)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RequestTimeout != nil {
+		in, out := &in.RequestTimeout, &out.RequestTimeout
+		*out = new(RequestTimeout)
+		**out = **in
+	}
 	if in.AuthTLSPassCertificateToUpstream != nil {
 		in, out := &in.AuthTLSPassCertificateToUpstream, &out.AuthTLSPassCertificateToUpstream
 		*out = new(AuthTLSPassCertificateToUpstream)
@@ -1323,6 +1606,87 @@ func (in *ObservabilityMetadata) DeepCopy() *ObservabilityMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenAPIValidation) DeepCopyInto(out *OpenAPIValidation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenAPIValidation.
+func (in *OpenAPIValidation) DeepCopy() *OpenAPIValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenAPIValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Overlay) DeepCopyInto(out *Overlay) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Middlewares != nil {
+		in, out := &in.Middlewares, &out.Middlewares
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(RouterTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Overlay.
+func (in *Overlay) DeepCopy() *Overlay {
+	if in == nil {
+		return nil
+	}
+	out := new(Overlay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverlaySelector) DeepCopyInto(out *OverlaySelector) {
+	*out = *in
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routers != nil {
+		in, out := &in.Routers, &out.Routers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EntryPoints != nil {
+		in, out := &in.EntryPoints, &out.EntryPoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverlaySelector.
+func (in *OverlaySelector) DeepCopy() *OverlaySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(OverlaySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PassTLSClientCert) DeepCopyInto(out *PassTLSClientCert) {
 	*out = *in
@@ -1512,6 +1876,22 @@ func (in *ReplacePathRegex) DeepCopy() *ReplacePathRegex {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestLimits) DeepCopyInto(out *RequestLimits) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestLimits.
+func (in *RequestLimits) DeepCopy() *RequestLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequestRedirect) DeepCopyInto(out *RequestRedirect) {
 	*out = *in
@@ -1553,6 +1933,22 @@ func (in *RequestRedirect) DeepCopy() *RequestRedirect {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestTimeout) DeepCopyInto(out *RequestTimeout) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestTimeout.
+func (in *RequestTimeout) DeepCopy() *RequestTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResponseForwarding) DeepCopyInto(out *ResponseForwarding) {
 	*out = *in
@@ -1630,6 +2026,13 @@ func (in *Router) DeepCopyInto(out *Router) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(RouterTLSConfig)
@@ -2021,6 +2424,11 @@ func (in *Sticky) DeepCopyInto(out *Sticky) {
 		*out = new(Cookie)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(StickyHeader)
+		**out = **in
+	}
 	return
 }
 
@@ -2034,6 +2442,22 @@ func (in *Sticky) DeepCopy() *Sticky {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StickyHeader) DeepCopyInto(out *StickyHeader) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StickyHeader.
+func (in *StickyHeader) DeepCopy() *StickyHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(StickyHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StripPrefix) DeepCopyInto(out *StripPrefix) {
 	*out = *in
@@ -2193,6 +2617,37 @@ func (in *TCPIPAllowList) DeepCopy() *TCPIPAllowList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPIPDenyList) DeepCopyInto(out *TCPIPDenyList) {
+	*out = *in
+	if in.SourceRange != nil {
+		in, out := &in.SourceRange, &out.SourceRange
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.URLs != nil {
+		in, out := &in.URLs, &out.URLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPIPDenyList.
+func (in *TCPIPDenyList) DeepCopy() *TCPIPDenyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPIPDenyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCPIPWhiteList) DeepCopyInto(out *TCPIPWhiteList) {
 	*out = *in
@@ -2248,6 +2703,11 @@ func (in *TCPMiddleware) DeepCopyInto(out *TCPMiddleware) {
 		*out = new(TCPIPAllowList)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IPDenyList != nil {
+		in, out := &in.IPDenyList, &out.IPDenyList
+		*out = new(TCPIPDenyList)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2865,6 +3325,27 @@ func (in Users) DeepCopy() Users {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAF) DeepCopyInto(out *WAF) {
+	*out = *in
+	if in.RuleFiles != nil {
+		in, out := &in.RuleFiles, &out.RuleFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAF.
+func (in *WAF) DeepCopy() *WAF {
+	if in == nil {
+		return nil
+	}
+	out := new(WAF)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WRRService) DeepCopyInto(out *WRRService) {
 	*out = *in
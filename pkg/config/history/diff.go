@@ -0,0 +1,129 @@
+package history
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// Change actions.
+const (
+	ActionAdded    = "added"
+	ActionRemoved  = "removed"
+	ActionModified = "modified"
+)
+
+// Change describes the change of a configuration element.
+type Change struct {
+	// Section is the kind of the changed element, e.g. http.routers.
+	Section string `json:"section"`
+	Name    string `json:"name"`
+	Action  string `json:"action"`
+	Before  any    `json:"before,omitempty"`
+	After   any    `json:"after,omitempty"`
+}
+
+// diff returns the changes between two merged configurations.
+// The servers transports and the TLS certificates and stores are not compared,
+// as they may hold private keys.
+func diff(before, after *dynamic.Configuration) []Change {
+	if before == nil {
+		before = &dynamic.Configuration{}
+	}
+	if after == nil {
+		after = &dynamic.Configuration{}
+	}
+
+	httpBefore, httpAfter := httpConfiguration(before), httpConfiguration(after)
+	tcpBefore, tcpAfter := tcpConfiguration(before), tcpConfiguration(after)
+	udpBefore, udpAfter := udpConfiguration(before), udpConfiguration(after)
+	tlsBefore, tlsAfter := tlsConfiguration(before), tlsConfiguration(after)
+
+	var changes []Change
+	changes = append(changes, diffSection("http.routers", httpBefore.Routers, httpAfter.Routers)...)
+	changes = append(changes, diffSection("http.middlewares", httpBefore.Middlewares, httpAfter.Middlewares)...)
+	changes = append(changes, diffSection("http.services", httpBefore.Services, httpAfter.Services)...)
+	changes = append(changes, diffSection("tcp.routers", tcpBefore.Routers, tcpAfter.Routers)...)
+	changes = append(changes, diffSection("tcp.middlewares", tcpBefore.Middlewares, tcpAfter.Middlewares)...)
+	changes = append(changes, diffSection("tcp.services", tcpBefore.Services, tcpAfter.Services)...)
+	changes = append(changes, diffSection("udp.routers", udpBefore.Routers, udpAfter.Routers)...)
+	changes = append(changes, diffSection("udp.services", udpBefore.Services, udpAfter.Services)...)
+	changes = append(changes, diffSection("tls.options", tlsBefore.Options, tlsAfter.Options)...)
+
+	return changes
+}
+
+func diffSection[T any](section string, before, after map[string]T) []Change {
+	names := make([]string, 0, len(before)+len(after))
+	for name := range before {
+		names = append(names, name)
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var changes []Change
+	for _, name := range names {
+		valueBefore, inBefore := before[name]
+		valueAfter, inAfter := after[name]
+
+		switch {
+		case !inBefore:
+			changes = append(changes, Change{Section: section, Name: name, Action: ActionAdded, After: valueAfter})
+		case !inAfter:
+			changes = append(changes, Change{Section: section, Name: name, Action: ActionRemoved, Before: valueBefore})
+		case !reflect.DeepEqual(valueBefore, valueAfter):
+			changes = append(changes, Change{Section: section, Name: name, Action: ActionModified, Before: valueBefore, After: valueAfter})
+		}
+	}
+
+	return changes
+}
+
+func summarize(changes []Change) Summary {
+	var summary Summary
+	for _, change := range changes {
+		switch change.Action {
+		case ActionAdded:
+			summary.Added++
+		case ActionRemoved:
+			summary.Removed++
+		case ActionModified:
+			summary.Modified++
+		}
+	}
+
+	return summary
+}
+
+func httpConfiguration(conf *dynamic.Configuration) *dynamic.HTTPConfiguration {
+	if conf.HTTP == nil {
+		return &dynamic.HTTPConfiguration{}
+	}
+	return conf.HTTP
+}
+
+func tcpConfiguration(conf *dynamic.Configuration) *dynamic.TCPConfiguration {
+	if conf.TCP == nil {
+		return &dynamic.TCPConfiguration{}
+	}
+	return conf.TCP
+}
+
+func udpConfiguration(conf *dynamic.Configuration) *dynamic.UDPConfiguration {
+	if conf.UDP == nil {
+		return &dynamic.UDPConfiguration{}
+	}
+	return conf.UDP
+}
+
+func tlsConfiguration(conf *dynamic.Configuration) *dynamic.TLSConfiguration {
+	if conf.TLS == nil {
+		return &dynamic.TLSConfiguration{}
+	}
+	return conf.TLS
+}
@@ -0,0 +1,237 @@
+package history
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/observability/logs"
+)
+
+// ErrEntryNotFound is returned when the requested entry is not, or no longer, in the history.
+var ErrEntryNotFound = errors.New("history entry not found")
+
+// ErrNotPinned is returned when unpinning a provider which is not pinned.
+var ErrNotPinned = errors.New("provider is not pinned")
+
+// Store keeps a bounded history of the applied configurations,
+// and allows to pin a provider to one of its previous configurations.
+type Store struct {
+	size int
+
+	mu         sync.RWMutex
+	entries    []*entry
+	lastID     uint64
+	pins       map[string]*pin
+	pinChanges chan struct{}
+}
+
+// Entry describes an applied configuration.
+type Entry struct {
+	ID        uint64    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	// Providers are the providers whose configuration change triggered the reload.
+	Providers []string `json:"providers"`
+	Changes   Summary  `json:"changes"`
+}
+
+// Summary counts the changes of an applied configuration.
+type Summary struct {
+	Added    int `json:"added"`
+	Removed  int `json:"removed"`
+	Modified int `json:"modified"`
+}
+
+// Pin describes a provider pinned to the configuration it had in a history entry.
+type Pin struct {
+	ID        uint64    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type entry struct {
+	Entry
+
+	configurations dynamic.Configurations
+	merged         *dynamic.Configuration
+	changes        []Change
+}
+
+type pin struct {
+	Pin
+
+	configuration *dynamic.Configuration
+}
+
+// NewStore creates a Store keeping at most size applied configurations.
+func NewStore(size int) (*Store, error) {
+	if size <= 0 {
+		return nil, errors.New("size must be greater than zero")
+	}
+
+	return &Store{
+		size:       size,
+		pins:       make(map[string]*pin),
+		pinChanges: make(chan struct{}, 1),
+	}, nil
+}
+
+// Record adds an applied configuration to the history.
+// The given configurations are the provider configurations the merged configuration is built from,
+// and must not be modified afterward.
+func (s *Store) Record(providers []string, configurations dynamic.Configurations, merged *dynamic.Configuration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var previous *dynamic.Configuration
+	if len(s.entries) > 0 {
+		previous = s.entries[len(s.entries)-1].merged
+	}
+
+	changes := diff(previous, merged)
+
+	s.lastID++
+	e := &entry{
+		Entry: Entry{
+			ID:        s.lastID,
+			Timestamp: time.Now().UTC(),
+			Providers: providers,
+			Changes:   summarize(changes),
+		},
+		configurations: configurations,
+		merged:         merged,
+		changes:        changes,
+	}
+
+	s.entries = append(s.entries, e)
+	if len(s.entries) > s.size {
+		s.entries = slices.Delete(s.entries, 0, len(s.entries)-s.size)
+	}
+}
+
+// Entries returns the entries of the history, the most recent first.
+func (s *Store) Entries() []Entry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := make([]Entry, 0, len(s.entries))
+	for i := len(s.entries) - 1; i >= 0; i-- {
+		entries = append(entries, s.entries[i].Entry)
+	}
+
+	return entries
+}
+
+// Diff returns the changes introduced by the given entry, compared to the previous applied configuration.
+func (s *Store) Diff(id uint64) ([]Change, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	e := s.entry(id)
+	if e == nil {
+		return nil, ErrEntryNotFound
+	}
+
+	return e.changes, nil
+}
+
+// Pin freezes the configuration of the given provider to the one it had in the given entry,
+// until the provider is unpinned.
+func (s *Store) Pin(providerName string, id uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.entry(id)
+	if e == nil {
+		return ErrEntryNotFound
+	}
+
+	configuration, ok := e.configurations[providerName]
+	if !ok {
+		return fmt.Errorf("provider %q has no configuration in history entry %d", providerName, id)
+	}
+
+	s.pins[providerName] = &pin{
+		Pin:           Pin{ID: id, Timestamp: e.Timestamp},
+		configuration: configuration,
+	}
+	s.notifyPinChange()
+
+	log.Warn().Str(logs.ProviderName, providerName).Msgf("Provider pinned to the configuration of history entry %d", id)
+
+	return nil
+}
+
+// Unpin releases the given provider, whose latest configuration is applied again.
+func (s *Store) Unpin(providerName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.pins[providerName]; !ok {
+		return ErrNotPinned
+	}
+
+	delete(s.pins, providerName)
+	s.notifyPinChange()
+
+	log.Info().Str(logs.ProviderName, providerName).Msg("Provider unpinned")
+
+	return nil
+}
+
+// Pins returns the pinned providers.
+func (s *Store) Pins() map[string]Pin {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	pins := make(map[string]Pin, len(s.pins))
+	for providerName, p := range s.pins {
+		pins[providerName] = p.Pin
+	}
+
+	return pins
+}
+
+// PinChanges returns a channel notified when a provider is pinned or unpinned.
+func (s *Store) PinChanges() <-chan struct{} {
+	return s.pinChanges
+}
+
+// ApplyPins returns the given configurations where the configuration of the pinned providers
+// is replaced by the configuration they are pinned to.
+func (s *Store) ApplyPins(configurations dynamic.Configurations) dynamic.Configurations {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.pins) == 0 {
+		return configurations
+	}
+
+	pinned := maps.Clone(configurations)
+	for providerName, p := range s.pins {
+		pinned[providerName] = p.configuration.DeepCopy()
+	}
+
+	return pinned
+}
+
+func (s *Store) entry(id uint64) *entry {
+	for _, e := range s.entries {
+		if e.ID == id {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) notifyPinChange() {
+	select {
+	case s.pinChanges <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,106 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestStore_Record(t *testing.T) {
+	store, err := NewStore(2)
+	require.NoError(t, err)
+
+	store.Record([]string{"file"}, dynamic.Configurations{"file": configuration("foo")}, configuration("foo"))
+	store.Record([]string{"file"}, dynamic.Configurations{"file": configuration("bar")}, configuration("bar"))
+	store.Record([]string{"docker", "file"}, dynamic.Configurations{"file": configuration("bar", "baz")}, configuration("bar", "baz"))
+
+	entries := store.Entries()
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, uint64(3), entries[0].ID)
+	assert.Equal(t, []string{"docker", "file"}, entries[0].Providers)
+	assert.Equal(t, Summary{Added: 1}, entries[0].Changes)
+
+	assert.Equal(t, uint64(2), entries[1].ID)
+	assert.Equal(t, Summary{Added: 1, Removed: 1}, entries[1].Changes)
+
+	_, err = store.Diff(1)
+	require.ErrorIs(t, err, ErrEntryNotFound)
+
+	changes, err := store.Diff(2)
+	require.NoError(t, err)
+
+	expected := []Change{
+		{Section: "http.routers", Name: "bar", Action: ActionAdded, After: &dynamic.Router{Service: "bar", Rule: "Host(`bar`)"}},
+		{Section: "http.routers", Name: "foo", Action: ActionRemoved, Before: &dynamic.Router{Service: "foo", Rule: "Host(`foo`)"}},
+	}
+	assert.Equal(t, expected, changes)
+}
+
+func TestStore_Pin(t *testing.T) {
+	store, err := NewStore(10)
+	require.NoError(t, err)
+
+	store.Record([]string{"file"}, dynamic.Configurations{"file": configuration("foo")}, configuration("foo"))
+	store.Record([]string{"file"}, dynamic.Configurations{"file": configuration("bar")}, configuration("bar"))
+
+	require.ErrorIs(t, store.Pin("file", 42), ErrEntryNotFound)
+	require.EqualError(t, store.Pin("docker", 1), `provider "docker" has no configuration in history entry 1`)
+	require.ErrorIs(t, store.Unpin("file"), ErrNotPinned)
+
+	require.NoError(t, store.Pin("file", 1))
+	assert.Len(t, store.PinChanges(), 1)
+	assert.Equal(t, map[string]Pin{"file": {ID: 1, Timestamp: store.Entries()[1].Timestamp}}, store.Pins())
+
+	current := dynamic.Configurations{"file": configuration("baz"), "docker": configuration("docker")}
+	pinned := store.ApplyPins(current)
+	assert.Equal(t, configuration("foo"), pinned["file"])
+	assert.Equal(t, configuration("docker"), pinned["docker"])
+	assert.Equal(t, configuration("baz"), current["file"])
+
+	require.NoError(t, store.Unpin("file"))
+	assert.Empty(t, store.Pins())
+	assert.Equal(t, current, store.ApplyPins(current))
+}
+
+func TestDiff(t *testing.T) {
+	before := configuration("foo", "bar")
+	before.HTTP.Services = map[string]*dynamic.Service{"svc": {}}
+
+	after := configuration("foo", "baz")
+	after.HTTP.Routers["foo"].Priority = 10
+	after.TCP = &dynamic.TCPConfiguration{Routers: map[string]*dynamic.TCPRouter{"tcp": {Rule: "HostSNI(`*`)"}}}
+
+	changes := diff(before, after)
+
+	var summary []string
+	for _, change := range changes {
+		summary = append(summary, change.Section+" "+change.Name+" "+change.Action)
+	}
+
+	expected := []string{
+		"http.routers bar removed",
+		"http.routers baz added",
+		"http.routers foo modified",
+		"http.services svc removed",
+		"tcp.routers tcp added",
+	}
+	assert.Equal(t, expected, summary)
+	assert.Empty(t, diff(after, after.DeepCopy()))
+}
+
+func configuration(routers ...string) *dynamic.Configuration {
+	conf := &dynamic.Configuration{
+		HTTP: &dynamic.HTTPConfiguration{
+			Routers: make(map[string]*dynamic.Router),
+		},
+	}
+
+	for _, name := range routers {
+		conf.HTTP.Routers[name] = &dynamic.Router{Service: name, Rule: "Host(`" + name + "`)"}
+	}
+
+	return conf
+}
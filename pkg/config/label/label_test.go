@@ -65,6 +65,8 @@ func TestDecodeConfiguration(t *testing.T) {
 		"traefik.http.middlewares.Middleware8.headers.browserxssfilter":                            "true",
 		"traefik.http.middlewares.Middleware8.headers.contentsecuritypolicy":                       "foobar",
 		"traefik.http.middlewares.Middleware8.headers.contentsecuritypolicyreportonly":             "foobar",
+		"traefik.http.middlewares.Middleware8.headers.contentsecuritypolicynonceheader":            "foobar",
+		"traefik.http.middlewares.Middleware8.headers.contentsecuritypolicynonceplaceholder":       "foobar",
 		"traefik.http.middlewares.Middleware8.headers.contenttypenosniff":                          "true",
 		"traefik.http.middlewares.Middleware8.headers.custombrowserxssvalue":                       "foobar",
 		"traefik.http.middlewares.Middleware8.headers.customframeoptionsvalue":                     "foobar",
@@ -647,23 +649,25 @@ func TestDecodeConfiguration(t *testing.T) {
 							"name0": "foobar",
 							"name1": "foobar",
 						},
-						SSLForceHost:                    new(true),
-						STSSeconds:                      new(int64(42)),
-						STSIncludeSubdomains:            true,
-						STSPreload:                      true,
-						ForceSTSHeader:                  true,
-						FrameDeny:                       true,
-						CustomFrameOptionsValue:         "foobar",
-						ContentTypeNosniff:              true,
-						BrowserXSSFilter:                true,
-						CustomBrowserXSSValue:           "foobar",
-						ContentSecurityPolicy:           "foobar",
-						ContentSecurityPolicyReportOnly: "foobar",
-						PublicKey:                       "foobar",
-						ReferrerPolicy:                  "foobar",
-						FeaturePolicy:                   new("foobar"),
-						PermissionsPolicy:               "foobar",
-						IsDevelopment:                   true,
+						SSLForceHost:                          new(true),
+						STSSeconds:                            new(int64(42)),
+						STSIncludeSubdomains:                  true,
+						STSPreload:                            true,
+						ForceSTSHeader:                        true,
+						FrameDeny:                             true,
+						CustomFrameOptionsValue:               "foobar",
+						ContentTypeNosniff:                    true,
+						BrowserXSSFilter:                      true,
+						CustomBrowserXSSValue:                 "foobar",
+						ContentSecurityPolicy:                 "foobar",
+						ContentSecurityPolicyReportOnly:       "foobar",
+						ContentSecurityPolicyNonceHeader:      "foobar",
+						ContentSecurityPolicyNoncePlaceholder: "foobar",
+						PublicKey:                             "foobar",
+						ReferrerPolicy:                        "foobar",
+						FeaturePolicy:                         new("foobar"),
+						PermissionsPolicy:                     "foobar",
+						IsDevelopment:                         true,
 					},
 				},
 				"Middleware9": {
@@ -1207,23 +1211,25 @@ func TestEncodeConfiguration(t *testing.T) {
 							"name0": "foobar",
 							"name1": "foobar",
 						},
-						SSLForceHost:                    new(true),
-						STSSeconds:                      new(int64(42)),
-						STSIncludeSubdomains:            true,
-						STSPreload:                      true,
-						ForceSTSHeader:                  true,
-						FrameDeny:                       true,
-						CustomFrameOptionsValue:         "foobar",
-						ContentTypeNosniff:              true,
-						BrowserXSSFilter:                true,
-						CustomBrowserXSSValue:           "foobar",
-						ContentSecurityPolicy:           "foobar",
-						ContentSecurityPolicyReportOnly: "foobar",
-						PublicKey:                       "foobar",
-						ReferrerPolicy:                  "foobar",
-						FeaturePolicy:                   new("foobar"),
-						PermissionsPolicy:               "foobar",
-						IsDevelopment:                   true,
+						SSLForceHost:                          new(true),
+						STSSeconds:                            new(int64(42)),
+						STSIncludeSubdomains:                  true,
+						STSPreload:                            true,
+						ForceSTSHeader:                        true,
+						FrameDeny:                             true,
+						CustomFrameOptionsValue:               "foobar",
+						ContentTypeNosniff:                    true,
+						BrowserXSSFilter:                      true,
+						CustomBrowserXSSValue:                 "foobar",
+						ContentSecurityPolicy:                 "foobar",
+						ContentSecurityPolicyReportOnly:       "foobar",
+						ContentSecurityPolicyNonceHeader:      "foobar",
+						ContentSecurityPolicyNoncePlaceholder: "foobar",
+						PublicKey:                             "foobar",
+						ReferrerPolicy:                        "foobar",
+						FeaturePolicy:                         new("foobar"),
+						PermissionsPolicy:                     "foobar",
+						IsDevelopment:                         true,
 					},
 				},
 				"Middleware9": {
@@ -1390,6 +1396,8 @@ func TestEncodeConfiguration(t *testing.T) {
 		"traefik.HTTP.Middlewares.Middleware8.Headers.BrowserXSSFilter":                            "true",
 		"traefik.HTTP.Middlewares.Middleware8.Headers.ContentSecurityPolicy":                       "foobar",
 		"traefik.HTTP.Middlewares.Middleware8.Headers.ContentSecurityPolicyReportOnly":             "foobar",
+		"traefik.HTTP.Middlewares.Middleware8.Headers.ContentSecurityPolicyNonceHeader":            "foobar",
+		"traefik.HTTP.Middlewares.Middleware8.Headers.ContentSecurityPolicyNoncePlaceholder":       "foobar",
 		"traefik.HTTP.Middlewares.Middleware8.Headers.ContentTypeNosniff":                          "true",
 		"traefik.HTTP.Middlewares.Middleware8.Headers.CustomBrowserXSSValue":                       "foobar",
 		"traefik.HTTP.Middlewares.Middleware8.Headers.CustomFrameOptionsValue":                     "foobar",
@@ -83,6 +83,7 @@ type HTTPConfig struct {
 	SanitizePath              *bool              `description:"Defines whether to enable request path sanitization (removal of /./, /../ and multiple slash sequences)." json:"sanitizePath,omitempty" toml:"sanitizePath,omitempty" yaml:"sanitizePath,omitempty" export:"true"`
 	MaxHeaderBytes            int                `description:"Maximum size of request headers in bytes." json:"maxHeaderBytes,omitempty" toml:"maxHeaderBytes,omitempty" yaml:"maxHeaderBytes,omitempty" export:"true"`
 	UnderscoreHeadersStrategy string             `description:"Defines the strategy to handle requests with headers with underscores (keep, delete, and reject)." json:"underscoreHeadersStrategy,omitempty" toml:"underscoreHeadersStrategy,omitempty" yaml:"underscoreHeadersStrategy,omitempty" export:"true"`
+	ClientFingerprint         *ClientFingerprint `description:"Client fingerprinting configuration." json:"clientFingerprint,omitempty" toml:"clientFingerprint,omitempty" yaml:"clientFingerprint,omitempty" label:"allowEmpty" file:"allowEmpty" export:"true"`
 }
 
 // SetDefaults sets the default values.
@@ -113,6 +114,13 @@ func (ec *EncodedCharacters) SetDefaults() {
 	ec.AllowEncodedHash = true
 }
 
+// ClientFingerprint configures the client fingerprints computed on an entry point,
+// in addition to the JA3 and JA4 fingerprints of the TLS connections.
+type ClientFingerprint struct {
+	HTTP2          bool `description:"Defines whether to compute the HTTP/2 fingerprint of the connections." json:"http2,omitempty" toml:"http2,omitempty" yaml:"http2,omitempty" export:"true"`
+	ForwardHeaders bool `description:"Defines whether to forward the client fingerprints to the services in the X-Forwarded-Tls-Client-Ja3, X-Forwarded-Tls-Client-Ja4, and X-Forwarded-Http2-Fingerprint headers." json:"forwardHeaders,omitempty" toml:"forwardHeaders,omitempty" yaml:"forwardHeaders,omitempty" export:"true"`
+}
+
 // HTTP2Config is the HTTP2 configuration of an entry point.
 type HTTP2Config struct {
 	MaxConcurrentStreams      int32 `description:"Specifies the number of concurrent streams per connection that each client is allowed to initiate." json:"maxConcurrentStreams,omitempty" toml:"maxConcurrentStreams,omitempty" yaml:"maxConcurrentStreams,omitempty" export:"true"`
@@ -0,0 +1,299 @@
+package cspreport
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// maxReportSize is the maximum size of the accepted report payloads.
+	maxReportSize = 16 * 1024
+	// maxReports is the maximum number of reports recorded from a single Reporting API payload.
+	maxReports = 10
+
+	// otherLabel is the metric label value of the unknown directives and dispositions,
+	// so that the clients cannot create an unbounded number of series.
+	otherLabel = "other"
+)
+
+// directives are the Content-Security-Policy directives which can be reported as violated.
+var directives = map[string]struct{}{
+	"base-uri":                  {},
+	"child-src":                 {},
+	"connect-src":               {},
+	"default-src":               {},
+	"fenced-frame-src":          {},
+	"font-src":                  {},
+	"form-action":               {},
+	"frame-ancestors":           {},
+	"frame-src":                 {},
+	"img-src":                   {},
+	"manifest-src":              {},
+	"media-src":                 {},
+	"object-src":                {},
+	"prefetch-src":              {},
+	"require-trusted-types-for": {},
+	"sandbox":                   {},
+	"script-src":                {},
+	"script-src-attr":           {},
+	"script-src-elem":           {},
+	"style-src":                 {},
+	"style-src-attr":            {},
+	"style-src-elem":            {},
+	"trusted-types":             {},
+	"webrtc":                    {},
+	"worker-src":                {},
+}
+
+// Handler exposes the Content-Security-Policy violation reporting endpoint.
+type Handler struct {
+	EntryPoint    string `description:"EntryPoint. If empty, the default entry points are used." json:"entryPoint,omitempty" toml:"entryPoint,omitempty" yaml:"entryPoint,omitempty" export:"true"`
+	Path          string `description:"Path of the reporting endpoint." json:"path,omitempty" toml:"path,omitempty" yaml:"path,omitempty" export:"true"`
+	ManualRouting bool   `description:"Manual routing" json:"manualRouting,omitempty" toml:"manualRouting,omitempty" yaml:"manualRouting,omitempty" export:"true"`
+
+	violations metrics.Counter
+}
+
+// SetDefaults sets the default values.
+func (h *Handler) SetDefaults() {
+	h.Path = "/csp-report"
+}
+
+// SetCounter sets the counter incremented for each reported violation.
+func (h *Handler) SetCounter(counter metrics.Counter) {
+	h.violations = counter
+}
+
+func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		rw.Header().Set("Allow", http.MethodPost)
+		http.Error(rw, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(rw, req.Body, maxReportSize))
+	if err != nil {
+		http.Error(rw, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+
+	var reports []report
+	switch mediaType {
+	case "application/csp-report", "application/json":
+		reports, err = parseCSPReport(body)
+	case "application/reports+json":
+		reports, err = parseReportingAPI(body)
+	default:
+		http.Error(rw, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	if err != nil {
+		log.Debug().Err(err).Msg("Invalid CSP report")
+		http.Error(rw, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	for _, r := range reports[:min(len(reports), maxReports)] {
+		h.record(req, r)
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) record(req *http.Request, r report) {
+	if r.Type != "csp-violation" {
+		log.Debug().
+			Str("type", r.Type).
+			Str("url", r.URL).
+			Str("userAgent", req.UserAgent()).
+			RawJSON("body", r.Body).
+			Msg("Browser report received")
+		return
+	}
+
+	directive := normalizeDirective(r.Violation)
+	disposition := normalizeDisposition(r.Violation.Disposition)
+
+	if h.violations != nil {
+		h.violations.With("directive", directive, "disposition", disposition).Add(1)
+	}
+
+	// The reports are sent by unauthenticated clients, so they are only logged at the debug level.
+	log.Debug().
+		Str("documentURL", r.Violation.DocumentURL).
+		Str("referrer", r.Violation.Referrer).
+		Str("blockedURL", r.Violation.BlockedURL).
+		Str("directive", directive).
+		Str("disposition", disposition).
+		Str("sourceFile", r.Violation.SourceFile).
+		Int("lineNumber", r.Violation.LineNumber).
+		Int("columnNumber", r.Violation.ColumnNumber).
+		Str("sample", r.Violation.Sample).
+		Str("userAgent", req.UserAgent()).
+		Func(func(e *zerolog.Event) {
+			if r.Violation.StatusCode != 0 {
+				e.Int("statusCode", r.Violation.StatusCode)
+			}
+		}).
+		Msg("Content-Security-Policy violation reported")
+}
+
+func normalizeDirective(v violation) string {
+	directive := v.EffectiveDirective
+	if directive == "" {
+		// The violated-directive field is deprecated in favor of effective-directive, but still sent by some browsers.
+		directive, _, _ = strings.Cut(v.ViolatedDirective, " ")
+	}
+
+	directive = strings.ToLower(directive)
+	if _, ok := directives[directive]; !ok {
+		return otherLabel
+	}
+
+	return directive
+}
+
+func normalizeDisposition(disposition string) string {
+	switch strings.ToLower(disposition) {
+	case "", "enforce":
+		return "enforce"
+	case "report":
+		return "report"
+	default:
+		return otherLabel
+	}
+}
+
+// report is a browser report, normalized from the legacy report-uri and the Reporting API formats.
+type report struct {
+	Type      string
+	URL       string
+	Body      json.RawMessage
+	Violation violation
+}
+
+type violation struct {
+	DocumentURL        string
+	Referrer           string
+	BlockedURL         string
+	EffectiveDirective string
+	ViolatedDirective  string
+	Disposition        string
+	SourceFile         string
+	Sample             string
+	StatusCode         int
+	LineNumber         int
+	ColumnNumber       int
+}
+
+// parseCSPReport parses the legacy reports sent to the report-uri directive endpoints.
+func parseCSPReport(body []byte) ([]report, error) {
+	var payload struct {
+		Report *struct {
+			DocumentURI        string `json:"document-uri"`
+			Referrer           string `json:"referrer"`
+			BlockedURI         string `json:"blocked-uri"`
+			EffectiveDirective string `json:"effective-directive"`
+			ViolatedDirective  string `json:"violated-directive"`
+			Disposition        string `json:"disposition"`
+			SourceFile         string `json:"source-file"`
+			ScriptSample       string `json:"script-sample"`
+			StatusCode         int    `json:"status-code"`
+			LineNumber         int    `json:"line-number"`
+			ColumnNumber       int    `json:"column-number"`
+		} `json:"csp-report"`
+	}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	if payload.Report == nil {
+		return nil, errors.New("missing csp-report field")
+	}
+
+	return []report{{
+		Type: "csp-violation",
+		URL:  payload.Report.DocumentURI,
+		Violation: violation{
+			DocumentURL:        payload.Report.DocumentURI,
+			Referrer:           payload.Report.Referrer,
+			BlockedURL:         payload.Report.BlockedURI,
+			EffectiveDirective: payload.Report.EffectiveDirective,
+			ViolatedDirective:  payload.Report.ViolatedDirective,
+			Disposition:        payload.Report.Disposition,
+			SourceFile:         payload.Report.SourceFile,
+			Sample:             payload.Report.ScriptSample,
+			StatusCode:         payload.Report.StatusCode,
+			LineNumber:         payload.Report.LineNumber,
+			ColumnNumber:       payload.Report.ColumnNumber,
+		},
+	}}, nil
+}
+
+// parseReportingAPI parses the reports sent by the Reporting API to the report-to directive endpoints.
+func parseReportingAPI(body []byte) ([]report, error) {
+	var payload []struct {
+		Type string          `json:"type"`
+		URL  string          `json:"url"`
+		Body json.RawMessage `json:"body"`
+	}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	reports := make([]report, 0, len(payload))
+	for _, p := range payload {
+		r := report{Type: p.Type, URL: p.URL, Body: p.Body}
+		if len(r.Body) == 0 {
+			r.Body = json.RawMessage("null")
+		}
+
+		if p.Type == "csp-violation" {
+			var v struct {
+				DocumentURL        string `json:"documentURL"`
+				Referrer           string `json:"referrer"`
+				BlockedURL         string `json:"blockedURL"`
+				EffectiveDirective string `json:"effectiveDirective"`
+				Disposition        string `json:"disposition"`
+				SourceFile         string `json:"sourceFile"`
+				Sample             string `json:"sample"`
+				StatusCode         int    `json:"statusCode"`
+				LineNumber         int    `json:"lineNumber"`
+				ColumnNumber       int    `json:"columnNumber"`
+			}
+			if err := json.Unmarshal(r.Body, &v); err != nil {
+				return nil, err
+			}
+
+			r.Violation = violation{
+				DocumentURL:        v.DocumentURL,
+				Referrer:           v.Referrer,
+				BlockedURL:         v.BlockedURL,
+				EffectiveDirective: v.EffectiveDirective,
+				Disposition:        v.Disposition,
+				SourceFile:         v.SourceFile,
+				Sample:             v.Sample,
+				StatusCode:         v.StatusCode,
+				LineNumber:         v.LineNumber,
+				ColumnNumber:       v.ColumnNumber,
+			}
+		}
+
+		reports = append(reports, r)
+	}
+
+	return reports, nil
+}
@@ -0,0 +1,175 @@
+package cspreport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	testCases := []struct {
+		desc               string
+		method             string
+		contentType        string
+		body               string
+		expectedStatus     int
+		expectedViolations float64
+		expectedLabels     []string
+	}{
+		{
+			desc:        "legacy report",
+			method:      http.MethodPost,
+			contentType: "application/csp-report",
+			body: `{"csp-report": {
+				"document-uri": "https://example.com/page",
+				"blocked-uri": "https://evil.com/script.js",
+				"violated-directive": "script-src-elem 'self'",
+				"effective-directive": "script-src-elem",
+				"disposition": "enforce",
+				"status-code": 200
+			}}`,
+			expectedStatus:     http.StatusNoContent,
+			expectedViolations: 1,
+			expectedLabels:     []string{"directive", "script-src-elem", "disposition", "enforce"},
+		},
+		{
+			desc:        "legacy report without effective directive",
+			method:      http.MethodPost,
+			contentType: "application/csp-report",
+			body: `{"csp-report": {
+				"document-uri": "https://example.com/page",
+				"blocked-uri": "inline",
+				"violated-directive": "style-src 'self'"
+			}}`,
+			expectedStatus:     http.StatusNoContent,
+			expectedViolations: 1,
+			expectedLabels:     []string{"directive", "style-src", "disposition", "enforce"},
+		},
+		{
+			desc:        "reporting API reports",
+			method:      http.MethodPost,
+			contentType: "application/reports+json",
+			body: `[
+				{"type": "csp-violation", "url": "https://example.com/page", "body": {
+					"documentURL": "https://example.com/page",
+					"blockedURL": "inline",
+					"effectiveDirective": "script-src-elem",
+					"disposition": "report",
+					"lineNumber": 12
+				}},
+				{"type": "deprecation", "url": "https://example.com/page", "body": {"id": "foo"}}
+			]`,
+			expectedStatus:     http.StatusNoContent,
+			expectedViolations: 1,
+			expectedLabels:     []string{"directive", "script-src-elem", "disposition", "report"},
+		},
+		{
+			desc:        "unknown directive and disposition",
+			method:      http.MethodPost,
+			contentType: "application/csp-report",
+			body: `{"csp-report": {
+				"document-uri": "https://example.com/page",
+				"effective-directive": "random-1234",
+				"disposition": "random-5678"
+			}}`,
+			expectedStatus:     http.StatusNoContent,
+			expectedViolations: 1,
+			expectedLabels:     []string{"directive", "other", "disposition", "other"},
+		},
+		{
+			desc:        "directive case",
+			method:      http.MethodPost,
+			contentType: "application/csp-report",
+			body: `{"csp-report": {
+				"document-uri": "https://example.com/page",
+				"effective-directive": "IMG-SRC",
+				"disposition": "Report"
+			}}`,
+			expectedStatus:     http.StatusNoContent,
+			expectedViolations: 1,
+			expectedLabels:     []string{"directive", "img-src", "disposition", "report"},
+		},
+		{
+			desc:               "too many reports",
+			method:             http.MethodPost,
+			contentType:        "application/reports+json",
+			body:               "[" + strings.Repeat(`{"type": "csp-violation", "body": {"effectiveDirective": "img-src"}},`, 20) + `{"type": "deprecation"}]`,
+			expectedStatus:     http.StatusNoContent,
+			expectedViolations: maxReports,
+			expectedLabels:     []string{"directive", "img-src", "disposition", "enforce"},
+		},
+		{
+			desc:           "invalid method",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			desc:           "invalid content type",
+			method:         http.MethodPost,
+			contentType:    "text/plain",
+			body:           "foo",
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:           "invalid legacy report",
+			method:         http.MethodPost,
+			contentType:    "application/csp-report",
+			body:           `{"foo": "bar"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			desc:           "invalid JSON",
+			method:         http.MethodPost,
+			contentType:    "application/reports+json",
+			body:           `{`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			desc:           "too large report",
+			method:         http.MethodPost,
+			contentType:    "application/csp-report",
+			body:           strings.Repeat("a", maxReportSize+1),
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			counter := &collectingCounter{}
+			handler := &Handler{}
+			handler.SetDefaults()
+			handler.SetCounter(counter)
+
+			req := httptest.NewRequest(test.method, "http://example.com/csp-report", strings.NewReader(test.body))
+			req.Header.Set("Content-Type", test.contentType)
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expectedStatus, recorder.Code)
+			assert.InDelta(t, test.expectedViolations, counter.value, 0)
+			assert.Equal(t, test.expectedLabels, counter.labels)
+		})
+	}
+}
+
+// collectingCounter is a counter recording its last labels.
+type collectingCounter struct {
+	value  float64
+	labels []string
+}
+
+func (c *collectingCounter) With(labelValues ...string) metrics.Counter {
+	c.labels = labelValues
+	return c
+}
+
+func (c *collectingCounter) Add(delta float64) {
+	c.value += delta
+}
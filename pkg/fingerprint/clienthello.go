@@ -0,0 +1,357 @@
+package fingerprint
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/cryptobyte"
+)
+
+// TLS record and handshake message types.
+const (
+	recordTypeHandshake = 0x16
+	typeClientHello     = 0x01
+)
+
+// TLS extension types.
+const (
+	extensionServerName          = 0x0000
+	extensionSupportedGroups     = 0x000a
+	extensionPointFormats        = 0x000b
+	extensionSignatureAlgorithms = 0x000d
+	extensionALPN                = 0x0010
+	extensionSupportedVersions   = 0x002b
+)
+
+// clientHello holds the fields of a TLS ClientHello used by the fingerprints, in the order sent by the client.
+type clientHello struct {
+	version             uint16
+	cipherSuites        []uint16
+	extensions          []uint16
+	supportedGroups     []uint16
+	pointFormats        []uint8
+	signatureAlgorithms []uint16
+	alpnProtocols       []string
+	supportedVersions   []uint16
+	hasServerName       bool
+}
+
+// FromClientHello computes the TLS fingerprints of a client,
+// from the raw TLS records holding its ClientHello.
+func FromClientHello(records []byte) (*Fingerprints, error) {
+	hello, err := parseClientHello(records)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Fingerprints{
+		JA3: ja3Hash(hello),
+		JA4: ja4(hello),
+	}, nil
+}
+
+// parseClientHello parses the ClientHello handshake message, which may be fragmented across several TLS records.
+// The records following the ClientHello are ignored.
+func parseClientHello(records []byte) (*clientHello, error) {
+	var handshake []byte
+
+	input := cryptobyte.String(records)
+	for !handshakeComplete(handshake) {
+		var (
+			recordType uint8
+			version    uint16
+			fragment   cryptobyte.String
+		)
+		if !input.ReadUint8(&recordType) || !input.ReadUint16(&version) || !input.ReadUint16LengthPrefixed(&fragment) {
+			return nil, errors.New("truncated TLS record")
+		}
+
+		if recordType != recordTypeHandshake {
+			return nil, fmt.Errorf("unexpected TLS record type %d", recordType)
+		}
+
+		handshake = append(handshake, fragment...)
+	}
+
+	msg := cryptobyte.String(handshake)
+
+	var (
+		msgType uint8
+		body    cryptobyte.String
+	)
+	if !msg.ReadUint8(&msgType) || !msg.ReadUint24LengthPrefixed(&body) {
+		return nil, errors.New("truncated handshake message")
+	}
+
+	if msgType != typeClientHello {
+		return nil, fmt.Errorf("unexpected handshake message type %d", msgType)
+	}
+
+	var (
+		hello              clientHello
+		sessionID          cryptobyte.String
+		cipherSuites       cryptobyte.String
+		compressionMethods cryptobyte.String
+	)
+	if !body.ReadUint16(&hello.version) ||
+		!body.Skip(32) || // Random.
+		!body.ReadUint8LengthPrefixed(&sessionID) ||
+		!body.ReadUint16LengthPrefixed(&cipherSuites) ||
+		!body.ReadUint8LengthPrefixed(&compressionMethods) {
+		return nil, errors.New("malformed ClientHello")
+	}
+
+	var ok bool
+	if hello.cipherSuites, ok = readUint16List(cipherSuites); !ok {
+		return nil, errors.New("malformed ClientHello cipher suites")
+	}
+
+	if body.Empty() {
+		// No extensions.
+		return &hello, nil
+	}
+
+	var extensions cryptobyte.String
+	if !body.ReadUint16LengthPrefixed(&extensions) {
+		return nil, errors.New("malformed ClientHello extensions")
+	}
+
+	for !extensions.Empty() {
+		var (
+			extension uint16
+			data      cryptobyte.String
+		)
+		if !extensions.ReadUint16(&extension) || !extensions.ReadUint16LengthPrefixed(&data) {
+			return nil, errors.New("malformed ClientHello extensions")
+		}
+
+		hello.extensions = append(hello.extensions, extension)
+
+		if err := hello.parseExtension(extension, data); err != nil {
+			return nil, err
+		}
+	}
+
+	return &hello, nil
+}
+
+// handshakeComplete reports whether the handshake data holds a whole handshake message.
+func handshakeComplete(handshake []byte) bool {
+	if len(handshake) < 4 {
+		return false
+	}
+
+	length := int(handshake[1])<<16 | int(handshake[2])<<8 | int(handshake[3])
+
+	return len(handshake) >= 4+length
+}
+
+func (h *clientHello) parseExtension(extension uint16, data cryptobyte.String) error {
+	var (
+		list cryptobyte.String
+		ok   = true
+	)
+
+	switch extension {
+	case extensionServerName:
+		h.hasServerName = true
+
+	case extensionSupportedGroups:
+		ok = data.ReadUint16LengthPrefixed(&list)
+		if ok {
+			h.supportedGroups, ok = readUint16List(list)
+		}
+
+	case extensionPointFormats:
+		ok = data.ReadUint8LengthPrefixed(&list)
+		if ok {
+			h.pointFormats = list
+		}
+
+	case extensionSignatureAlgorithms:
+		ok = data.ReadUint16LengthPrefixed(&list)
+		if ok {
+			h.signatureAlgorithms, ok = readUint16List(list)
+		}
+
+	case extensionALPN:
+		ok = data.ReadUint16LengthPrefixed(&list)
+		for ok && !list.Empty() {
+			var protocol cryptobyte.String
+			ok = list.ReadUint8LengthPrefixed(&protocol)
+			if ok {
+				h.alpnProtocols = append(h.alpnProtocols, string(protocol))
+			}
+		}
+
+	case extensionSupportedVersions:
+		ok = data.ReadUint8LengthPrefixed(&list)
+		if ok {
+			h.supportedVersions, ok = readUint16List(list)
+		}
+	}
+
+	if !ok {
+		return fmt.Errorf("malformed ClientHello extension %d", extension)
+	}
+
+	return nil
+}
+
+func readUint16List(list cryptobyte.String) ([]uint16, bool) {
+	var values []uint16
+	for !list.Empty() {
+		var value uint16
+		if !list.ReadUint16(&value) {
+			return nil, false
+		}
+
+		values = append(values, value)
+	}
+
+	return values, true
+}
+
+// isGREASE reports whether the value is one of the reserved GREASE values (RFC 8701),
+// which are sent at random by the clients and ignored by the fingerprints.
+func isGREASE(value uint16) bool {
+	return value&0x0f0f == 0x0a0a && value>>8 == value&0xff
+}
+
+func withoutGREASE(values []uint16) []uint16 {
+	return slices.DeleteFunc(slices.Clone(values), isGREASE)
+}
+
+// ja3Hash returns the MD5 hash of the JA3 fingerprint of the ClientHello:
+// SSLVersion,Ciphers,Extensions,EllipticCurves,EllipticCurvePointFormats.
+func ja3Hash(hello *clientHello) string {
+	pointFormats := make([]uint16, len(hello.pointFormats))
+	for i, pointFormat := range hello.pointFormats {
+		pointFormats[i] = uint16(pointFormat)
+	}
+
+	ja3 := strings.Join([]string{
+		strconv.Itoa(int(hello.version)),
+		joinDecimal(withoutGREASE(hello.cipherSuites)),
+		joinDecimal(withoutGREASE(hello.extensions)),
+		joinDecimal(withoutGREASE(hello.supportedGroups)),
+		joinDecimal(pointFormats),
+	}, ",")
+
+	hash := md5.Sum([]byte(ja3))
+
+	return hex.EncodeToString(hash[:])
+}
+
+func joinDecimal(values []uint16) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = strconv.Itoa(int(value))
+	}
+
+	return strings.Join(parts, "-")
+}
+
+// ja4 returns the JA4 fingerprint of the ClientHello, as specified by FoxIO.
+func ja4(hello *clientHello) string {
+	cipherSuites := withoutGREASE(hello.cipherSuites)
+	extensions := withoutGREASE(hello.extensions)
+
+	sni := "i"
+	if hello.hasServerName {
+		sni = "d"
+	}
+
+	a := fmt.Sprintf("t%s%s%02d%02d%s", ja4Version(hello), sni, min(len(cipherSuites), 99), min(len(extensions), 99), ja4ALPN(hello))
+
+	slices.Sort(cipherSuites)
+	b := ja4Hash(joinHex(cipherSuites))
+
+	// The server name and ALPN extensions are already part of the first section.
+	extensions = slices.DeleteFunc(extensions, func(extension uint16) bool {
+		return extension == extensionServerName || extension == extensionALPN
+	})
+	slices.Sort(extensions)
+
+	c := joinHex(extensions)
+	if len(hello.signatureAlgorithms) > 0 {
+		c += "_" + joinHex(withoutGREASE(hello.signatureAlgorithms))
+	}
+
+	if len(extensions) == 0 {
+		c = ""
+	}
+
+	return a + "_" + b + "_" + ja4Hash(c)
+}
+
+func ja4Version(hello *clientHello) string {
+	version := hello.version
+	if supportedVersions := withoutGREASE(hello.supportedVersions); len(supportedVersions) > 0 {
+		version = slices.Max(supportedVersions)
+	}
+
+	switch version {
+	case 0x0304:
+		return "13"
+	case 0x0303:
+		return "12"
+	case 0x0302:
+		return "11"
+	case 0x0301:
+		return "10"
+	case 0x0300:
+		return "s3"
+	case 0x0002:
+		return "s2"
+	default:
+		return "00"
+	}
+}
+
+// ja4ALPN returns the first and last characters of the first ALPN protocol.
+func ja4ALPN(hello *clientHello) string {
+	if len(hello.alpnProtocols) == 0 || hello.alpnProtocols[0] == "" {
+		return "00"
+	}
+
+	protocol := hello.alpnProtocols[0]
+	first, last := protocol[0], protocol[len(protocol)-1]
+	if isAlphanumeric(first) && isAlphanumeric(last) {
+		return string([]byte{first, last})
+	}
+
+	encoded := hex.EncodeToString([]byte(protocol))
+
+	return string([]byte{encoded[0], encoded[len(encoded)-1]})
+}
+
+func isAlphanumeric(c byte) bool {
+	return '0' <= c && c <= '9' || 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z'
+}
+
+func joinHex(values []uint16) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = fmt.Sprintf("%04x", value)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// ja4Hash returns the first 12 characters of the SHA256 hash of the value, or zeros when the value is empty.
+func ja4Hash(value string) string {
+	if value == "" {
+		return "000000000000"
+	}
+
+	hash := sha256.Sum256([]byte(value))
+
+	return hex.EncodeToString(hash[:])[:12]
+}
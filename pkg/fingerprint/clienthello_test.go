@@ -0,0 +1,270 @@
+package fingerprint
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/cryptobyte"
+)
+
+func TestFromClientHello(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		hello       clientHello
+		expectedJA3 string
+		expectedJA4 string
+	}{
+		{
+			// Example from the JA3 specification.
+			desc: "TLS 1.0",
+			hello: clientHello{
+				version:         0x0301,
+				cipherSuites:    []uint16{47, 53, 5, 10, 49161, 49162, 49171, 49172, 50, 56, 19, 4},
+				extensions:      []uint16{0, 10, 11},
+				supportedGroups: []uint16{23, 24, 25},
+				pointFormats:    []uint8{0},
+				hasServerName:   true,
+			},
+			expectedJA3: "ada70206e40642a3e4461f35503241d5",
+			expectedJA4: "t10d120300_d94e65cdb899_33a13ba74d1c",
+		},
+		{
+			// Example from the JA4 specification, with GREASE values.
+			desc: "TLS 1.3",
+			hello: clientHello{
+				version: 0x0303,
+				cipherSuites: []uint16{
+					0x1a1a, 0x1301, 0x1302, 0x1303, 0xc02b, 0xc02f, 0xc02c, 0xc030,
+					0xcca9, 0xcca8, 0xc013, 0xc014, 0x009c, 0x009d, 0x002f, 0x0035,
+				},
+				extensions: []uint16{
+					0x2a2a, 0x0000, 0x0017, 0xff01, 0x000a, 0x000b, 0x0023, 0x0010, 0x0005,
+					0x000d, 0x0012, 0x0033, 0x002d, 0x002b, 0x001b, 0x4469, 0x0015,
+				},
+				supportedGroups:     []uint16{0x3a3a, 0x001d, 0x0017, 0x0018},
+				pointFormats:        []uint8{0},
+				signatureAlgorithms: []uint16{0x0403, 0x0804, 0x0401, 0x0503, 0x0805, 0x0501, 0x0806, 0x0601},
+				alpnProtocols:       []string{"h2", "http/1.1"},
+				supportedVersions:   []uint16{0x4a4a, 0x0304, 0x0303},
+				hasServerName:       true,
+			},
+			expectedJA3: "cd08e31494f9531f560d64c695473da9",
+			expectedJA4: "t13d1516h2_8daaf6152771_e5627efa2ab1",
+		},
+		{
+			desc: "without extensions",
+			hello: clientHello{
+				version:      0x0303,
+				cipherSuites: []uint16{0x002f},
+			},
+			expectedJA3: "fde4273625b2ac63bd01d9c500dac91b",
+			expectedJA4: "t12i010000_ba72b8082249_000000000000",
+		},
+		{
+			desc: "non alphanumeric ALPN",
+			hello: clientHello{
+				version:       0x0303,
+				cipherSuites:  []uint16{0x002f},
+				extensions:    []uint16{0x0010},
+				alpnProtocols: []string{"\xabfoo\xcd"},
+			},
+			expectedJA3: "6ae42ddbf08eb46f86bde7b5662fb56d",
+			expectedJA4: "t12i0101ad_ba72b8082249_000000000000",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			fingerprints, err := FromClientHello(marshalClientHello(t, &test.hello, 1<<14))
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedJA3, fingerprints.JA3)
+			assert.Equal(t, test.expectedJA4, fingerprints.JA4)
+		})
+	}
+}
+
+func TestFromClientHello_records(t *testing.T) {
+	hello := &clientHello{
+		version:           0x0303,
+		cipherSuites:      []uint16{0x1301, 0x1302},
+		extensions:        []uint16{0x0000, 0x0010, 0x002b},
+		alpnProtocols:     []string{"http/1.1"},
+		supportedVersions: []uint16{0x0304},
+		hasServerName:     true,
+	}
+
+	records := marshalClientHello(t, hello, 10)
+	// Early data sent after the ClientHello.
+	records = append(records, 0x17, 0x03, 0x03, 0x00, 0x02, 0xca, 0xfe)
+
+	fingerprints, err := FromClientHello(records)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1dae9c1bb9ff5e63c969a12a81eed58d", fingerprints.JA3)
+	assert.Equal(t, "t13d0203h1_62ed6f6ca7ad_b9a491fefe05", fingerprints.JA4)
+}
+
+func TestFromClientHello_goClient(t *testing.T) {
+	server, client := net.Pipe()
+	t.Cleanup(func() {
+		_ = server.Close()
+		_ = client.Close()
+	})
+
+	go func() {
+		tlsClient := tls.Client(client, &tls.Config{ServerName: "example.com", NextProtos: []string{"h2", "http/1.1"}})
+		_ = tlsClient.Handshake()
+	}()
+
+	header := make([]byte, 5)
+	_, err := io.ReadFull(server, header)
+	require.NoError(t, err)
+
+	record := make([]byte, 5+int(header[3])<<8|int(header[4]))
+	copy(record, header)
+	_, err = io.ReadFull(server, record[5:])
+	require.NoError(t, err)
+
+	fingerprints, err := FromClientHello(record)
+	require.NoError(t, err)
+
+	assert.Len(t, fingerprints.JA3, 32)
+	assert.Regexp(t, `^t13d\d{4}h2_[0-9a-f]{12}_[0-9a-f]{12}$`, fingerprints.JA4)
+}
+
+func TestFromClientHello_invalid(t *testing.T) {
+	valid := marshalClientHello(t, &clientHello{version: 0x0303, cipherSuites: []uint16{0x1301}}, 1<<14)
+
+	testCases := []struct {
+		desc    string
+		records []byte
+	}{
+		{
+			desc:    "empty",
+			records: []byte{},
+		},
+		{
+			desc:    "truncated record",
+			records: valid[:len(valid)-1],
+		},
+		{
+			desc:    "not a handshake record",
+			records: append([]byte{0x17}, valid[1:]...),
+		},
+		{
+			desc:    "not a ClientHello",
+			records: append(append([]byte{}, valid[:5]...), append([]byte{0x02}, valid[6:]...)...),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := FromClientHello(test.records)
+			require.Error(t, err)
+		})
+	}
+}
+
+// marshalClientHello encodes the ClientHello in TLS records holding at most fragmentSize bytes.
+func marshalClientHello(t *testing.T, hello *clientHello, fragmentSize int) []byte {
+	t.Helper()
+
+	var msg cryptobyte.Builder
+	msg.AddUint8(typeClientHello)
+	msg.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddUint16(hello.version)
+		b.AddBytes(make([]byte, 32))
+		b.AddUint8(0)
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			for _, cipherSuite := range hello.cipherSuites {
+				b.AddUint16(cipherSuite)
+			}
+		})
+		b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddUint8(0)
+		})
+
+		if len(hello.extensions) == 0 {
+			return
+		}
+
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			for _, extension := range hello.extensions {
+				b.AddUint16(extension)
+				b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+					marshalExtension(b, hello, extension)
+				})
+			}
+		})
+	})
+
+	handshake, err := msg.Bytes()
+	require.NoError(t, err)
+
+	var records []byte
+	for len(handshake) > 0 {
+		fragment := handshake[:min(fragmentSize, len(handshake))]
+		handshake = handshake[len(fragment):]
+
+		records = append(records, recordTypeHandshake, 0x03, 0x01, byte(len(fragment)>>8), byte(len(fragment)))
+		records = append(records, fragment...)
+	}
+
+	return records
+}
+
+func marshalExtension(b *cryptobyte.Builder, hello *clientHello, extension uint16) {
+	switch extension {
+	case extensionServerName:
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddUint8(0)
+			b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+				b.AddBytes([]byte("example.com"))
+			})
+		})
+
+	case extensionSupportedGroups:
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			for _, group := range hello.supportedGroups {
+				b.AddUint16(group)
+			}
+		})
+
+	case extensionPointFormats:
+		b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes(hello.pointFormats)
+		})
+
+	case extensionSignatureAlgorithms:
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			for _, algorithm := range hello.signatureAlgorithms {
+				b.AddUint16(algorithm)
+			}
+		})
+
+	case extensionALPN:
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			for _, protocol := range hello.alpnProtocols {
+				b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
+					b.AddBytes([]byte(protocol))
+				})
+			}
+		})
+
+	case extensionSupportedVersions:
+		b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
+			for _, version := range hello.supportedVersions {
+				b.AddUint16(version)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package fingerprint
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Fingerprints holds the fingerprints of the client of a connection.
+// Fields are empty when the fingerprint is unknown.
+type Fingerprints struct {
+	// JA3 is the MD5 hash of the JA3 fingerprint of the TLS ClientHello.
+	JA3 string
+	// JA4 is the JA4 fingerprint of the TLS ClientHello.
+	JA4 string
+
+	// http2 is set once the client has sent its first HTTP/2 request.
+	http2 atomic.Pointer[string]
+}
+
+// HTTP2 returns the HTTP/2 fingerprint of the connection,
+// computed from the frames sent by the client up to its first request.
+func (f *Fingerprints) HTTP2() string {
+	if fingerprint := f.http2.Load(); fingerprint != nil {
+		return *fingerprint
+	}
+
+	return ""
+}
+
+// SetHTTP2 sets the HTTP/2 fingerprint of the connection.
+func (f *Fingerprints) SetHTTP2(fingerprint string) {
+	f.http2.Store(&fingerprint)
+}
+
+// Conn is implemented by the connections carrying the fingerprints of their client.
+type Conn interface {
+	Fingerprints() *Fingerprints
+}
+
+type fingerprintsKey struct{}
+
+// NewContext returns a new context carrying the connection fingerprints.
+func NewContext(ctx context.Context, fingerprints *Fingerprints) context.Context {
+	return context.WithValue(ctx, fingerprintsKey{}, fingerprints)
+}
+
+// FromContext returns the connection fingerprints carried by the context, or nil if there are none.
+func FromContext(ctx context.Context) *Fingerprints {
+	fingerprints, _ := ctx.Value(fingerprintsKey{}).(*Fingerprints)
+	return fingerprints
+}
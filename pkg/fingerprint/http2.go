@@ -0,0 +1,250 @@
+package fingerprint
+
+import (
+	"crypto/tls"
+	"encoding/binary"
+	"net"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/http2/hpack"
+)
+
+// http2Preface is the connection preface sent by the HTTP/2 clients.
+const http2Preface = "PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n"
+
+// maxHTTP2SniffBytes is the maximum number of bytes read from a connection to compute its HTTP/2 fingerprint.
+const maxHTTP2SniffBytes = 64 * 1024
+
+// HTTP/2 frame types and flags.
+const (
+	frameHeaders      = 0x1
+	framePriority     = 0x2
+	frameSettings     = 0x4
+	frameWindowUpdate = 0x8
+	frameContinuation = 0x9
+
+	flagAck        = 0x1
+	flagEndHeaders = 0x4
+	flagPadded     = 0x8
+	flagPriority   = 0x20
+)
+
+// NewHTTP2Conn returns a connection computing the HTTP/2 fingerprint of its client from the frames it reads,
+// and setting it on the fingerprints once the first request has been read.
+// sawClientPreface tells whether the connection preface has already been read from the connection.
+// The returned connection also implements ConnectionState when conn is a TLS connection.
+func NewHTTP2Conn(conn net.Conn, fingerprints *Fingerprints, sawClientPreface bool) net.Conn {
+	c := &http2Conn{
+		Conn:         conn,
+		fingerprints: fingerprints,
+		sniffer:      &http2Sniffer{prefaceRead: sawClientPreface},
+	}
+
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		return &tlsHTTP2Conn{http2Conn: c, tlsConn: tlsConn}
+	}
+
+	return c
+}
+
+type http2Conn struct {
+	net.Conn
+
+	fingerprints *Fingerprints
+	// sniffer is only used by Read, which the HTTP/2 server never calls concurrently.
+	sniffer *http2Sniffer
+}
+
+func (c *http2Conn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+
+	if c.sniffer != nil && n > 0 {
+		fingerprint, done := c.sniffer.write(p[:n])
+		if done {
+			if fingerprint != "" {
+				c.fingerprints.SetHTTP2(fingerprint)
+			}
+			// The fingerprint is computed once per connection.
+			c.sniffer = nil
+		}
+	}
+
+	return n, err
+}
+
+// tlsHTTP2Conn is an http2Conn over a TLS connection,
+// which exposes the TLS connection state to the HTTP/2 server.
+type tlsHTTP2Conn struct {
+	*http2Conn
+
+	tlsConn *tls.Conn
+}
+
+func (c *tlsHTTP2Conn) ConnectionState() tls.ConnectionState {
+	return c.tlsConn.ConnectionState()
+}
+
+// http2Sniffer computes the HTTP/2 fingerprint of a connection,
+// from the frames sent by the client up to its first HEADERS frame.
+// The fingerprint follows the format described by Akamai:
+// SETTINGS|WINDOW_UPDATE|PRIORITY|Pseudo-Header-Order.
+type http2Sniffer struct {
+	buf         []byte
+	read        int
+	prefaceRead bool
+
+	settings     []string
+	windowUpdate string
+	priorities   []string
+	headerBlock  []byte
+	inHeaders    bool
+}
+
+// write adds data read from the connection, and returns the fingerprint once it is complete.
+// It returns done with an empty fingerprint when the connection is not a valid HTTP/2 connection.
+func (s *http2Sniffer) write(data []byte) (fingerprint string, done bool) {
+	s.read += len(data)
+	if s.read > maxHTTP2SniffBytes {
+		return "", true
+	}
+
+	s.buf = append(s.buf, data...)
+
+	if !s.prefaceRead {
+		if len(s.buf) < len(http2Preface) {
+			return "", !strings.HasPrefix(http2Preface, string(s.buf))
+		}
+
+		if string(s.buf[:len(http2Preface)]) != http2Preface {
+			return "", true
+		}
+
+		s.buf = s.buf[len(http2Preface):]
+		s.prefaceRead = true
+	}
+
+	for len(s.buf) >= 9 {
+		length := int(s.buf[0])<<16 | int(s.buf[1])<<8 | int(s.buf[2])
+		if len(s.buf) < 9+length {
+			return "", false
+		}
+
+		frameType, flags := s.buf[3], s.buf[4]
+		streamID := binary.BigEndian.Uint32(s.buf[5:9]) & (1<<31 - 1)
+		payload := s.buf[9 : 9+length]
+		s.buf = s.buf[9+length:]
+
+		if s.inHeaders && frameType != frameContinuation {
+			return "", true
+		}
+
+		switch frameType {
+		case frameSettings:
+			if flags&flagAck != 0 || s.settings != nil {
+				continue
+			}
+
+			s.settings = []string{}
+			for i := 0; i+6 <= len(payload); i += 6 {
+				id := binary.BigEndian.Uint16(payload[i:])
+				value := binary.BigEndian.Uint32(payload[i+2:])
+				s.settings = append(s.settings, strconv.Itoa(int(id))+":"+strconv.FormatUint(uint64(value), 10))
+			}
+
+		case frameWindowUpdate:
+			if streamID == 0 && s.windowUpdate == "" && len(payload) == 4 {
+				s.windowUpdate = strconv.FormatUint(uint64(binary.BigEndian.Uint32(payload)&(1<<31-1)), 10)
+			}
+
+		case framePriority:
+			if len(payload) == 5 {
+				s.priorities = append(s.priorities, formatPriority(streamID, payload))
+			}
+
+		case frameHeaders:
+			if flags&flagPadded != 0 {
+				if len(payload) < 1 || int(payload[0]) > len(payload)-1 {
+					return "", true
+				}
+				payload = payload[1 : len(payload)-int(payload[0])]
+			}
+
+			if flags&flagPriority != 0 {
+				if len(payload) < 5 {
+					return "", true
+				}
+				payload = payload[5:]
+			}
+
+			s.headerBlock = append(s.headerBlock, payload...)
+			s.inHeaders = true
+
+			if flags&flagEndHeaders != 0 {
+				return s.fingerprint(), true
+			}
+
+		case frameContinuation:
+			if !s.inHeaders {
+				return "", true
+			}
+
+			s.headerBlock = append(s.headerBlock, payload...)
+
+			if flags&flagEndHeaders != 0 {
+				return s.fingerprint(), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// formatPriority formats the payload of a PRIORITY frame as StreamID:Exclusive:DependentStreamID:Weight.
+func formatPriority(streamID uint32, payload []byte) string {
+	dependency := binary.BigEndian.Uint32(payload)
+
+	exclusive := "0"
+	if dependency>>31 == 1 {
+		exclusive = "1"
+	}
+
+	return strings.Join([]string{
+		strconv.FormatUint(uint64(streamID), 10),
+		exclusive,
+		strconv.FormatUint(uint64(dependency&(1<<31-1)), 10),
+		strconv.Itoa(int(payload[4]) + 1),
+	}, ":")
+}
+
+func (s *http2Sniffer) fingerprint() string {
+	// The header block of the first request is decoded with an empty dynamic table.
+	decoder := hpack.NewDecoder(maxHTTP2SniffBytes, nil)
+
+	var pseudoHeaders []string
+	fields, err := decoder.DecodeFull(s.headerBlock)
+	if err == nil {
+		for _, field := range fields {
+			if field.IsPseudo() && len(field.Name) > 1 {
+				pseudoHeaders = append(pseudoHeaders, field.Name[1:2])
+			}
+		}
+	}
+
+	windowUpdate := s.windowUpdate
+	if windowUpdate == "" {
+		windowUpdate = "00"
+	}
+
+	priorities := "0"
+	if len(s.priorities) > 0 {
+		priorities = strings.Join(s.priorities, ",")
+	}
+
+	return strings.Join([]string{
+		strings.Join(s.settings, ";"),
+		windowUpdate,
+		priorities,
+		strings.Join(pseudoHeaders, ","),
+	}, "|")
+}
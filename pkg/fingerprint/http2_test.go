@@ -0,0 +1,155 @@
+package fingerprint
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+func TestNewHTTP2Conn(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		sawClientPreface bool
+		frames           func(t *testing.T, framer *http2.Framer, headerBlock []byte)
+		expected         string
+	}{
+		{
+			desc: "Chrome like",
+			frames: func(t *testing.T, framer *http2.Framer, headerBlock []byte) {
+				t.Helper()
+
+				require.NoError(t, framer.WriteSettings(
+					http2.Setting{ID: http2.SettingHeaderTableSize, Val: 65536},
+					http2.Setting{ID: http2.SettingEnablePush, Val: 0},
+					http2.Setting{ID: http2.SettingInitialWindowSize, Val: 6291456},
+					http2.Setting{ID: http2.SettingMaxHeaderListSize, Val: 262144},
+				))
+				require.NoError(t, framer.WriteWindowUpdate(0, 15663105))
+				require.NoError(t, framer.WriteHeaders(http2.HeadersFrameParam{
+					StreamID:      1,
+					BlockFragment: headerBlock,
+					EndStream:     true,
+					EndHeaders:    true,
+					Priority:      http2.PriorityParam{StreamDep: 0, Exclusive: true, Weight: 255},
+				}))
+			},
+			expected: "1:65536;2:0;4:6291456;6:262144|15663105|0|m,a,s,p",
+		},
+		{
+			desc: "Firefox like",
+			frames: func(t *testing.T, framer *http2.Framer, headerBlock []byte) {
+				t.Helper()
+
+				require.NoError(t, framer.WriteSettings(
+					http2.Setting{ID: http2.SettingHeaderTableSize, Val: 65536},
+					http2.Setting{ID: http2.SettingInitialWindowSize, Val: 131072},
+					http2.Setting{ID: http2.SettingMaxFrameSize, Val: 16384},
+				))
+				require.NoError(t, framer.WriteWindowUpdate(0, 12517377))
+				require.NoError(t, framer.WritePriority(3, http2.PriorityParam{StreamDep: 0, Weight: 200}))
+				require.NoError(t, framer.WritePriority(5, http2.PriorityParam{StreamDep: 0, Weight: 100}))
+				require.NoError(t, framer.WriteHeaders(http2.HeadersFrameParam{
+					StreamID:      1,
+					BlockFragment: headerBlock[:2],
+					EndStream:     true,
+					PadLength:     4,
+				}))
+				require.NoError(t, framer.WriteContinuation(1, true, headerBlock[2:]))
+			},
+			expected: "1:65536;4:131072;5:16384|12517377|3:0:0:201,5:0:0:101|m,a,s,p",
+		},
+		{
+			desc:             "preface already read",
+			sawClientPreface: true,
+			frames: func(t *testing.T, framer *http2.Framer, headerBlock []byte) {
+				t.Helper()
+
+				require.NoError(t, framer.WriteSettings())
+				require.NoError(t, framer.WriteSettingsAck())
+				require.NoError(t, framer.WriteHeaders(http2.HeadersFrameParam{
+					StreamID:      1,
+					BlockFragment: headerBlock,
+					EndHeaders:    true,
+				}))
+			},
+			expected: "|00|0|m,a,s,p",
+		},
+		{
+			desc: "no request",
+			frames: func(t *testing.T, framer *http2.Framer, _ []byte) {
+				t.Helper()
+
+				require.NoError(t, framer.WriteSettings())
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var headerBlock bytes.Buffer
+			encoder := hpack.NewEncoder(&headerBlock)
+			for _, field := range []hpack.HeaderField{
+				{Name: ":method", Value: "GET"},
+				{Name: ":authority", Value: "example.com"},
+				{Name: ":scheme", Value: "https"},
+				{Name: ":path", Value: "/"},
+				{Name: "user-agent", Value: "foo"},
+			} {
+				require.NoError(t, encoder.WriteField(field))
+			}
+
+			var data bytes.Buffer
+			if !test.sawClientPreface {
+				data.WriteString(http2.ClientPreface)
+			}
+			test.frames(t, http2.NewFramer(&data, nil), headerBlock.Bytes())
+
+			server, client := net.Pipe()
+			t.Cleanup(func() { _ = server.Close() })
+
+			go func() {
+				// The frames are written byte by byte, to check they are reassembled across reads.
+				for _, b := range data.Bytes() {
+					if _, err := client.Write([]byte{b}); err != nil {
+						return
+					}
+				}
+				_ = client.Close()
+			}()
+
+			fingerprints := &Fingerprints{}
+			conn := NewHTTP2Conn(server, fingerprints, test.sawClientPreface)
+
+			read, err := io.ReadAll(conn)
+			require.NoError(t, err)
+
+			assert.Equal(t, data.Bytes(), read)
+			assert.Equal(t, test.expected, fingerprints.HTTP2())
+		})
+	}
+}
+
+func TestNewHTTP2Conn_notHTTP2(t *testing.T) {
+	server, client := net.Pipe()
+	t.Cleanup(func() { _ = server.Close() })
+
+	go func() {
+		_, _ = client.Write([]byte("GET / HTTP/1.1\r\nHost: example.com\r\n\r\n"))
+		_ = client.Close()
+	}()
+
+	fingerprints := &Fingerprints{}
+
+	_, err := io.ReadAll(NewHTTP2Conn(server, fingerprints, false))
+	require.NoError(t, err)
+
+	assert.Empty(t, fingerprints.HTTP2())
+}
@@ -0,0 +1,54 @@
+package geoip
+
+import (
+	"context"
+	"sync"
+)
+
+type locationsKey struct{}
+
+// Locations memoizes the locations looked up for a request or a connection, by address.
+// A nil Locations does not memoize anything.
+type Locations struct {
+	mu      sync.Mutex
+	entries map[string]locationEntry
+}
+
+type locationEntry struct {
+	location Location
+	err      error
+}
+
+// NewContext returns a new context memoizing the locations looked up with it.
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, locationsKey{}, &Locations{})
+}
+
+// FromContext returns the locations memoized by the context, or nil if there are none.
+func FromContext(ctx context.Context) *Locations {
+	locations, _ := ctx.Value(locationsKey{}).(*Locations)
+	return locations
+}
+
+// Lookup returns the location of the given address, looked up in the GeoIP databases on the first call only.
+func (l *Locations) Lookup(geoIP *GeoIP, addr string) (Location, error) {
+	if l == nil {
+		return geoIP.Lookup(addr)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if entry, ok := l.entries[addr]; ok {
+		return entry.location, entry.err
+	}
+
+	location, err := geoIP.Lookup(addr)
+
+	if l.entries == nil {
+		l.entries = make(map[string]locationEntry, 1)
+	}
+	l.entries[addr] = locationEntry{location: location, err: err}
+
+	return location, err
+}
@@ -0,0 +1,251 @@
+package geoip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/ip"
+)
+
+// Location holds the geographical and network information of an IP address.
+// Fields are empty when the information is unknown.
+type Location struct {
+	// Country is the ISO 3166-1 alpha-2 code of the country.
+	Country string
+	// ASN is the number of the autonomous system.
+	ASN uint64
+	// ASOrganization is the name of the organization owning the autonomous system.
+	ASOrganization string
+}
+
+// GeoIP resolves the location of the client IPs from local MaxMind DB (MMDB) databases,
+// which are reloaded whenever they change.
+type GeoIP struct {
+	countryDatabase string
+	asnDatabase     string
+
+	strategy  ip.Strategy
+	countryDB atomic.Pointer[maxminddb.Reader]
+	asnDB     atomic.Pointer[maxminddb.Reader]
+}
+
+// countryRecord holds the fields of the Country and City databases records used to locate an IP.
+type countryRecord struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	RegisteredCountry struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"registered_country"`
+}
+
+// asnRecord holds the fields of the ASN databases records used to locate an IP.
+type asnRecord struct {
+	AutonomousSystemNumber       uint64 `maxminddb:"autonomous_system_number"`
+	AutonomousSystemOrganization string `maxminddb:"autonomous_system_organization"`
+}
+
+// New creates a GeoIP resolver, and loads the given databases.
+func New(countryDatabase, asnDatabase string, ipStrategy *dynamic.IPStrategy) (*GeoIP, error) {
+	if countryDatabase == "" && asnDatabase == "" {
+		return nil, errors.New("at least one of countryDatabase or asnDatabase must be set")
+	}
+
+	strategy, err := ipStrategy.Get()
+	if err != nil {
+		return nil, fmt.Errorf("invalid IP strategy: %w", err)
+	}
+
+	g := &GeoIP{
+		countryDatabase: countryDatabase,
+		asnDatabase:     asnDatabase,
+		strategy:        strategy,
+	}
+
+	if countryDatabase != "" {
+		if err := g.load(countryDatabase, &g.countryDB); err != nil {
+			return nil, err
+		}
+	}
+
+	if asnDatabase != "" {
+		if err := g.load(asnDatabase, &g.asnDB); err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+// Watch reloads the databases whenever their files change, until the context is done.
+// The directories holding the databases are watched rather than the files,
+// so that the databases replaced by a rename (as done by geoipupdate) are reloaded too.
+func (g *GeoIP) Watch(ctx context.Context) {
+	logger := log.Ctx(ctx).With().Str("component", "geoip").Logger()
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		logger.Error().Err(err).Msg("Unable to create the GeoIP databases watcher")
+		return
+	}
+	defer func() { _ = watcher.Close() }()
+
+	databases := map[string][]*atomic.Pointer[maxminddb.Reader]{}
+	for _, watched := range []struct {
+		path string
+		db   *atomic.Pointer[maxminddb.Reader]
+	}{
+		{path: g.countryDatabase, db: &g.countryDB},
+		{path: g.asnDatabase, db: &g.asnDB},
+	} {
+		if watched.path == "" {
+			continue
+		}
+
+		path := filepath.Clean(watched.path)
+		databases[path] = append(databases[path], watched.db)
+
+		if err := watcher.Add(filepath.Dir(path)); err != nil {
+			logger.Error().Err(err).Msgf("Unable to watch the GeoIP database %s", path)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case event := <-watcher.Events:
+			if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) && !event.Has(fsnotify.Rename) {
+				continue
+			}
+
+			path := filepath.Clean(event.Name)
+
+			for _, db := range databases[path] {
+				if err := g.load(path, db); err != nil {
+					// The file can be partially written, the next event triggers a new attempt.
+					logger.Warn().Err(err).Msg("Unable to reload the GeoIP database, keeping the previous one")
+					continue
+				}
+
+				logger.Info().Msgf("GeoIP database %s reloaded", path)
+			}
+
+		case err := <-watcher.Errors:
+			logger.Error().Err(err).Msg("GeoIP databases watcher error")
+		}
+	}
+}
+
+// ClientIP returns the client IP of the request, according to the configured IP strategy.
+func (g *GeoIP) ClientIP(req *http.Request) string {
+	return g.strategy.GetIP(req)
+}
+
+// Handler returns a handler memoizing, in the request context, the locations looked up while handling the request,
+// so that the router rules, the middlewares and the access logs look up each client IP once per request.
+func (g *GeoIP) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		next.ServeHTTP(rw, req.WithContext(NewContext(req.Context())))
+	})
+}
+
+// LookupRequest returns the location of the given address, looked up once per request
+// when the request context memoizes the locations.
+func (g *GeoIP) LookupRequest(req *http.Request, addr string) (Location, error) {
+	return FromContext(req.Context()).Lookup(g, addr)
+}
+
+// Lookup returns the location of the given address.
+// An address absent from the databases has an empty location.
+func (g *GeoIP) Lookup(addr string) (Location, error) {
+	ipAddr := net.ParseIP(addr)
+	if ipAddr == nil {
+		return Location{}, fmt.Errorf("unable to parse address: %s", addr)
+	}
+
+	var location Location
+
+	if db := g.countryDB.Load(); db != nil {
+		var record countryRecord
+		if err := db.Lookup(ipAddr, &record); err != nil {
+			return Location{}, err
+		}
+
+		// Falls back on the country where the network is registered.
+		location.Country = record.Country.ISOCode
+		if location.Country == "" {
+			location.Country = record.RegisteredCountry.ISOCode
+		}
+	}
+
+	if db := g.asnDB.Load(); db != nil {
+		var record asnRecord
+		if err := db.Lookup(ipAddr, &record); err != nil {
+			return Location{}, err
+		}
+
+		location.ASN = record.AutonomousSystemNumber
+		location.ASOrganization = record.AutonomousSystemOrganization
+	}
+
+	return location, nil
+}
+
+func (g *GeoIP) load(path string, db *atomic.Pointer[maxminddb.Reader]) error {
+	// The database is read in memory rather than memory-mapped,
+	// so that the previous database can be replaced while lookups are still reading it.
+	buffer, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("loading GeoIP database %s: %w", path, err)
+	}
+
+	loaded, err := maxminddb.FromBytes(buffer)
+	if err != nil {
+		return fmt.Errorf("loading GeoIP database %s: %w", path, err)
+	}
+
+	db.Store(loaded)
+
+	log.Debug().Str("component", "geoip").Msgf("GeoIP database %s (%s) loaded", path, loaded.Metadata.DatabaseType)
+
+	return nil
+}
+
+// ParseCountry parses an ISO 3166-1 alpha-2 country code, case-insensitively.
+func ParseCountry(value string) (string, error) {
+	country := strings.ToUpper(strings.TrimSpace(value))
+	if len(country) != 2 || country[0] < 'A' || country[0] > 'Z' || country[1] < 'A' || country[1] > 'Z' {
+		return "", fmt.Errorf("invalid country code %q: expected an ISO 3166-1 alpha-2 code", value)
+	}
+
+	return country, nil
+}
+
+// ParseASN parses an autonomous system number, optionally prefixed with AS.
+func ParseASN(value string) (uint64, error) {
+	number := strings.TrimSpace(value)
+	if len(number) > 2 && strings.EqualFold(number[:2], "AS") {
+		number = number[2:]
+	}
+
+	asn, err := strconv.ParseUint(number, 10, 32)
+	if err != nil || asn == 0 {
+		return 0, fmt.Errorf("invalid autonomous system number %q", value)
+	}
+
+	return asn, nil
+}
@@ -0,0 +1,151 @@
+package geoip
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestGeoIP_Lookup(t *testing.T) {
+	geoIP, err := New("./fixtures/country.mmdb", "./fixtures/asn.mmdb", nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		addr          string
+		expected      Location
+		expectedError bool
+	}{
+		{
+			addr:     "1.2.3.4",
+			expected: Location{Country: "FR", ASN: 64512, ASOrganization: "Example FR"},
+		},
+		{
+			addr:     "5.6.7.8",
+			expected: Location{Country: "DE", ASN: 64513, ASOrganization: "Example DE"},
+		},
+		{
+			addr:     "2001:db8::1",
+			expected: Location{Country: "US"},
+		},
+		{
+			addr: "9.9.9.9",
+		},
+		{
+			addr:          "foo",
+			expectedError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.addr, func(t *testing.T) {
+			t.Parallel()
+
+			location, err := geoIP.Lookup(test.addr)
+			if test.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, location)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New("", "", nil)
+	require.Error(t, err)
+
+	_, err = New("./fixtures/missing.mmdb", "", nil)
+	require.Error(t, err)
+
+	_, err = New("./fixtures/country.mmdb", "", &dynamic.IPStrategy{IPv6Subnet: new(int)})
+	require.Error(t, err)
+}
+
+func TestGeoIP_ClientIP(t *testing.T) {
+	geoIP, err := New("./fixtures/country.mmdb", "", &dynamic.IPStrategy{Depth: 1})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+	req.Header.Set("X-Forwarded-For", "5.6.7.8, 1.2.3.4")
+
+	assert.Equal(t, "1.2.3.4", geoIP.ClientIP(req))
+}
+
+func TestGeoIP_LookupRequest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "country.mmdb")
+
+	writeDatabase(t, path, "Test", map[string]map[string]any{
+		"1.2.3.0/24": {"country": map[string]any{"iso_code": "FR"}},
+	})
+
+	geoIP, err := New(path, "", nil)
+	require.NoError(t, err)
+
+	var countries []string
+	handler := geoIP.Handler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		location, err := geoIP.LookupRequest(req, "1.2.3.4")
+		require.NoError(t, err)
+		countries = append(countries, location.Country)
+
+		// The locations looked up while handling the request are not looked up again.
+		writeDatabase(t, path, "Test", map[string]map[string]any{
+			"1.2.3.0/24": {"country": map[string]any{"iso_code": "IT"}},
+		})
+		require.NoError(t, geoIP.load(path, &geoIP.countryDB))
+
+		location, err = geoIP.LookupRequest(req, "1.2.3.4")
+		require.NoError(t, err)
+		countries = append(countries, location.Country)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "http://localhost", nil))
+
+	assert.Equal(t, []string{"FR", "FR"}, countries)
+
+	// Without memoized locations, the address is looked up in the current database.
+	location, err := geoIP.LookupRequest(httptest.NewRequest(http.MethodGet, "http://localhost", nil), "1.2.3.4")
+	require.NoError(t, err)
+	assert.Equal(t, "IT", location.Country)
+}
+
+func TestGeoIP_Watch(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "country.mmdb")
+
+	writeDatabase(t, path, "Test", map[string]map[string]any{
+		"1.2.3.0/24": {"country": map[string]any{"iso_code": "FR"}},
+	})
+
+	geoIP, err := New(path, "", nil)
+	require.NoError(t, err)
+
+	go geoIP.Watch(t.Context())
+
+	location, err := geoIP.Lookup("1.2.3.4")
+	require.NoError(t, err)
+	assert.Equal(t, "FR", location.Country)
+
+	// Let the watcher start before replacing the database.
+	time.Sleep(100 * time.Millisecond)
+
+	// The database is replaced with a rename, as done by geoipupdate.
+	tmpPath := filepath.Join(dir, "country.mmdb.tmp")
+	writeDatabase(t, tmpPath, "Test", map[string]map[string]any{
+		"1.2.3.0/24": {"country": map[string]any{"iso_code": "IT"}},
+	})
+	require.NoError(t, os.Rename(tmpPath, path))
+
+	assert.Eventually(t, func() bool {
+		location, err := geoIP.Lookup("1.2.3.4")
+		return err == nil && location.Country == "IT"
+	}, 5*time.Second, 10*time.Millisecond)
+}
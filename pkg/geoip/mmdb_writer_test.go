@@ -0,0 +1,194 @@
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/netip"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// metadataMarker marks the beginning of the metadata section of a MaxMind DB file.
+var metadataMarker = []byte("\xAB\xCD\xEFMaxMind.com")
+
+// dataSectionSeparatorSize is the size of the zeroed section between the search tree and the data section.
+const dataSectionSeparatorSize = 16
+
+// MaxMind DB data types written by the test databases, as defined by https://maxmind.github.io/MaxMind-DB/.
+const (
+	typeString  = 2
+	typeMap     = 7
+	typeUint64  = 9
+	typeBoolean = 14
+)
+
+// writeDatabase writes a MaxMind DB database holding the given records, keyed by CIDR.
+func writeDatabase(t *testing.T, path, databaseType string, records map[string]map[string]any) {
+	t.Helper()
+
+	err := os.WriteFile(path, buildDatabase(t, databaseType, records), 0o600)
+	require.NoError(t, err)
+}
+
+type testNode struct {
+	id       int
+	children [2]*testNode
+	data     [2]int
+}
+
+// buildDatabase builds an IPv6 MaxMind DB database holding the given records, keyed by CIDR.
+func buildDatabase(t *testing.T, databaseType string, records map[string]map[string]any) []byte {
+	t.Helper()
+
+	var cidrs []string
+	for cidr := range records {
+		cidrs = append(cidrs, cidr)
+	}
+	sort.Strings(cidrs)
+
+	var data bytes.Buffer
+	root := &testNode{data: [2]int{-1, -1}}
+
+	for _, cidr := range cidrs {
+		prefix := netip.MustParsePrefix(cidr)
+
+		key := prefix.Addr().As16()
+		length := prefix.Bits()
+		if prefix.Addr().Is4() {
+			// IPv4 networks are stored in the ::/96 subtree.
+			key = [16]byte{}
+			copy(key[12:], prefix.Addr().AsSlice())
+			length += 96
+		}
+
+		offset := data.Len()
+		encodeValue(&data, records[cidr])
+
+		node := root
+		for i := range length - 1 {
+			bit := int(key[i/8]>>(7-i%8)) & 1
+			if node.children[bit] == nil {
+				node.children[bit] = &testNode{data: [2]int{-1, -1}}
+			}
+			node = node.children[bit]
+		}
+
+		last := length - 1
+		node.data[int(key[last/8]>>(7-last%8))&1] = offset
+	}
+
+	var nodes []*testNode
+	queue := []*testNode{root}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		node.id = len(nodes)
+		nodes = append(nodes, node)
+
+		for _, child := range node.children {
+			if child != nil {
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	nodeCount := len(nodes)
+
+	var tree bytes.Buffer
+	for _, node := range nodes {
+		var values [2]uint32
+		for bit := range 2 {
+			switch {
+			case node.children[bit] != nil:
+				values[bit] = uint32(node.children[bit].id)
+			case node.data[bit] >= 0:
+				values[bit] = uint32(nodeCount + dataSectionSeparatorSize + node.data[bit])
+			default:
+				values[bit] = uint32(nodeCount)
+			}
+		}
+
+		// 24 bits records.
+		tree.Write([]byte{byte(values[0] >> 16), byte(values[0] >> 8), byte(values[0])})
+		tree.Write([]byte{byte(values[1] >> 16), byte(values[1] >> 8), byte(values[1])})
+	}
+
+	var file bytes.Buffer
+	file.Write(tree.Bytes())
+	file.Write(make([]byte, dataSectionSeparatorSize))
+	file.Write(data.Bytes())
+	file.Write(metadataMarker)
+	encodeValue(&file, map[string]any{
+		"node_count":                  uint64(nodeCount),
+		"record_size":                 uint64(24),
+		"ip_version":                  uint64(6),
+		"database_type":               databaseType,
+		"binary_format_major_version": uint64(2),
+		"binary_format_minor_version": uint64(0),
+	})
+
+	return file.Bytes()
+}
+
+func encodeValue(buffer *bytes.Buffer, value any) {
+	switch v := value.(type) {
+	case string:
+		encodeControl(buffer, typeString, len(v))
+		buffer.WriteString(v)
+	case uint64:
+		raw := binary.BigEndian.AppendUint64(nil, v)
+		raw = bytes.TrimLeft(raw, "\x00")
+		encodeControl(buffer, typeUint64, len(raw))
+		buffer.Write(raw)
+	case bool:
+		size := 0
+		if v {
+			size = 1
+		}
+		encodeControl(buffer, typeBoolean, size)
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		encodeControl(buffer, typeMap, len(v))
+		for _, key := range keys {
+			encodeValue(buffer, key)
+			encodeValue(buffer, v[key])
+		}
+	default:
+		panic("unsupported type")
+	}
+}
+
+func encodeControl(buffer *bytes.Buffer, dataType, size int) {
+	var extra []byte
+	switch {
+	case size >= 65821:
+		size -= 65821
+		extra = []byte{byte(size >> 16), byte(size >> 8), byte(size)}
+		size = 31
+	case size >= 285:
+		size -= 285
+		extra = []byte{byte(size >> 8), byte(size)}
+		size = 30
+	case size >= 29:
+		extra = []byte{byte(size - 29)}
+		size = 29
+	}
+
+	if dataType > 7 {
+		buffer.WriteByte(byte(size))
+		buffer.WriteByte(byte(dataType - 7))
+	} else {
+		buffer.WriteByte(byte(dataType<<5 | size))
+	}
+
+	buffer.Write(extra)
+}
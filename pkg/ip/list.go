@@ -0,0 +1,319 @@
+package ip
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"weak"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/safe"
+)
+
+const (
+	listFetchTimeout = 30 * time.Second
+
+	// maxListSize is the maximum size of a list fetched from a URL.
+	maxListSize = 10 << 20
+)
+
+// ListSources holds the sources of the prefixes of a List.
+type ListSources struct {
+	// SourceRange is the static list of IPs or CIDR-Strings.
+	SourceRange []string
+	// Files are the paths of the files listing IPs or CIDR-Strings, one per line.
+	Files []string
+	// URLs are the HTTP(S) URLs of the lists of IPs or CIDR-Strings, one per line.
+	URLs []string
+}
+
+func (s ListSources) isEmpty() bool {
+	return len(s.SourceRange) == 0 && len(s.Files) == 0 && len(s.URLs) == 0
+}
+
+func (s ListSources) isDynamic() bool {
+	return len(s.Files) > 0 || len(s.URLs) > 0
+}
+
+// lists holds the lists in use, by sources and refresh interval,
+// so that the middlewares with the same sources share them across configuration reloads.
+var lists = newListCache()
+
+// List is a set of prefixes loaded from static ranges, files and URLs,
+// which is periodically refreshed in the background.
+// The previous prefixes are used until a refresh succeeds,
+// so that a failing source never makes the lookups fail.
+type List struct {
+	sources ListSources
+	client  *http.Client
+	logger  zerolog.Logger
+
+	// loaded are the last prefixes successfully loaded, by file and URL.
+	// They are only accessed by the initial load, and then by the refresh goroutine.
+	loaded map[string][]netip.Prefix
+
+	tree atomic.Pointer[RadixTree]
+}
+
+// NewList returns the List of the given sources.
+// The static ranges and the files are loaded immediately, while the URLs are loaded in the background,
+// and the sources are refreshed every refreshInterval. A zero refreshInterval disables the refresh.
+// The lists are shared by sources and refresh interval, and only the first call loads them.
+func NewList(ctx context.Context, sources ListSources, refreshInterval time.Duration) (*List, error) {
+	if sources.isEmpty() {
+		return nil, errors.New("no IP sources provided")
+	}
+
+	// The static ranges are part of the configuration, and are checked before sharing the list.
+	tree, err := NewRadixTree(sources.SourceRange)
+	if err != nil {
+		return nil, err
+	}
+
+	return lists.get(ctx, sources, refreshInterval, tree)
+}
+
+// Contains checks if provided address is in the list.
+func (l *List) Contains(addr string) (bool, error) {
+	return l.tree.Load().Contains(addr)
+}
+
+// Len returns the number of prefixes in the list.
+func (l *List) Len() int {
+	return l.tree.Load().Len()
+}
+
+type listCache struct {
+	mu    sync.Mutex
+	lists map[string]weak.Pointer[List]
+}
+
+func newListCache() *listCache {
+	return &listCache{lists: make(map[string]weak.Pointer[List])}
+}
+
+func (c *listCache) get(ctx context.Context, sources ListSources, refreshInterval time.Duration, tree *RadixTree) (*List, error) {
+	rawKey, err := json.Marshal(sources)
+	if err != nil {
+		return nil, fmt.Errorf("computing IP list key: %w", err)
+	}
+	key := fmt.Sprintf("%s/%s", rawKey, refreshInterval)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if list := c.lists[key].Value(); list != nil {
+		return list, nil
+	}
+
+	list := &List{
+		sources: sources,
+		client:  &http.Client{Timeout: listFetchTimeout},
+		loaded:  make(map[string][]netip.Prefix),
+		logger:  log.Ctx(ctx).With().Strs("files", sources.Files).Strs("urls", sources.URLs).Logger(),
+	}
+	list.tree.Store(tree)
+
+	// The files are loaded before the list is used, so that it never lets through the addresses they list.
+	if len(sources.Files) > 0 {
+		list.load(ctx, false)
+	}
+
+	ref := weak.Make(list)
+	c.lists[key] = ref
+	runtime.AddCleanup(list, c.release, key)
+
+	loadURLs := len(sources.URLs) > 0
+	if sources.isDynamic() && (loadURLs || refreshInterval > 0) {
+		// The refresh is stopped, and its pending fetches canceled, once the list is not used anymore.
+		refreshCtx, cancel := context.WithCancel(context.Background())
+		runtime.AddCleanup(list, func(cancel context.CancelFunc) { cancel() }, cancel)
+
+		safe.Go(func() { refresh(refreshCtx, ref, refreshInterval, loadURLs) })
+	}
+
+	return list, nil
+}
+
+// release removes a list which is not used anymore from the cache.
+func (c *listCache) release(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if list, ok := c.lists[key]; ok && list.Value() == nil {
+		delete(c.lists, key)
+	}
+}
+
+// refresh loads the list, when it has URLs, and then reloads it every refreshInterval, until the list is not used anymore.
+// It only holds a weak reference to the list while waiting, so that the list can be collected once dropped by the middlewares.
+func refresh(ctx context.Context, ref weak.Pointer[List], refreshInterval time.Duration, loadURLs bool) {
+	if loadURLs && !reload(ctx, ref) {
+		return
+	}
+
+	if refreshInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !reload(ctx, ref) {
+				return
+			}
+		}
+	}
+}
+
+// reload loads the referenced list, and reports whether it is still used.
+func reload(ctx context.Context, ref weak.Pointer[List]) bool {
+	list := ref.Value()
+	if list == nil {
+		return false
+	}
+
+	list.load(ctx, true)
+
+	return true
+}
+
+// load loads the files, and the URLs when loadURLs is true, of the list.
+// The previous prefixes of a file or URL which cannot be, or is not, loaded are kept.
+func (l *List) load(ctx context.Context, loadURLs bool) {
+	tree, err := NewRadixTree(l.sources.SourceRange)
+	if err != nil {
+		// The static ranges are checked when the list is created.
+		l.logger.Error().Err(err).Msg("Unable to load the IP list")
+		return
+	}
+
+	for _, path := range l.sources.Files {
+		l.loadSource(tree, path, func() ([]netip.Prefix, error) {
+			return l.loadFile(path)
+		})
+	}
+
+	for _, url := range l.sources.URLs {
+		if !loadURLs {
+			// The URL keeps its previous prefixes.
+			for _, prefix := range l.loaded[url] {
+				tree.Insert(prefix)
+			}
+			continue
+		}
+
+		l.loadSource(tree, url, func() ([]netip.Prefix, error) {
+			return l.loadURL(ctx, url)
+		})
+	}
+
+	l.tree.Store(tree)
+
+	l.logger.Debug().Msgf("IP list loaded with %d prefixes", tree.Len())
+}
+
+func (l *List) loadSource(tree *RadixTree, source string, loadFn func() ([]netip.Prefix, error)) {
+	prefixes, err := loadFn()
+	if err != nil {
+		l.logger.Error().Err(err).Str("source", source).Msgf("Unable to load the IP list source, keeping its %d previous prefixes", len(l.loaded[source]))
+	} else {
+		l.loaded[source] = prefixes
+	}
+
+	for _, prefix := range l.loaded[source] {
+		tree.Insert(prefix)
+	}
+}
+
+func (l *List) loadFile(path string) ([]netip.Prefix, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	return parseList(file)
+}
+
+func (l *List) loadURL(ctx context.Context, url string) ([]netip.Prefix, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	// The list is read entirely before being parsed, so that a truncated list is never partially loaded.
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxListSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > maxListSize {
+		return nil, fmt.Errorf("list larger than %d bytes", maxListSize)
+	}
+
+	return parseList(bytes.NewReader(data))
+}
+
+// parseList returns the IPs and CIDR-Strings read from the reader, one per line.
+// Empty lines and comments, starting with # or ;, are ignored,
+// as well as anything following the first field of a line.
+func parseList(reader io.Reader) ([]netip.Prefix, error) {
+	scanner := bufio.NewScanner(reader)
+
+	var prefixes []netip.Prefix
+
+	var lineNumber int
+	for scanner.Scan() {
+		lineNumber++
+
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line, _, _ = strings.Cut(line, ";")
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		prefix, err := ParsePrefix(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return prefixes, nil
+}
@@ -0,0 +1,212 @@
+package ip
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "denylist.txt")
+	err := os.WriteFile(path, []byte("# Denied ranges\n\n10.0.0.0/8 ; SBL1\n192.168.1.1 # single IP\n"), 0o600)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/list" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = fmt.Fprintln(rw, "2001:db8::/32")
+	}))
+	t.Cleanup(server.Close)
+
+	testCases := []struct {
+		desc          string
+		sources       ListSources
+		expectedLen   int
+		expectedError bool
+	}{
+		{
+			desc:          "no sources",
+			expectedError: true,
+		},
+		{
+			desc: "all sources",
+			sources: ListSources{
+				SourceRange: []string{"172.16.0.0/12"},
+				Files:       []string{path},
+				URLs:        []string{server.URL + "/list"},
+			},
+			expectedLen: 4,
+		},
+		{
+			desc:          "invalid source range",
+			sources:       ListSources{SourceRange: []string{"foo"}},
+			expectedError: true,
+		},
+		{
+			desc: "failing sources",
+			sources: ListSources{
+				SourceRange: []string{"172.16.0.0/12"},
+				Files:       []string{filepath.Join(t.TempDir(), "missing.txt")},
+				URLs:        []string{server.URL + "/missing"},
+			},
+			expectedLen: 1,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			list, err := NewList(t.Context(), test.sources, 0)
+			if test.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Eventually(t, func() bool { return list.Len() == test.expectedLen }, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestNewList_filesLoadedImmediately(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "denylist.txt")
+	require.NoError(t, os.WriteFile(path, []byte("10.0.0.0/8\n"), 0o600))
+
+	list, err := NewList(t.Context(), ListSources{Files: []string{path}}, time.Hour)
+	require.NoError(t, err)
+
+	assertContains(t, list, "10.0.0.1")
+}
+
+func TestNewList_shared(t *testing.T) {
+	sources := ListSources{SourceRange: []string{"10.0.0.0/8"}, Files: []string{filepath.Join(t.TempDir(), "missing.txt")}}
+
+	first, err := NewList(t.Context(), sources, time.Minute)
+	require.NoError(t, err)
+
+	second, err := NewList(t.Context(), sources, time.Minute)
+	require.NoError(t, err)
+
+	assert.Same(t, first, second)
+
+	other, err := NewList(t.Context(), sources, time.Hour)
+	require.NoError(t, err)
+
+	assert.NotSame(t, first, other)
+}
+
+func TestList_load(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "denylist.txt")
+	require.NoError(t, os.WriteFile(path, []byte("10.0.0.0/8\n"), 0o600))
+
+	var status atomic.Int64
+	status.Store(http.StatusOK)
+
+	var content atomic.Value
+	content.Store("192.168.0.0/16\n")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(int(status.Load()))
+		_, _ = fmt.Fprint(rw, content.Load())
+	}))
+	t.Cleanup(server.Close)
+
+	list := &List{
+		sources: ListSources{SourceRange: []string{"172.16.0.0/12"}, Files: []string{path}, URLs: []string{server.URL}},
+		client:  server.Client(),
+		logger:  log.Logger,
+		loaded:  make(map[string][]netip.Prefix),
+	}
+
+	list.load(t.Context(), true)
+	assertContains(t, list, "10.0.0.1", "172.16.0.1", "192.168.1.1")
+
+	// The previous prefixes of the failing sources are kept.
+	require.NoError(t, os.WriteFile(path, []byte("foo\n"), 0o600))
+	status.Store(http.StatusInternalServerError)
+
+	list.load(t.Context(), true)
+	assertContains(t, list, "10.0.0.1", "172.16.0.1", "192.168.1.1")
+
+	// The sources are reloaded independently.
+	status.Store(http.StatusOK)
+	content.Store("192.0.2.0/24\n")
+
+	list.load(t.Context(), true)
+	assertContains(t, list, "10.0.0.1", "172.16.0.1", "192.0.2.1")
+
+	ok, err := list.Contains("192.168.1.1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// The URLs are only reloaded when requested, and keep their previous prefixes otherwise.
+	content.Store("198.51.100.0/24\n")
+
+	list.load(t.Context(), false)
+	assertContains(t, list, "10.0.0.1", "172.16.0.1", "192.0.2.1")
+}
+
+func TestList_loadURLTooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write(bytes.Repeat([]byte("10.0.0.0/8\n"), maxListSize/10))
+	}))
+	t.Cleanup(server.Close)
+
+	list := &List{client: server.Client()}
+
+	_, err := list.loadURL(t.Context(), server.URL)
+	require.EqualError(t, err, fmt.Sprintf("list larger than %d bytes", maxListSize))
+}
+
+func TestList_refresh(t *testing.T) {
+	var content atomic.Value
+	content.Store("10.0.0.0/8\n")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = fmt.Fprint(rw, content.Load())
+	}))
+	t.Cleanup(server.Close)
+
+	list, err := NewList(t.Context(), ListSources{URLs: []string{server.URL}}, 10*time.Millisecond)
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		ok, err := list.Contains("10.0.0.1")
+		return err == nil && ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	content.Store("192.168.0.0/16\n")
+	assert.Eventually(t, func() bool {
+		ok, err := list.Contains("192.168.1.1")
+		return err == nil && ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	ok, err := list.Contains("10.0.0.1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func assertContains(t *testing.T, list *List, addrs ...string) {
+	t.Helper()
+
+	for _, addr := range addrs {
+		ok, err := list.Contains(addr)
+		require.NoError(t, err)
+		assert.True(t, ok, addr)
+	}
+}
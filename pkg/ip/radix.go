@@ -0,0 +1,204 @@
+package ip
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"net/netip"
+	"strings"
+)
+
+// keyBits is the size of the radix tree keys.
+// IPv4 addresses are stored as IPv4-mapped IPv6 addresses.
+const keyBits = 128
+
+type radixKey struct {
+	hi, lo uint64
+}
+
+func newRadixKey(addr netip.Addr) radixKey {
+	b := addr.As16()
+
+	var key radixKey
+	for i := range 8 {
+		key.hi = key.hi<<8 | uint64(b[i])
+		key.lo = key.lo<<8 | uint64(b[i+8])
+	}
+
+	return key
+}
+
+// bit returns the bit of the key at the given position, 0 being the most significant bit.
+func (k radixKey) bit(pos int) int {
+	if pos < 64 {
+		return int(k.hi >> (63 - pos) & 1)
+	}
+	return int(k.lo >> (127 - pos) & 1)
+}
+
+// commonPrefixLen returns the number of leading bits shared by both keys.
+func (k radixKey) commonPrefixLen(other radixKey) int {
+	if hi := k.hi ^ other.hi; hi != 0 {
+		return bits.LeadingZeros64(hi)
+	}
+	return 64 + bits.LeadingZeros64(k.lo^other.lo)
+}
+
+// mask returns the key with all the bits after the given length set to zero.
+func (k radixKey) mask(length int) radixKey {
+	switch {
+	case length <= 0:
+		return radixKey{}
+	case length < 64:
+		return radixKey{hi: k.hi &^ (^uint64(0) >> length)}
+	case length < keyBits:
+		return radixKey{hi: k.hi, lo: k.lo &^ (^uint64(0) >> (length - 64))}
+	default:
+		return k
+	}
+}
+
+type radixNode struct {
+	key      radixKey
+	length   int
+	terminal bool
+	children [2]*radixNode
+}
+
+// RadixTree is a path-compressed binary trie of IP prefixes,
+// allowing to check an address against a large set of prefixes without scanning them all.
+// A RadixTree is not safe for concurrent writes,
+// but it can be read concurrently once fully built.
+type RadixTree struct {
+	root *radixNode
+	size int
+}
+
+// NewRadixTree builds a new RadixTree given a list of IPs or CIDR-Strings.
+func NewRadixTree(prefixes []string) (*RadixTree, error) {
+	tree := &RadixTree{}
+
+	for _, value := range prefixes {
+		prefix, err := ParsePrefix(value)
+		if err != nil {
+			return nil, err
+		}
+
+		tree.Insert(prefix)
+	}
+
+	return tree, nil
+}
+
+// Insert adds the prefix to the tree.
+// Prefixes covered by a prefix already in the tree are ignored.
+func (t *RadixTree) Insert(prefix netip.Prefix) {
+	prefix = prefix.Masked()
+
+	length := prefix.Bits()
+	if prefix.Addr().Is4() {
+		length += keyBits - 32
+	}
+
+	key := newRadixKey(prefix.Addr())
+
+	node := &t.root
+	for {
+		current := *node
+		if current == nil {
+			*node = &radixNode{key: key, length: length, terminal: true}
+			t.size++
+			return
+		}
+
+		common := min(key.commonPrefixLen(current.key), current.length, length)
+
+		if common == current.length {
+			if current.terminal {
+				// The prefix is already covered.
+				return
+			}
+
+			if length == current.length {
+				current.terminal = true
+				t.size++
+				return
+			}
+
+			node = &current.children[key.bit(current.length)]
+			continue
+		}
+
+		split := &radixNode{key: key.mask(common), length: common}
+		split.children[current.key.bit(common)] = current
+
+		if length == common {
+			split.terminal = true
+		} else {
+			split.children[key.bit(common)] = &radixNode{key: key, length: length, terminal: true}
+		}
+
+		*node = split
+		t.size++
+		return
+	}
+}
+
+// Contains checks if provided address is in one of the prefixes of the tree.
+func (t *RadixTree) Contains(addr string) (bool, error) {
+	if len(addr) == 0 {
+		return false, errors.New("empty IP address")
+	}
+
+	ipAddr, err := netip.ParseAddr(addr)
+	if err != nil {
+		return false, fmt.Errorf("unable to parse address: %s: %w", addr, err)
+	}
+
+	return t.ContainsAddr(ipAddr), nil
+}
+
+// ContainsAddr checks if provided address is in one of the prefixes of the tree.
+func (t *RadixTree) ContainsAddr(addr netip.Addr) bool {
+	key := newRadixKey(addr)
+
+	for node := t.root; node != nil; {
+		if key.commonPrefixLen(node.key) < node.length {
+			return false
+		}
+
+		if node.terminal {
+			return true
+		}
+
+		node = node.children[key.bit(node.length)]
+	}
+
+	return false
+}
+
+// Len returns the number of prefixes in the tree.
+func (t *RadixTree) Len() int {
+	return t.size
+}
+
+// ParsePrefix parses an IP or a CIDR-String into a prefix.
+// An IP is parsed as a single address prefix.
+func ParsePrefix(value string) (netip.Prefix, error) {
+	if !strings.Contains(value, "/") {
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("parsing IP %s: %w", value, err)
+		}
+
+		addr = addr.WithZone("")
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("parsing CIDR %s: %w", value, err)
+	}
+
+	return prefix, nil
+}
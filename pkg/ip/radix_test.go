@@ -0,0 +1,107 @@
+package ip
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRadixTree_Contains(t *testing.T) {
+	tree, err := NewRadixTree([]string{
+		"10.0.0.0/8",
+		"10.1.0.0/16",
+		"192.168.1.1",
+		"192.168.1.128/25",
+		"2001:db8::/32",
+		"2001:db8:1::1",
+		"fe80::1%eth0",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, tree.Len())
+
+	testCases := []struct {
+		addr     string
+		expected bool
+	}{
+		{addr: "10.0.0.1", expected: true},
+		{addr: "10.255.255.255", expected: true},
+		{addr: "11.0.0.1"},
+		{addr: "192.168.1.1", expected: true},
+		{addr: "192.168.1.2"},
+		{addr: "192.168.1.200", expected: true},
+		{addr: "192.168.1.127"},
+		{addr: "::ffff:10.0.0.1", expected: true},
+		{addr: "2001:db8:ffff::1", expected: true},
+		{addr: "2001:db9::1"},
+		{addr: "fe80::1", expected: true},
+		{addr: "fe80::2"},
+		{addr: "::a00:1"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.addr, func(t *testing.T) {
+			t.Parallel()
+
+			ok, err := tree.Contains(test.addr)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ok)
+		})
+	}
+}
+
+func TestRadixTree_InvalidAddresses(t *testing.T) {
+	_, err := NewRadixTree([]string{"10.0.0.0/33"})
+	require.Error(t, err)
+
+	_, err = NewRadixTree([]string{"foo"})
+	require.Error(t, err)
+
+	tree, err := NewRadixTree([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	_, err = tree.Contains("")
+	require.Error(t, err)
+
+	_, err = tree.Contains("10.0.0.1:80")
+	require.Error(t, err)
+}
+
+func TestRadixTree_MatchesChecker(t *testing.T) {
+	var prefixes []string
+	for i := range 2000 {
+		prefixes = append(prefixes, fmt.Sprintf("%d.%d.%d.0/%d", i%223+1, i*7%256, i*13%256, 8+i%17))
+	}
+
+	tree, err := NewRadixTree(prefixes)
+	require.NoError(t, err)
+
+	checker, err := NewChecker(prefixes)
+	require.NoError(t, err)
+
+	for i := range 5000 {
+		addr := netip.AddrFrom4([4]byte{byte(i % 224), byte(i * 31), byte(i * 17), byte(i)})
+
+		expected, err := checker.Contains(addr.String())
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, tree.ContainsAddr(addr), addr.String())
+	}
+}
+
+func BenchmarkRadixTree_Contains(b *testing.B) {
+	tree := &RadixTree{}
+	for i := range 500000 {
+		tree.Insert(netip.PrefixFrom(netip.AddrFrom4([4]byte{byte(i >> 16), byte(i >> 8), byte(i), 0}), 24))
+	}
+
+	addr := netip.MustParseAddr("7.161.32.10")
+
+	b.ResetTimer()
+	for range b.N {
+		tree.ContainsAddr(addr)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"github.com/traefik/traefik/v3/pkg/middlewares/capture"
+	"github.com/traefik/traefik/v3/pkg/observability"
 	"github.com/traefik/traefik/v3/pkg/observability/logs"
 	"github.com/vulcand/oxy/v2/utils"
 )
@@ -47,10 +48,17 @@ func (f *FieldHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 func AddServiceFields(rw http.ResponseWriter, req *http.Request, next http.Handler, data *LogData) {
 	start := time.Now().UTC()
 
-	next.ServeHTTP(rw, req)
+	upstreamCtx, timings := observability.WithUpstreamTimings(req.Context())
+	next.ServeHTTP(rw, req.WithContext(upstreamCtx))
 
 	// use UTC to handle switchover of daylight saving correctly
 	data.Core[OriginDuration] = time.Now().UTC().Sub(start)
+	data.Core[OriginConnectDuration] = timings.Connect()
+	data.Core[OriginTLSHandshakeDuration] = timings.TLSHandshake()
+	data.Core[OriginTimeToFirstByte] = timings.TimeToFirstByte()
+	if reused, ok := timings.ConnReused(); ok {
+		data.Core[OriginConnReused] = reused
+	}
 	// make copy of headers, so we can ensure there is no subsequent mutation
 	// during response processing
 	data.OriginResponse = make(http.Header)
@@ -30,6 +30,18 @@ const (
 	ClientPort = "ClientPort"
 	// ClientUsername is the map key used for the username provided in the URL, if present.
 	ClientUsername = "ClientUsername"
+	// ClientCountry is the map key used for the ISO 3166-1 alpha-2 code of the client IP country, resolved from the GeoIP databases.
+	ClientCountry = "ClientCountry"
+	// ClientASN is the map key used for the autonomous system number of the client IP, resolved from the GeoIP databases.
+	ClientASN = "ClientASN"
+	// ClientASOrganization is the map key used for the organization owning the autonomous system of the client IP.
+	ClientASOrganization = "ClientASOrganization"
+	// ClientJA3 is the map key used for the JA3 fingerprint hash of the client TLS ClientHello.
+	ClientJA3 = "ClientJA3"
+	// ClientJA4 is the map key used for the JA4 fingerprint of the client TLS ClientHello.
+	ClientJA4 = "ClientJA4"
+	// ClientHTTP2Fingerprint is the map key used for the HTTP/2 fingerprint of the client connection.
+	ClientHTTP2Fingerprint = "ClientHTTP2Fingerprint"
 	// RequestAddr is the map key used for the HTTP Host header (usually IP:port). This is treated as not a header by the Go API.
 	RequestAddr = "RequestAddr"
 	// RequestHost is the map key used for the HTTP Host server name (not including port).
@@ -54,6 +66,14 @@ const (
 	OriginDuration = "OriginDuration"
 	// OriginContentSize is the map key used for the content length specified by the origin server, or 0 if unspecified.
 	OriginContentSize = "OriginContentSize"
+	// OriginConnectDuration is the map key used for the time taken to establish a new connection to the origin server, or 0 if a connection has been reused.
+	OriginConnectDuration = "OriginConnectDuration"
+	// OriginTLSHandshakeDuration is the map key used for the time taken by the TLS handshake with the origin server.
+	OriginTLSHandshakeDuration = "OriginTLSHandshakeDuration"
+	// OriginTimeToFirstByte is the map key used for the time taken by the origin server to send the first byte of its response.
+	OriginTimeToFirstByte = "OriginTimeToFirstByte"
+	// OriginConnReused is the map key used for whether the connection to the origin server has been reused.
+	OriginConnReused = "OriginConnReused"
 	// OriginStatus is the map key used for the HTTP status code returned by the origin server.
 	// If the request was handled by this Traefik instance (e.g. with a redirect), then this value will be absent.
 	OriginStatus = "OriginStatus"
@@ -70,6 +90,10 @@ const (
 	Overhead = "Overhead"
 	// RetryAttempts is the map key used for the amount of attempts the request was retried.
 	RetryAttempts = "RetryAttempts"
+	// WAFMatchedRules is the map key used for the comma-separated IDs of the WAF rules which matched the request.
+	WAFMatchedRules = "WAFMatchedRules"
+	// WAFAction is the map key used for the WAF outcome of the request, either detected or blocked.
+	WAFAction = "WAFAction"
 
 	// TLSVersion is the version of TLS used in the request.
 	TLSVersion = "TLSVersion"
@@ -112,10 +136,18 @@ var defaultCoreKeys = [...]string{
 	ClientHost,
 	ClientPort,
 	ClientUsername,
+	ClientCountry,
+	ClientASN,
+	ClientASOrganization,
+	ClientJA3,
+	ClientJA4,
+	ClientHTTP2Fingerprint,
 	GzipRatio,
 	StartLocal,
 	Overhead,
 	RetryAttempts,
+	WAFMatchedRules,
+	WAFAction,
 	TLSVersion,
 	TLSCipher,
 	TLSClientSubject,
@@ -128,6 +160,10 @@ var defaultCoreKeys = [...]string{
 	RequestScheme,
 	RequestContentSize,
 	OriginDuration,
+	OriginConnectDuration,
+	OriginTLSHandshakeDuration,
+	OriginTimeToFirstByte,
+	OriginConnReused,
 	OriginContentSize,
 	OriginStatus,
 	DownstreamStatus,
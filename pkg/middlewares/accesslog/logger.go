@@ -19,6 +19,8 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/sirupsen/logrus"
 	ptypes "github.com/traefik/paerser/types"
+	"github.com/traefik/traefik/v3/pkg/fingerprint"
+	"github.com/traefik/traefik/v3/pkg/geoip"
 	"github.com/traefik/traefik/v3/pkg/middlewares/capture"
 	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
 	"github.com/traefik/traefik/v3/pkg/observability/logs"
@@ -72,6 +74,7 @@ type Handler struct {
 	httpCodeRanges types.HTTPCodeRanges
 	logHandlerChan chan handlerParams
 	wg             sync.WaitGroup
+	geoIP          *geoip.GeoIP
 }
 
 // NewHandler creates a new Handler.
@@ -169,6 +172,11 @@ func NewHandler(ctx context.Context, config *otypes.AccessLog) (*Handler, error)
 	return logHandler, nil
 }
 
+// SetGeoIP sets the GeoIP databases used to enrich the access logs with the client IP location.
+func (h *Handler) SetGeoIP(geoIP *geoip.GeoIP) {
+	h.geoIP = geoIP
+}
+
 // AliceConstructor returns an alice.Constructor that wraps the Handler (conditionally) in a middleware chain.
 func (h *Handler) AliceConstructor() alice.Constructor {
 	return func(next http.Handler) (http.Handler, error) {
@@ -266,6 +274,14 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request, next http
 		core[ClientHost] = forwardedFor
 	}
 
+	if h.geoIP != nil {
+		h.setLocation(req, core)
+	}
+
+	if fingerprints := fingerprint.FromContext(req.Context()); fingerprints != nil {
+		setFingerprints(fingerprints, core)
+	}
+
 	ctx := req.Context()
 	capt, err := capture.FromContext(ctx)
 	if err != nil {
@@ -300,6 +316,42 @@ func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request, next http
 	next.ServeHTTP(rw, reqWithDataTable)
 }
 
+// setLocation adds the location of the client IP to the log data.
+func (h *Handler) setLocation(req *http.Request, core CoreLogData) {
+	location, err := h.geoIP.LookupRequest(req, h.geoIP.ClientIP(req))
+	if err != nil {
+		log.Ctx(req.Context()).Debug().Err(err).Str(logs.MiddlewareType, "AccessLogs").Msg("Unable to resolve the client IP location")
+		return
+	}
+
+	if location.Country != "" {
+		core[ClientCountry] = location.Country
+	}
+
+	if location.ASN != 0 {
+		core[ClientASN] = location.ASN
+	}
+
+	if location.ASOrganization != "" {
+		core[ClientASOrganization] = location.ASOrganization
+	}
+}
+
+// setFingerprints adds the known client fingerprints to the log data.
+func setFingerprints(fingerprints *fingerprint.Fingerprints, core CoreLogData) {
+	if fingerprints.JA3 != "" {
+		core[ClientJA3] = fingerprints.JA3
+	}
+
+	if fingerprints.JA4 != "" {
+		core[ClientJA4] = fingerprints.JA4
+	}
+
+	if http2 := fingerprints.HTTP2(); http2 != "" {
+		core[ClientHTTP2Fingerprint] = http2
+	}
+}
+
 // Close closes the Logger (i.e. the file, drain logHandlerChan, etc).
 func (h *Handler) Close() error {
 	close(h.logHandlerChan)
@@ -26,6 +26,8 @@ import (
 	"github.com/stretchr/testify/require"
 	ptypes "github.com/traefik/paerser/types"
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/fingerprint"
+	"github.com/traefik/traefik/v3/pkg/geoip"
 	"github.com/traefik/traefik/v3/pkg/middlewares/capture"
 	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
 	otypes "github.com/traefik/traefik/v3/pkg/observability/types"
@@ -979,6 +981,85 @@ func TestLoggerJSON(t *testing.T) {
 	}
 }
 
+func TestLoggerGeoIP(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), logFileNameSuffix)
+
+	logger, err := NewHandler(t.Context(), &otypes.AccessLog{FilePath: logFilePath, Format: JSONFormat})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := logger.Close()
+		require.NoError(t, err)
+	})
+
+	geoIP, err := geoip.New("../../geoip/fixtures/country.mmdb", "../../geoip/fixtures/asn.mmdb", nil)
+	require.NoError(t, err)
+
+	logger.SetGeoIP(geoIP)
+
+	chain := alice.New(capture.Wrap, func(next http.Handler) (http.Handler, error) {
+		return observability.WithObservabilityHandler(next, observability.Observability{AccessLogsEnabled: true}), nil
+	}, logger.AliceConstructor())
+
+	handler, err := chain.Then(http.HandlerFunc(logWriterTestHandlerFunc))
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil)
+	req.RemoteAddr = "1.2.3.4:1234"
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	logData, err := os.ReadFile(logFilePath)
+	require.NoError(t, err)
+
+	jsonData := make(map[string]any)
+	err = json.Unmarshal(logData, &jsonData)
+	require.NoError(t, err)
+
+	assert.Equal(t, "FR", jsonData[ClientCountry])
+	assert.InDelta(t, float64(64512), jsonData[ClientASN], 0)
+	assert.Equal(t, "Example FR", jsonData[ClientASOrganization])
+}
+
+func TestLoggerFingerprints(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), logFileNameSuffix)
+
+	logger, err := NewHandler(t.Context(), &otypes.AccessLog{FilePath: logFilePath, Format: JSONFormat})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := logger.Close()
+		require.NoError(t, err)
+	})
+
+	chain := alice.New(capture.Wrap, func(next http.Handler) (http.Handler, error) {
+		return observability.WithObservabilityHandler(next, observability.Observability{AccessLogsEnabled: true}), nil
+	}, logger.AliceConstructor())
+
+	handler, err := chain.Then(http.HandlerFunc(logWriterTestHandlerFunc))
+	require.NoError(t, err)
+
+	fingerprints := &fingerprint.Fingerprints{
+		JA3: "cd08e31494f9531f560d64c695473da9",
+		JA4: "t13d1516h2_8daaf6152771_e5627efa2ab1",
+	}
+	fingerprints.SetHTTP2("1:65536;2:0;4:6291456;6:262144|15663105|0|m,a,s,p")
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost/foo", nil)
+	req = req.WithContext(fingerprint.NewContext(req.Context(), fingerprints))
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	logData, err := os.ReadFile(logFilePath)
+	require.NoError(t, err)
+
+	jsonData := make(map[string]any)
+	err = json.Unmarshal(logData, &jsonData)
+	require.NoError(t, err)
+
+	assert.Equal(t, "cd08e31494f9531f560d64c695473da9", jsonData[ClientJA3])
+	assert.Equal(t, "t13d1516h2_8daaf6152771_e5627efa2ab1", jsonData[ClientJA4])
+	assert.Equal(t, "1:65536;2:0;4:6291456;6:262144|15663105|0|m,a,s,p", jsonData[ClientHTTP2Fingerprint])
+}
+
 func TestLogger_AbortedRequest(t *testing.T) {
 	expected := map[string]func(t *testing.T, value any){
 		RequestContentSize:             assertFloat64(0),
@@ -2,8 +2,10 @@ package buffering
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
+	"github.com/mailgun/multibuf"
 	"github.com/rs/zerolog"
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
 	"github.com/traefik/traefik/v3/pkg/middlewares"
@@ -15,6 +17,9 @@ const (
 	typeName = "Buffer"
 )
 
+// DefaultMemBodyBytes is the default threshold (in bytes) from which the bodies are buffered on disk instead of in memory.
+const DefaultMemBodyBytes = multibuf.DefaultMemBytes
+
 type buffer struct {
 	name   string
 	buffer *oxybuffer.Buffer
@@ -66,3 +71,21 @@ func (b *buffer) GetTracingInformation() (string, string) {
 func (b *buffer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	b.buffer.ServeHTTP(rw, req)
 }
+
+// BufferRequestBody reads the request body into a buffer which can be read again from its start.
+// The body is kept in memory up to memBytes (DefaultMemBodyBytes when 0), and buffered on disk beyond.
+// When maxBytes is greater than 0, bodies exceeding it are rejected with an error reported by IsBodyTooLarge.
+// The caller must close the returned buffer.
+func BufferRequestBody(req *http.Request, memBytes, maxBytes int64) (multibuf.MultiReader, error) {
+	if maxBytes > 0 && req.ContentLength > maxBytes {
+		return nil, &multibuf.MaxSizeReachedError{MaxSize: maxBytes}
+	}
+
+	return multibuf.New(req.Body, multibuf.MaxBytes(maxBytes), multibuf.MemBytes(memBytes))
+}
+
+// IsBodyTooLarge reports whether the error is due to a body exceeding its maximum size.
+func IsBodyTooLarge(err error) bool {
+	var maxSizeErr *multibuf.MaxSizeReachedError
+	return errors.As(err, &maxSizeErr)
+}
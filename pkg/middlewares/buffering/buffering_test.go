@@ -68,3 +68,62 @@ func TestBuffering(t *testing.T) {
 		})
 	}
 }
+
+func TestBufferRequestBody(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		body          string
+		contentLength int64
+		maxBytes      int64
+		expectedError bool
+	}{
+		{
+			desc:          "unlimited",
+			body:          "foobar",
+			contentLength: -1,
+		},
+		{
+			desc:          "within the limit",
+			body:          "foobar",
+			contentLength: -1,
+			maxBytes:      6,
+		},
+		{
+			desc:          "exceeding the limit",
+			body:          "foobar",
+			contentLength: -1,
+			maxBytes:      5,
+			expectedError: true,
+		},
+		{
+			desc:          "content length exceeding the limit",
+			body:          "foo",
+			contentLength: 6,
+			maxBytes:      5,
+			expectedError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(test.body))
+			req.ContentLength = test.contentLength
+
+			body, err := BufferRequestBody(req, 0, test.maxBytes)
+			if test.expectedError {
+				require.Error(t, err)
+				assert.True(t, IsBodyTooLarge(err))
+				return
+			}
+			require.NoError(t, err)
+
+			t.Cleanup(func() { _ = body.Close() })
+
+			size, err := body.Size()
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(test.body)), size)
+		})
+	}
+}
@@ -0,0 +1,273 @@
+// Package challenge implements a middleware serving a proof-of-work challenge to the clients without a clearance cookie.
+package challenge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/middlewares"
+	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+	"github.com/traefik/traefik/v3/pkg/middlewares/ratelimiter/ttlmap"
+	"github.com/vulcand/oxy/v2/utils"
+	"golang.org/x/time/rate"
+)
+
+const (
+	typeName = "Challenge"
+
+	defaultDifficulty   = 16
+	defaultClearanceTTL = time.Hour
+	defaultCookieName   = "_traefik_clearance"
+
+	maxDifficulty = 32
+	maxSources    = 65536
+
+	// solutionHeader is the request header in which the challenge page sends back the solution of its challenge.
+	solutionHeader = "X-Challenge-Solution"
+)
+
+// challenge is a middleware that serves a proof-of-work challenge page to the clients without a valid clearance cookie,
+// and issues a clearance cookie to the clients solving it.
+type challenge struct {
+	next          http.Handler
+	name          string
+	signer        *signer
+	difficulty    int
+	clearanceTTL  time.Duration
+	cookieName    string
+	cookieDomain  string
+	cookieSecure  bool
+	sourceMatcher utils.SourceExtractor
+	threshold     *threshold
+	now           func() time.Time
+}
+
+// New builds a new Challenge middleware.
+func New(ctx context.Context, next http.Handler, config dynamic.Challenge, name string) (http.Handler, error) {
+	logger := middlewares.GetLogger(ctx, name, typeName)
+	logger.Debug().Msg("Creating middleware")
+
+	if config.Secret == "" {
+		return nil, errors.New("secret is empty, Challenge not created")
+	}
+
+	difficulty := defaultDifficulty
+	if config.Difficulty != nil {
+		difficulty = *config.Difficulty
+	}
+
+	if difficulty < 0 || difficulty > maxDifficulty {
+		return nil, fmt.Errorf("invalid difficulty %d: must be between 0 and %d", difficulty, maxDifficulty)
+	}
+
+	clearanceTTL := time.Duration(config.ClearanceTTL)
+	if clearanceTTL < 0 {
+		return nil, fmt.Errorf("negative value not valid for clearanceTTL: %v", clearanceTTL)
+	}
+	if clearanceTTL == 0 {
+		clearanceTTL = defaultClearanceTTL
+	}
+
+	cookieName := config.CookieName
+	if cookieName == "" {
+		cookieName = defaultCookieName
+	}
+
+	if config.SourceCriterion == nil ||
+		config.SourceCriterion.IPStrategy == nil &&
+			config.SourceCriterion.RequestHeaderName == "" && !config.SourceCriterion.RequestHost {
+		config.SourceCriterion = &dynamic.SourceCriterion{
+			IPStrategy: &dynamic.IPStrategy{},
+		}
+	}
+
+	sourceMatcher, err := middlewares.GetSourceExtractor(logger.WithContext(ctx), config.SourceCriterion)
+	if err != nil {
+		return nil, fmt.Errorf("getting source extractor: %w", err)
+	}
+
+	c := &challenge{
+		next:          next,
+		name:          name,
+		signer:        &signer{secret: []byte(config.Secret)},
+		difficulty:    difficulty,
+		clearanceTTL:  clearanceTTL,
+		cookieName:    cookieName,
+		cookieDomain:  config.CookieDomain,
+		cookieSecure:  config.CookieSecure,
+		sourceMatcher: sourceMatcher,
+		now:           time.Now,
+	}
+
+	if config.Threshold != nil {
+		c.threshold, err = newThreshold(*config.Threshold)
+		if err != nil {
+			return nil, fmt.Errorf("creating threshold: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+func (c *challenge) GetTracingInformation() (string, string) {
+	return c.name, typeName
+}
+
+func (c *challenge) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	logger := middlewares.GetLogger(req.Context(), c.name, typeName)
+	ctx := logger.WithContext(req.Context())
+
+	source, _, err := c.sourceMatcher.Extract(req)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not extract source of request")
+		http.Error(rw, "could not extract source of request", http.StatusInternalServerError)
+		return
+	}
+
+	if c.hasClearance(req, source) {
+		req.Header.Del(solutionHeader)
+		c.next.ServeHTTP(rw, req)
+		return
+	}
+
+	if solution := req.Header.Get(solutionHeader); solution != "" {
+		c.verify(ctx, rw, source, solution)
+		return
+	}
+
+	if c.threshold != nil && c.threshold.allow(source) {
+		c.next.ServeHTTP(rw, req)
+		return
+	}
+
+	logger.Debug().Msg("Challenging request without clearance")
+	observability.SetStatusErrorf(req.Context(), "Challenging request without clearance")
+
+	c.serveChallenge(ctx, rw, source)
+}
+
+// hasClearance reports whether the request holds a valid clearance cookie, issued to its source.
+func (c *challenge) hasClearance(req *http.Request, source string) bool {
+	cookie, err := req.Cookie(c.cookieName)
+	if err != nil {
+		return false
+	}
+
+	return c.signer.verifyClearance(cookie.Value, source, c.now())
+}
+
+// verify checks the solution of a challenge, and issues a clearance cookie if it is valid.
+func (c *challenge) verify(ctx context.Context, rw http.ResponseWriter, source, solution string) {
+	if reason := c.signer.verifySolution(solution, source, c.difficulty, c.now()); reason != "" {
+		log.Ctx(ctx).Debug().Msgf("Rejecting challenge solution: %s", reason)
+		observability.SetStatusErrorf(ctx, "Rejecting challenge solution: %s", reason)
+		middlewares.Reject(ctx, http.StatusForbidden, rw)
+		return
+	}
+
+	expires := c.now().Add(c.clearanceTTL)
+
+	http.SetCookie(rw, &http.Cookie{
+		Name:     c.cookieName,
+		Value:    c.signer.clearance(source, expires),
+		Domain:   c.cookieDomain,
+		Path:     "/",
+		Expires:  expires,
+		MaxAge:   int(c.clearanceTTL.Seconds()),
+		Secure:   c.cookieSecure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func (c *challenge) serveChallenge(ctx context.Context, rw http.ResponseWriter, source string) {
+	token, err := c.signer.challenge(source, c.now().Add(challengeTTL))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not generate challenge")
+		http.Error(rw, "could not generate challenge", http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+	rw.Header().Set("Cache-Control", "no-store")
+	rw.WriteHeader(http.StatusForbidden)
+
+	err = pageTemplate.Execute(rw, pageData{
+		Challenge:      token,
+		Difficulty:     c.difficulty,
+		SolutionHeader: solutionHeader,
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not serve challenge page")
+	}
+}
+
+// threshold tracks the rate of the requests of each source with a token bucket,
+// and allows the requests until the rate is exceeded.
+type threshold struct {
+	rate  rate.Limit
+	burst int
+	// ttl is the duration, in seconds, after which the bucket of an inactive source is removed.
+	ttl     int
+	buckets *ttlmap.Map[*rate.Limiter]
+}
+
+func newThreshold(config dynamic.ChallengeThreshold) (*threshold, error) {
+	if config.Average <= 0 {
+		return nil, fmt.Errorf("invalid average %d: must be greater than 0", config.Average)
+	}
+
+	period := time.Duration(config.Period)
+	if period < 0 {
+		return nil, fmt.Errorf("negative value not valid for period: %v", period)
+	}
+	if period == 0 {
+		period = time.Second
+	}
+
+	rtl := float64(config.Average*int64(time.Second)) / float64(period)
+
+	// As with the RateLimit middleware, the bucket of a source is kept for the time needed to refill it,
+	// plus one second.
+	ttl := 1
+	if rtl >= 1 {
+		ttl++
+	} else {
+		ttl += int(1 / rtl)
+	}
+
+	buckets, err := ttlmap.New[*rate.Limiter](maxSources)
+	if err != nil {
+		return nil, fmt.Errorf("creating ttlmap: %w", err)
+	}
+
+	return &threshold{
+		rate:    rate.Limit(rtl),
+		burst:   int(max(config.Burst, 1)),
+		ttl:     ttl,
+		buckets: buckets,
+	}, nil
+}
+
+// allow reports whether the source is below the threshold.
+func (t *threshold) allow(source string) bool {
+	bucket, exists := t.buckets.Get(source)
+	if !exists {
+		bucket = rate.NewLimiter(t.rate, t.burst)
+	}
+
+	// The expiration is updated on each request, to reflect the activity of the source.
+	if err := t.buckets.Set(source, bucket, t.ttl); err != nil {
+		// The source cannot be tracked, so it is challenged.
+		return false
+	}
+
+	return bucket.Allow()
+}
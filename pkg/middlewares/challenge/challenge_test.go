@@ -0,0 +1,356 @@
+package challenge
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ptypes "github.com/traefik/paerser/types"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		config        dynamic.Challenge
+		expectedError bool
+	}{
+		{
+			desc:          "empty config",
+			config:        dynamic.Challenge{},
+			expectedError: true,
+		},
+		{
+			desc: "valid config",
+			config: dynamic.Challenge{
+				Secret:       "secret",
+				Difficulty:   new(20),
+				ClearanceTTL: ptypes.Duration(time.Minute),
+				SourceCriterion: &dynamic.SourceCriterion{
+					RequestHeaderName: "X-Api-Key",
+				},
+				Threshold: &dynamic.ChallengeThreshold{Average: 10},
+			},
+		},
+		{
+			desc: "difficulty too high",
+			config: dynamic.Challenge{
+				Secret:     "secret",
+				Difficulty: new(33),
+			},
+			expectedError: true,
+		},
+		{
+			desc: "negative clearance TTL",
+			config: dynamic.Challenge{
+				Secret:       "secret",
+				ClearanceTTL: ptypes.Duration(-time.Minute),
+			},
+			expectedError: true,
+		},
+		{
+			desc: "invalid source criterion",
+			config: dynamic.Challenge{
+				Secret: "secret",
+				SourceCriterion: &dynamic.SourceCriterion{
+					IPStrategy:        &dynamic.IPStrategy{},
+					RequestHeaderName: "X-Api-Key",
+				},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "threshold without average",
+			config: dynamic.Challenge{
+				Secret:    "secret",
+				Threshold: &dynamic.ChallengeThreshold{Burst: 10},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			handler, err := New(t.Context(), next, test.config, "traefikTest")
+
+			if test.expectedError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, handler)
+			}
+		})
+	}
+}
+
+func TestNew_difficulty(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		difficulty *int
+		expected   int
+	}{
+		{
+			desc:     "unset difficulty",
+			expected: defaultDifficulty,
+		},
+		{
+			desc:       "no proof of work",
+			difficulty: new(0),
+			expected:   0,
+		},
+		{
+			desc:       "custom difficulty",
+			difficulty: new(20),
+			expected:   20,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			// The config is not initialized with SetDefaults, as when decoded from a provider payload.
+			config := dynamic.Challenge{Secret: "secret", Difficulty: test.difficulty}
+
+			handler, err := New(t.Context(), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), config, "traefikTest")
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, handler.(*challenge).difficulty)
+		})
+	}
+}
+
+func TestChallenge(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	var nextCalls int
+	next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		nextCalls++
+		assert.Empty(t, req.Header.Get(solutionHeader))
+	})
+
+	handler, err := New(t.Context(), next, dynamic.Challenge{
+		Secret:       "secret",
+		Difficulty:   new(8),
+		ClearanceTTL: ptypes.Duration(time.Hour),
+	}, "traefikTest")
+	require.NoError(t, err)
+
+	handler.(*challenge).now = func() time.Time { return now }
+
+	// The requests without a clearance cookie are challenged.
+	rw := serve(t, handler, "10.0.0.1:1234", nil)
+	assert.Equal(t, http.StatusForbidden, rw.Code)
+	assert.Equal(t, "text/html; charset=utf-8", rw.Header().Get("Content-Type"))
+	assert.Equal(t, "no-store", rw.Header().Get("Cache-Control"))
+	assert.Equal(t, 0, nextCalls)
+
+	solution := solve(t, extractChallenge(t, rw.Body.String()), 8)
+
+	// The solution is rejected when sent from another source.
+	rw = serve(t, handler, "10.0.0.2:1234", map[string]string{solutionHeader: solution})
+	assert.Equal(t, http.StatusForbidden, rw.Code)
+	assert.Empty(t, rw.Result().Cookies())
+
+	// The valid solution is exchanged for a clearance cookie.
+	rw = serve(t, handler, "10.0.0.1:1234", map[string]string{solutionHeader: solution})
+	assert.Equal(t, http.StatusNoContent, rw.Code)
+	assert.Equal(t, 0, nextCalls)
+
+	cookies := rw.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, defaultCookieName, cookies[0].Name)
+	assert.True(t, cookies[0].HttpOnly)
+	assert.Equal(t, 3600, cookies[0].MaxAge)
+
+	cookie := cookies[0].Name + "=" + cookies[0].Value
+
+	// The requests holding the clearance cookie are forwarded.
+	rw = serve(t, handler, "10.0.0.1:4321", map[string]string{"Cookie": cookie, solutionHeader: solution})
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, 1, nextCalls)
+
+	// The clearance cookie is only valid for the source it was issued to.
+	rw = serve(t, handler, "10.0.0.2:1234", map[string]string{"Cookie": cookie})
+	assert.Equal(t, http.StatusForbidden, rw.Code)
+	assert.Equal(t, 1, nextCalls)
+
+	// The clearance cookie expires.
+	now = now.Add(time.Hour)
+
+	rw = serve(t, handler, "10.0.0.1:1234", map[string]string{"Cookie": cookie})
+	assert.Equal(t, http.StatusForbidden, rw.Code)
+	assert.Equal(t, 1, nextCalls)
+}
+
+func TestChallenge_invalidSolution(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	s := &signer{secret: []byte("secret")}
+
+	token, err := s.challenge("10.0.0.1", now.Add(challengeTTL))
+	require.NoError(t, err)
+
+	expiredToken, err := s.challenge("10.0.0.1", now)
+	require.NoError(t, err)
+
+	otherSecretToken, err := (&signer{secret: []byte("other")}).challenge("10.0.0.1", now.Add(challengeTTL))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc     string
+		solution string
+	}{
+		{
+			desc:     "missing nonce",
+			solution: token,
+		},
+		{
+			desc:     "non numeric nonce",
+			solution: token + ":abc",
+		},
+		{
+			desc:     "forged challenge",
+			solution: solve(t, "1767272400.AAAAAAAAAAAAAAAAAAAAAA.forged", 8),
+		},
+		{
+			desc:     "challenge signed with another secret",
+			solution: solve(t, otherSecretToken, 8),
+		},
+		{
+			desc:     "expired challenge",
+			solution: solve(t, expiredToken, 8),
+		},
+		{
+			desc:     "insufficient proof of work",
+			solution: unsolved(t, token, 8),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			handler, err := New(t.Context(), next, dynamic.Challenge{Secret: "secret", Difficulty: new(8)}, "traefikTest")
+			require.NoError(t, err)
+
+			handler.(*challenge).now = func() time.Time { return now }
+
+			rw := serve(t, handler, "10.0.0.1:1234", map[string]string{solutionHeader: test.solution})
+
+			assert.Equal(t, http.StatusForbidden, rw.Code)
+			assert.Equal(t, http.StatusText(http.StatusForbidden), rw.Body.String())
+			assert.Empty(t, rw.Result().Cookies())
+		})
+	}
+}
+
+func TestChallenge_threshold(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	handler, err := New(t.Context(), next, dynamic.Challenge{
+		Secret:     "secret",
+		Difficulty: new(0),
+		SourceCriterion: &dynamic.SourceCriterion{
+			RequestHeaderName: "X-Api-Key",
+		},
+		Threshold: &dynamic.ChallengeThreshold{
+			Average: 1,
+			Period:  ptypes.Duration(time.Hour),
+			Burst:   2,
+		},
+	}, "traefikTest")
+	require.NoError(t, err)
+
+	// The requests are served until the source exceeds the threshold.
+	for range 2 {
+		rw := serve(t, handler, "10.0.0.1:1234", map[string]string{"X-Api-Key": "foo"})
+		assert.Equal(t, http.StatusOK, rw.Code)
+	}
+
+	rw := serve(t, handler, "10.0.0.2:1234", map[string]string{"X-Api-Key": "foo"})
+	assert.Equal(t, http.StatusForbidden, rw.Code)
+
+	// The other sources are not challenged.
+	rw = serve(t, handler, "10.0.0.1:1234", map[string]string{"X-Api-Key": "bar"})
+	assert.Equal(t, http.StatusOK, rw.Code)
+
+	// Once cleared, the source is not challenged anymore.
+	solution := solve(t, extractChallenge(t, serve(t, handler, "10.0.0.1:1234", map[string]string{"X-Api-Key": "foo"}).Body.String()), 0)
+
+	rw = serve(t, handler, "10.0.0.1:1234", map[string]string{"X-Api-Key": "foo", solutionHeader: solution})
+	require.Equal(t, http.StatusNoContent, rw.Code)
+
+	cookies := rw.Result().Cookies()
+	require.Len(t, cookies, 1)
+
+	rw = serve(t, handler, "10.0.0.3:1234", map[string]string{"X-Api-Key": "foo", "Cookie": cookies[0].Name + "=" + cookies[0].Value})
+	assert.Equal(t, http.StatusOK, rw.Code)
+}
+
+func serve(t *testing.T, handler http.Handler, remoteAddr string, headers map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/foo", http.NoBody)
+	req.RemoteAddr = remoteAddr
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, req)
+
+	return rw
+}
+
+var challengeRegexp = regexp.MustCompile(`var challenge = "([^"]+)"`)
+
+func extractChallenge(t *testing.T, page string) string {
+	t.Helper()
+
+	matches := challengeRegexp.FindStringSubmatch(page)
+	require.Len(t, matches, 2)
+
+	return matches[1]
+}
+
+// solve returns the first solution of the challenge with the given difficulty, as computed by the challenge page.
+func solve(t *testing.T, challenge string, difficulty int) string {
+	t.Helper()
+
+	for nonce := 0; ; nonce++ {
+		solution := challenge + ":" + strconv.Itoa(nonce)
+		if leadingZeroBits(solution) >= difficulty {
+			return solution
+		}
+	}
+}
+
+// unsolved returns a solution of the challenge which does not meet the given difficulty.
+func unsolved(t *testing.T, challenge string, difficulty int) string {
+	t.Helper()
+
+	for nonce := 0; ; nonce++ {
+		solution := challenge + ":" + strconv.Itoa(nonce)
+		if leadingZeroBits(solution) < difficulty {
+			return solution
+		}
+	}
+}
+
+func leadingZeroBits(solution string) int {
+	hash := sha256.Sum256([]byte(solution))
+	return bits.LeadingZeros32(binary.BigEndian.Uint32(hash[:4]))
+}
@@ -0,0 +1,100 @@
+package challenge
+
+import "html/template"
+
+type pageData struct {
+	Challenge      string
+	Difficulty     int
+	SolutionHeader string
+}
+
+// pageTemplate is the challenge page.
+// Its script looks for a nonce such that the SHA-256 hash of challenge:nonce starts with difficulty zero bits,
+// sends the solution back in the solution header of a request to the same URL, and reloads the page once cleared.
+// SHA-256 is implemented in the script, as the Web Crypto API is only available in secure contexts.
+var pageTemplate = template.Must(template.New("challenge").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex, nofollow">
+<title>Checking your browser</title>
+</head>
+<body>
+<p id="status">Checking your browser before accessing the page. This should only take a few seconds.</p>
+<noscript><p>JavaScript is required to access this page.</p></noscript>
+<script>
+(function () {
+  var challenge = {{.Challenge}}, difficulty = {{.Difficulty}}, solutionHeader = {{.SolutionHeader}};
+
+  // The SHA-256 constants are the fractional parts of the square and cube roots of the first primes.
+  var k = [], h0 = [];
+  for (var n = 2, c = 0; c < 64; n++) {
+    for (var d = 2; d * d <= n && n % d; d++);
+    if (d * d > n) {
+      if (c < 8) h0[c] = Math.pow(n, 1 / 2) * 4294967296 | 0;
+      k[c++] = Math.pow(n, 1 / 3) * 4294967296 | 0;
+    }
+  }
+
+  function sha256(bytes) {
+    var h = h0.slice(), i, j;
+    var l = bytes.length, m = new Uint8Array(((l + 72) >> 6) << 6), w = new Int32Array(64);
+    m.set(bytes);
+    m[l] = 0x80;
+    for (i = 0; i < 4; i++) m[m.length - 1 - i] = (l * 8) >>> (i * 8);
+    for (i = 0; i < m.length; i += 64) {
+      for (j = 0; j < 64; j++) {
+        if (j < 16) {
+          w[j] = m[i + j * 4] << 24 | m[i + j * 4 + 1] << 16 | m[i + j * 4 + 2] << 8 | m[i + j * 4 + 3];
+        } else {
+          var a = w[j - 15], b = w[j - 2];
+          w[j] = ((a >>> 7 | a << 25) ^ (a >>> 18 | a << 14) ^ a >>> 3) + w[j - 7] +
+            ((b >>> 17 | b << 15) ^ (b >>> 19 | b << 13) ^ b >>> 10) + w[j - 16] | 0;
+        }
+      }
+      var s = h.slice();
+      for (j = 0; j < 64; j++) {
+        var e = s[4], f = s[0];
+        var t1 = s[7] + ((e >>> 6 | e << 26) ^ (e >>> 11 | e << 21) ^ (e >>> 25 | e << 7)) + (e & s[5] ^ ~e & s[6]) + k[j] + w[j] | 0;
+        var t2 = ((f >>> 2 | f << 30) ^ (f >>> 13 | f << 19) ^ (f >>> 22 | f << 10)) + (f & s[1] ^ f & s[2] ^ s[1] & s[2]) | 0;
+        s.unshift(t1 + t2 | 0);
+        s[4] = s[4] + t1 | 0;
+        s.pop();
+      }
+      for (j = 0; j < 8; j++) h[j] = h[j] + s[j] | 0;
+    }
+    return h;
+  }
+
+  function fail() {
+    document.getElementById("status").textContent = "The verification failed. Please reload the page.";
+  }
+
+  var encoder = new TextEncoder(), nonce = 0;
+
+  function solve() {
+    for (var end = nonce + 5000; nonce < end; nonce++) {
+      var solution = challenge + ":" + nonce;
+      if (difficulty === 0 || sha256(encoder.encode(solution))[0] >>> (32 - difficulty) === 0) {
+        var headers = {};
+        headers[solutionHeader] = solution;
+        fetch(location.href, {headers: headers, credentials: "same-origin", cache: "no-store"}).then(function (response) {
+          if (response.ok) {
+            location.reload();
+          } else {
+            fail();
+          }
+        }, fail);
+        return;
+      }
+    }
+    setTimeout(solve, 0);
+  }
+
+  solve();
+})();
+</script>
+</body>
+</html>
+`))
@@ -0,0 +1,111 @@
+package challenge
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// challengeTTL is the duration for which a challenge can be solved.
+const challengeTTL = 5 * time.Minute
+
+const (
+	purposeChallenge = "challenge"
+	purposeClearance = "clearance"
+
+	challengeRandomSize = 16
+	maxNonceLength      = 20
+)
+
+// signer issues and verifies the challenges and the clearance cookies,
+// which are signed with an HMAC-SHA256 of their content, their purpose, and the source they were issued to.
+// They are stateless, so that they are valid across several instances of Traefik sharing the same secret.
+type signer struct {
+	secret []byte
+}
+
+// challenge returns a new challenge for the source, in the expires.random.signature format.
+func (s *signer) challenge(source string, expires time.Time) (string, error) {
+	random := make([]byte, challengeRandomSize)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	payload := strconv.FormatInt(expires.Unix(), 10) + "." + base64.RawURLEncoding.EncodeToString(random)
+
+	return payload + "." + s.sign(purposeChallenge, source, payload), nil
+}
+
+// verifySolution returns why the challenge solution is rejected, or an empty string if it is accepted.
+// The solution is made of the challenge and a nonce, in the challenge:nonce format,
+// and the SHA-256 hash of the solution must start with difficulty zero bits.
+func (s *signer) verifySolution(solution, source string, difficulty int, now time.Time) string {
+	token, nonce, ok := cutLast(solution, ":")
+	if !ok || nonce == "" || len(nonce) > maxNonceLength || strings.Trim(nonce, "0123456789") != "" {
+		return "malformed solution"
+	}
+
+	payload, signature, ok := cutLast(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(s.sign(purposeChallenge, source, payload))) {
+		return "invalid challenge signature"
+	}
+
+	expires, _, _ := strings.Cut(payload, ".")
+	if expired(expires, now) {
+		return "expired challenge"
+	}
+
+	hash := sha256.Sum256([]byte(solution))
+	if bits.LeadingZeros32(binary.BigEndian.Uint32(hash[:4])) < difficulty {
+		return "insufficient proof of work"
+	}
+
+	return ""
+}
+
+// clearance returns a clearance cookie value for the source, in the expires.signature format.
+func (s *signer) clearance(source string, expires time.Time) string {
+	payload := strconv.FormatInt(expires.Unix(), 10)
+
+	return payload + "." + s.sign(purposeClearance, source, payload)
+}
+
+// verifyClearance reports whether the clearance cookie value has been issued to the source and has not expired.
+func (s *signer) verifyClearance(value, source string, now time.Time) bool {
+	payload, signature, ok := strings.Cut(value, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(s.sign(purposeClearance, source, payload))) {
+		return false
+	}
+
+	return !expired(payload, now)
+}
+
+func (s *signer) sign(purpose, source, payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(purpose + "\x00" + source + "\x00" + payload))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func expired(expires string, now time.Time) bool {
+	timestamp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return true
+	}
+
+	return !now.Before(time.Unix(timestamp, 0))
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+
+	return s, "", false
+}
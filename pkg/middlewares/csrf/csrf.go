@@ -0,0 +1,282 @@
+package csrf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/middlewares"
+	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+)
+
+const (
+	typeName = "CSRF"
+)
+
+// csrf is a middleware that rejects the cross-site requests using state-changing methods.
+type csrf struct {
+	next                 http.Handler
+	name                 string
+	allowedOrigins       []string
+	allowedOriginRegexes []*regexp.Regexp
+	allowSameSite        bool
+	exemptPaths          []string
+	exemptPathPrefixes   []string
+	token                *token
+	rejectStatusCode     int
+}
+
+// New builds a new CSRF protection middleware.
+func New(ctx context.Context, next http.Handler, config dynamic.CSRF, name string) (http.Handler, error) {
+	logger := middlewares.GetLogger(ctx, name, typeName)
+	logger.Debug().Msg("Creating middleware")
+
+	rejectStatusCode := config.RejectStatusCode
+	// If RejectStatusCode is not given, default to Forbidden (403).
+	if rejectStatusCode == 0 {
+		rejectStatusCode = http.StatusForbidden
+	} else if http.StatusText(rejectStatusCode) == "" {
+		return nil, fmt.Errorf("invalid HTTP status code %d", rejectStatusCode)
+	}
+
+	allowedOrigins := make([]string, 0, len(config.AllowedOriginList))
+	for _, origin := range config.AllowedOriginList {
+		normalized, err := normalizeOrigin(origin)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed origin %q: %w", origin, err)
+		}
+
+		allowedOrigins = append(allowedOrigins, normalized)
+	}
+
+	regexes := make([]*regexp.Regexp, len(config.AllowedOriginListRegex))
+	for i, str := range config.AllowedOriginListRegex {
+		reg, err := regexp.Compile(str)
+		if err != nil {
+			return nil, fmt.Errorf("error occurred during origin parsing: %w", err)
+		}
+		regexes[i] = reg
+	}
+
+	c := &csrf{
+		next:                 next,
+		name:                 name,
+		allowedOrigins:       allowedOrigins,
+		allowedOriginRegexes: regexes,
+		allowSameSite:        config.AllowSameSite,
+		rejectStatusCode:     rejectStatusCode,
+	}
+
+	for _, path := range config.ExemptPaths {
+		if !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("invalid exempt path %q: must start with /", path)
+		}
+
+		if prefix, ok := strings.CutSuffix(path, "*"); ok {
+			c.exemptPathPrefixes = append(c.exemptPathPrefixes, prefix)
+			continue
+		}
+
+		c.exemptPaths = append(c.exemptPaths, path)
+	}
+
+	if config.Token != nil {
+		t, err := newToken(*config.Token)
+		if err != nil {
+			return nil, err
+		}
+
+		c.token = t
+	}
+
+	return c, nil
+}
+
+func (c *csrf) GetTracingInformation() (string, string) {
+	return c.name, typeName
+}
+
+func (c *csrf) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	logger := middlewares.GetLogger(req.Context(), c.name, typeName)
+	ctx := logger.WithContext(req.Context())
+
+	if c.token != nil {
+		c.token.issue(rw, req)
+	}
+
+	if isSafeMethod(req.Method) || c.isExempt(req.URL.Path) {
+		c.next.ServeHTTP(rw, req)
+		return
+	}
+
+	if reason := c.rejectReason(req); reason != "" {
+		logger.Debug().Msgf("Rejecting %s request to %s: %s", req.Method, req.URL.Path, reason)
+		observability.SetStatusErrorf(req.Context(), "Rejecting %s request: %s", req.Method, reason)
+		middlewares.Reject(ctx, c.rejectStatusCode, rw)
+		return
+	}
+
+	c.next.ServeHTTP(rw, req)
+}
+
+// rejectReason returns why the request is rejected, or an empty string if it is accepted.
+func (c *csrf) rejectReason(req *http.Request) string {
+	if reason := c.checkOrigin(req); reason != "" {
+		return reason
+	}
+
+	if c.token != nil {
+		return c.token.check(req)
+	}
+
+	return ""
+}
+
+// checkOrigin checks that the request is not sent from another site.
+// The Sec-Fetch-Site header is used when sent by the browser, and the Origin and Referer headers otherwise.
+// Requests without any of these headers are not sent by browsers, and are accepted.
+func (c *csrf) checkOrigin(req *http.Request) string {
+	origin := req.Header.Get("Origin")
+
+	switch site := req.Header.Get("Sec-Fetch-Site"); site {
+	case "":
+	case "same-origin", "none":
+		return ""
+	case "same-site":
+		if c.allowSameSite || c.isAllowedOrigin(origin) {
+			return ""
+		}
+
+		return "same-site request"
+	default:
+		if c.isAllowedOrigin(origin) {
+			return ""
+		}
+
+		return fmt.Sprintf("%s request from origin %q", site, origin)
+	}
+
+	if origin == "" {
+		referer := req.Header.Get("Referer")
+		if referer == "" {
+			return ""
+		}
+
+		u, err := url.Parse(referer)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Sprintf("invalid referer %q", referer)
+		}
+
+		origin = u.Scheme + "://" + u.Host
+	}
+
+	normalized, err := normalizeOrigin(origin)
+	if err != nil {
+		return fmt.Sprintf("invalid origin %q", origin)
+	}
+
+	if normalized == requestOrigin(req) || c.isAllowedOrigin(normalized) {
+		return ""
+	}
+
+	return fmt.Sprintf("cross-origin request from origin %q", origin)
+}
+
+func (c *csrf) isAllowedOrigin(origin string) bool {
+	if origin == "" || origin == "null" {
+		return false
+	}
+
+	normalized, err := normalizeOrigin(origin)
+	if err != nil {
+		return false
+	}
+
+	if slices.Contains(c.allowedOrigins, normalized) {
+		return true
+	}
+
+	for _, reg := range c.allowedOriginRegexes {
+		if reg.MatchString(origin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *csrf) isExempt(path string) bool {
+	if slices.Contains(c.exemptPaths, path) {
+		return true
+	}
+
+	for _, prefix := range c.exemptPathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSafeMethod reports whether the method is safe, as defined by RFC 9110, and is therefore not protected.
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	default:
+		return false
+	}
+}
+
+// requestOrigin returns the normalized origin of the request.
+func requestOrigin(req *http.Request) string {
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+
+	// The X-Forwarded-Proto header is set, or sanitized, by the entry point.
+	if proto := req.Header.Get("X-Forwarded-Proto"); proto == "http" || proto == "https" {
+		scheme = proto
+	}
+
+	return scheme + "://" + stripDefaultPort(scheme, strings.ToLower(req.Host))
+}
+
+// normalizeOrigin normalizes an origin to the scheme://host[:port] form, without the default port of the scheme.
+func normalizeOrigin(origin string) (string, error) {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return "", err
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return "", errors.New("missing scheme or host")
+	}
+
+	if u.Path != "" && u.Path != "/" || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", errors.New("origin must only contain a scheme, a host and a port")
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+
+	return scheme + "://" + stripDefaultPort(scheme, strings.ToLower(u.Host)), nil
+}
+
+func stripDefaultPort(scheme, host string) string {
+	switch {
+	case scheme == "http" && strings.HasSuffix(host, ":80"):
+		return strings.TrimSuffix(host, ":80")
+	case scheme == "https" && strings.HasSuffix(host, ":443"):
+		return strings.TrimSuffix(host, ":443")
+	default:
+		return host
+	}
+}
@@ -0,0 +1,476 @@
+package csrf
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+const (
+	testSecret  = "secret"
+	testSession = "session-id"
+)
+
+// testToken is a token signed for the testSession session.
+var testToken = newTestToken(testSession)
+
+func newTestToken(session string) string {
+	random := "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+
+	return random + "." + (&token{secret: []byte(testSecret)}).sign(session, random)
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		config        dynamic.CSRF
+		expectedError bool
+	}{
+		{
+			desc:   "empty config",
+			config: dynamic.CSRF{},
+		},
+		{
+			desc: "valid config",
+			config: dynamic.CSRF{
+				AllowedOriginList:      []string{"https://foo.example.com"},
+				AllowedOriginListRegex: []string{`^https://.*\.example\.com$`},
+				ExemptPaths:            []string{"/webhook", "/api/*"},
+				Token:                  &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session", CookieSameSite: "Strict"},
+			},
+		},
+		{
+			desc: "invalid allowed origin",
+			config: dynamic.CSRF{
+				AllowedOriginList: []string{"foo.example.com"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "allowed origin with path",
+			config: dynamic.CSRF{
+				AllowedOriginList: []string{"https://foo.example.com/bar"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "invalid allowed origin regex",
+			config: dynamic.CSRF{
+				AllowedOriginListRegex: []string{"("},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "invalid exempt path",
+			config: dynamic.CSRF{
+				ExemptPaths: []string{"webhook"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "invalid HTTP status code",
+			config: dynamic.CSRF{
+				RejectStatusCode: 600,
+			},
+			expectedError: true,
+		},
+		{
+			desc: "missing token secret",
+			config: dynamic.CSRF{
+				Token: &dynamic.CSRFToken{SessionCookieName: "session"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "missing token session cookie name",
+			config: dynamic.CSRF{
+				Token: &dynamic.CSRFToken{Secret: testSecret},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "invalid cookie same site policy",
+			config: dynamic.CSRF{
+				Token: &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session", CookieSameSite: "foo"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "insecure cookie with none same site policy",
+			config: dynamic.CSRF{
+				Token: &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session", CookieSameSite: "none"},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			handler, err := New(t.Context(), next, test.config, "traefikTest")
+
+			if test.expectedError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, handler)
+			}
+		})
+	}
+}
+
+func TestCSRF_ServeHTTP(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		config         dynamic.CSRF
+		method         string
+		target         string
+		headers        map[string]string
+		expectedStatus int
+	}{
+		{
+			desc:           "safe method from another site",
+			method:         http.MethodGet,
+			headers:        map[string]string{"Sec-Fetch-Site": "cross-site", "Origin": "https://evil.com"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "no browser headers",
+			method:         http.MethodPost,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "same origin fetch metadata",
+			method:         http.MethodPost,
+			headers:        map[string]string{"Sec-Fetch-Site": "same-origin"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "user initiated fetch metadata",
+			method:         http.MethodPost,
+			headers:        map[string]string{"Sec-Fetch-Site": "none"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "cross site fetch metadata",
+			method:         http.MethodPost,
+			headers:        map[string]string{"Sec-Fetch-Site": "cross-site", "Origin": "https://evil.com"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc: "cross site fetch metadata from allowed origin",
+			config: dynamic.CSRF{
+				AllowedOriginList: []string{"https://app.example.com:443"},
+			},
+			method:         http.MethodPut,
+			headers:        map[string]string{"Sec-Fetch-Site": "cross-site", "Origin": "https://app.example.com"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc: "cross site fetch metadata from origin matching regex",
+			config: dynamic.CSRF{
+				AllowedOriginListRegex: []string{`^https://.*\.example\.com$`},
+			},
+			method:         http.MethodDelete,
+			headers:        map[string]string{"Sec-Fetch-Site": "cross-site", "Origin": "https://app.example.com"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "same site fetch metadata",
+			method:         http.MethodPost,
+			headers:        map[string]string{"Sec-Fetch-Site": "same-site", "Origin": "http://app.example.com"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "same site fetch metadata allowed",
+			config:         dynamic.CSRF{AllowSameSite: true},
+			method:         http.MethodPost,
+			headers:        map[string]string{"Sec-Fetch-Site": "same-site", "Origin": "http://app.example.com"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "same origin",
+			method:         http.MethodPost,
+			headers:        map[string]string{"Origin": "http://example.com:80"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "same origin with forwarded proto",
+			method:         http.MethodPost,
+			headers:        map[string]string{"Origin": "https://example.com", "X-Forwarded-Proto": "https"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "cross origin",
+			method:         http.MethodPatch,
+			headers:        map[string]string{"Origin": "https://evil.com"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "null origin",
+			method:         http.MethodPost,
+			headers:        map[string]string{"Origin": "null"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "same origin referer",
+			method:         http.MethodPost,
+			headers:        map[string]string{"Referer": "http://example.com/form?foo=bar"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "cross origin referer",
+			method:         http.MethodPost,
+			headers:        map[string]string{"Referer": "https://evil.com/form"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc: "exempt path",
+			config: dynamic.CSRF{
+				ExemptPaths: []string{"/webhook"},
+			},
+			method:         http.MethodPost,
+			target:         "/webhook",
+			headers:        map[string]string{"Origin": "https://evil.com"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc: "exempt path prefix",
+			config: dynamic.CSRF{
+				ExemptPaths: []string{"/api/*"},
+			},
+			method:         http.MethodPost,
+			target:         "/api/users",
+			headers:        map[string]string{"Origin": "https://evil.com"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc: "not exempt path",
+			config: dynamic.CSRF{
+				ExemptPaths: []string{"/webhook"},
+			},
+			method:         http.MethodPost,
+			target:         "/webhook/foo",
+			headers:        map[string]string{"Origin": "https://evil.com"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "custom reject status code",
+			config:         dynamic.CSRF{RejectStatusCode: http.StatusBadRequest},
+			method:         http.MethodPost,
+			headers:        map[string]string{"Origin": "https://evil.com"},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			desc:           "missing token cookie",
+			config:         dynamic.CSRF{Token: &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session"}},
+			method:         http.MethodPost,
+			headers:        map[string]string{"X-CSRF-Token": testToken},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "missing token",
+			config:         dynamic.CSRF{Token: &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session"}},
+			method:         http.MethodPost,
+			headers:        map[string]string{"Cookie": "session=" + testSession + "; _csrf=" + testToken},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "token mismatch",
+			config:         dynamic.CSRF{Token: &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session"}},
+			method:         http.MethodPost,
+			headers:        map[string]string{"Cookie": "session=" + testSession + "; _csrf=" + testToken, "X-CSRF-Token": "foo"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "unsigned token",
+			config:         dynamic.CSRF{Token: &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session"}},
+			method:         http.MethodPost,
+			headers:        map[string]string{"Cookie": "session=" + testSession + "; _csrf=AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", "X-CSRF-Token": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "token signed with another secret",
+			config:         dynamic.CSRF{Token: &dynamic.CSRFToken{Secret: "other", SessionCookieName: "session"}},
+			method:         http.MethodPost,
+			headers:        map[string]string{"Cookie": "session=" + testSession + "; _csrf=" + testToken, "X-CSRF-Token": testToken},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "token bound to another session",
+			config:         dynamic.CSRF{Token: &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session"}},
+			method:         http.MethodPost,
+			headers:        map[string]string{"Cookie": "session=other; _csrf=" + testToken, "X-CSRF-Token": testToken},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "token without session",
+			config:         dynamic.CSRF{Token: &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session"}},
+			method:         http.MethodPost,
+			headers:        map[string]string{"Cookie": "_csrf=" + testToken, "X-CSRF-Token": testToken},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "valid token",
+			config:         dynamic.CSRF{Token: &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session"}},
+			method:         http.MethodPost,
+			headers:        map[string]string{"Cookie": "session=" + testSession + "; _csrf=" + testToken, "X-CSRF-Token": testToken},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc: "valid token with custom names",
+			config: dynamic.CSRF{Token: &dynamic.CSRFToken{
+				Secret:            testSecret,
+				SessionCookieName: "session",
+				CookieName:        "token",
+				HeaderName:        "X-Token",
+			}},
+			method:         http.MethodPost,
+			headers:        map[string]string{"Cookie": "session=" + testSession + "; token=" + testToken, "X-Token": testToken},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "valid token from another site",
+			config:         dynamic.CSRF{Token: &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session"}},
+			method:         http.MethodPost,
+			headers:        map[string]string{"Cookie": "session=" + testSession + "; _csrf=" + testToken, "X-CSRF-Token": testToken, "Origin": "https://evil.com"},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			handler, err := New(t.Context(), next, test.config, "traefikTest")
+			require.NoError(t, err)
+
+			target := test.target
+			if target == "" {
+				target = "/"
+			}
+
+			req := httptest.NewRequest(test.method, "http://example.com"+target, nil)
+			for name, value := range test.headers {
+				req.Header.Set(name, value)
+			}
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expectedStatus, recorder.Code)
+		})
+	}
+}
+
+func TestCSRF_ServeHTTP_formToken(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		body           string
+		expectedStatus int
+	}{
+		{
+			desc:           "valid token",
+			body:           url.Values{"csrf_token": {testToken}, "foo": {"bar"}}.Encode(),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "token mismatch",
+			body:           url.Values{"csrf_token": {"foo"}, "foo": {"bar"}}.Encode(),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:           "missing token",
+			body:           url.Values{"foo": {"bar"}}.Encode(),
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var forwardedBody string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				forwardedBody = string(body)
+			})
+
+			config := dynamic.CSRF{Token: &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session", FormFieldName: "csrf_token"}}
+			handler, err := New(t.Context(), next, config, "traefikTest")
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "http://example.com/", strings.NewReader(test.body))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.AddCookie(&http.Cookie{Name: "session", Value: testSession})
+			req.AddCookie(&http.Cookie{Name: "_csrf", Value: testToken})
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expectedStatus, recorder.Code)
+			if test.expectedStatus == http.StatusOK {
+				assert.Equal(t, test.body, forwardedBody)
+			}
+		})
+	}
+}
+
+func TestCSRF_ServeHTTP_issueToken(t *testing.T) {
+	var forwardedCookie string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("_csrf")
+		require.NoError(t, err)
+
+		forwardedCookie = cookie.Value
+	})
+
+	config := dynamic.CSRF{Token: &dynamic.CSRFToken{Secret: testSecret, SessionCookieName: "session", CookieSecure: true, CookieSameSite: "strict"}}
+	handler, err := New(t.Context(), next, config, "traefikTest")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	cookies := recorder.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, "_csrf", cookies[0].Name)
+	assert.Equal(t, "/", cookies[0].Path)
+	assert.True(t, cookies[0].Secure)
+	assert.Equal(t, http.SameSiteStrictMode, cookies[0].SameSite)
+	assert.Equal(t, cookies[0].Value, forwardedCookie)
+
+	// A valid token cookie is not issued again.
+	req = httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+	req.AddCookie(cookies[0])
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	assert.Empty(t, recorder.Result().Cookies())
+	assert.Equal(t, cookies[0].Value, forwardedCookie)
+
+	// A token cookie bound to a previous session is issued again.
+	req = httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+	req.AddCookie(&http.Cookie{Name: "session", Value: testSession})
+	req.AddCookie(cookies[0])
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	sessionCookies := recorder.Result().Cookies()
+	require.Len(t, sessionCookies, 1)
+	assert.NotEqual(t, cookies[0].Value, sessionCookies[0].Value)
+	assert.Equal(t, sessionCookies[0].Value, forwardedCookie)
+}
@@ -0,0 +1,251 @@
+package csrf
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+const (
+	defaultCookieName = "_csrf"
+	defaultCookiePath = "/"
+	defaultHeaderName = "X-CSRF-Token"
+
+	tokenSize = 32
+
+	// maxFormBodySize is the maximum size of the form bodies read to find the token,
+	// aligned with the limit used by net/http for the url-encoded forms.
+	maxFormBodySize = 10 << 20
+)
+
+// token implements the signed double-submit cookie pattern:
+// a random token, signed with the secret and bound to the session of the client, is issued in a cookie,
+// and must be sent back in a header or a form field.
+type token struct {
+	secret            []byte
+	sessionCookieName string
+	cookieName        string
+	cookieDomain      string
+	cookiePath        string
+	cookieSecure      bool
+	cookieSameSite    http.SameSite
+	headerName        string
+	formFieldName     string
+}
+
+func newToken(config dynamic.CSRFToken) (*token, error) {
+	if config.Secret == "" {
+		return nil, errors.New("token secret is empty")
+	}
+
+	if config.SessionCookieName == "" {
+		return nil, errors.New("token session cookie name is empty")
+	}
+
+	t := &token{
+		secret:            []byte(config.Secret),
+		sessionCookieName: config.SessionCookieName,
+		cookieName:        config.CookieName,
+		cookieDomain:      config.CookieDomain,
+		cookiePath:        config.CookiePath,
+		cookieSecure:      config.CookieSecure,
+		headerName:        config.HeaderName,
+		formFieldName:     config.FormFieldName,
+	}
+
+	if t.cookieName == "" {
+		t.cookieName = defaultCookieName
+	}
+
+	if t.cookiePath == "" {
+		t.cookiePath = defaultCookiePath
+	}
+
+	if t.headerName == "" {
+		t.headerName = defaultHeaderName
+	}
+
+	switch strings.ToLower(config.CookieSameSite) {
+	case "", "lax":
+		t.cookieSameSite = http.SameSiteLaxMode
+	case "strict":
+		t.cookieSameSite = http.SameSiteStrictMode
+	case "none":
+		if !t.cookieSecure {
+			return nil, fmt.Errorf("cookie with %q same site policy must be secure", config.CookieSameSite)
+		}
+		t.cookieSameSite = http.SameSiteNoneMode
+	default:
+		return nil, fmt.Errorf("invalid cookie same site policy %q", config.CookieSameSite)
+	}
+
+	return t, nil
+}
+
+// issue sets a new token cookie on the response when the request does not hold a valid one,
+// for instance when the session of the client has changed.
+// The cookie is also added to the request, so the service can embed the token in its first response.
+func (t *token) issue(rw http.ResponseWriter, req *http.Request) {
+	session := t.session(req)
+	if _, ok := t.cookieValue(req, session); ok {
+		return
+	}
+
+	value, err := t.generate(session)
+	if err != nil {
+		// Nothing is issued, and the state-changing requests will be rejected until a token is issued.
+		return
+	}
+
+	http.SetCookie(rw, &http.Cookie{
+		Name:     t.cookieName,
+		Value:    value,
+		Domain:   t.cookieDomain,
+		Path:     t.cookiePath,
+		Secure:   t.cookieSecure,
+		SameSite: t.cookieSameSite,
+	})
+
+	// The previous token cookie, if any, is replaced in the request.
+	cookies := req.Cookies()
+	req.Header.Del("Cookie")
+	for _, cookie := range cookies {
+		if cookie.Name != t.cookieName {
+			req.AddCookie(cookie)
+		}
+	}
+
+	req.AddCookie(&http.Cookie{Name: t.cookieName, Value: value})
+}
+
+// check returns why the request token is rejected, or an empty string if it is accepted.
+func (t *token) check(req *http.Request) string {
+	expected, ok := t.cookieValue(req, t.session(req))
+	if !ok {
+		return "missing or invalid token cookie"
+	}
+
+	actual := req.Header.Get(t.headerName)
+	if actual == "" && t.formFieldName != "" {
+		var err error
+		actual, err = t.formValue(req)
+		if err != nil {
+			return fmt.Sprintf("unable to read the token form field: %v", err)
+		}
+	}
+
+	if actual == "" {
+		return "missing token"
+	}
+
+	if subtle.ConstantTimeCompare([]byte(actual), []byte(expected)) != 1 {
+		return "token mismatch"
+	}
+
+	return ""
+}
+
+// cookieValue returns the token held by the request cookie, if signed for the given session.
+func (t *token) cookieValue(req *http.Request, session string) (string, bool) {
+	cookie, err := req.Cookie(t.cookieName)
+	if err != nil {
+		return "", false
+	}
+
+	random, signature, ok := strings.Cut(cookie.Value, ".")
+	if !ok {
+		return "", false
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(random)
+	if err != nil || len(raw) != tokenSize {
+		return "", false
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(t.sign(session, random))) {
+		return "", false
+	}
+
+	return cookie.Value, true
+}
+
+// session returns the session identifier held by the request cookie, or an empty string if there is none.
+func (t *token) session(req *http.Request) string {
+	cookie, err := req.Cookie(t.sessionCookieName)
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
+}
+
+// generate returns a new token for the given session, in the random.signature format.
+func (t *token) generate(session string) (string, error) {
+	raw := make([]byte, tokenSize)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+
+	random := base64.RawURLEncoding.EncodeToString(raw)
+
+	return random + "." + t.sign(session, random), nil
+}
+
+func (t *token) sign(session, random string) string {
+	mac := hmac.New(sha256.New, t.secret)
+	mac.Write([]byte(session + "\x00" + random))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// formValue reads the token form field from the request body, which is restored for the next handlers.
+func (t *token) formValue(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil || (mediaType != "application/x-www-form-urlencoded" && mediaType != "multipart/form-data") {
+		return "", nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxFormBodySize+1))
+	if err != nil {
+		return "", err
+	}
+
+	if len(body) > maxFormBodySize {
+		return "", fmt.Errorf("form body exceeds %d bytes", maxFormBodySize)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	// The form is parsed on a clone, to not alter the request forwarded to the service.
+	clone := req.Clone(req.Context())
+	clone.Body = io.NopCloser(bytes.NewReader(body))
+
+	if mediaType == "multipart/form-data" {
+		err = clone.ParseMultipartForm(maxFormBodySize)
+		if clone.MultipartForm != nil {
+			_ = clone.MultipartForm.RemoveAll()
+		}
+	} else {
+		err = clone.ParseForm()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return clone.PostForm.Get(t.formFieldName), nil
+}
@@ -0,0 +1,98 @@
+package timeout
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/middlewares"
+)
+
+const typeName = "RequestTimeout"
+
+// requestTimeout is a middleware bounding the time allowed to handle a request.
+// The deadline is set on the request context, so the proxy aborts the forwarded request when it expires.
+type requestTimeout struct {
+	next    http.Handler
+	name    string
+	timeout time.Duration
+}
+
+// NewRequestTimeout creates a new request timeout middleware.
+func NewRequestTimeout(ctx context.Context, next http.Handler, config dynamic.RequestTimeout, name string) http.Handler {
+	logger := middlewares.GetLogger(ctx, name, typeName)
+	logger.Debug().Msg("Creating middleware")
+
+	return &requestTimeout{
+		next:    next,
+		name:    name,
+		timeout: time.Duration(config.Timeout),
+	}
+}
+
+func (r *requestTimeout) GetTracingInformation() (string, string) {
+	return r.name, typeName
+}
+
+func (r *requestTimeout) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if r.timeout <= 0 {
+		r.next.ServeHTTP(rw, req)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), r.timeout)
+	defer cancel()
+
+	recorder := &headerRecorder{ResponseWriter: rw}
+	r.next.ServeHTTP(recorder, req.WithContext(ctx))
+
+	// The next handler may give up on the request without writing any response,
+	// in which case the timeout error is reported to the client.
+	if !recorder.wroteHeader && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		middlewares.GetLogger(req.Context(), r.name, typeName).Debug().Msgf("Request timed out after %s", r.timeout)
+
+		rw.WriteHeader(http.StatusGatewayTimeout)
+		_, _ = rw.Write([]byte(http.StatusText(http.StatusGatewayTimeout)))
+	}
+}
+
+// headerRecorder records whether the response headers have been written.
+type headerRecorder struct {
+	http.ResponseWriter
+
+	wroteHeader bool
+}
+
+func (h *headerRecorder) WriteHeader(code int) {
+	// The informational responses are forwarded before the final one.
+	if code >= http.StatusOK || code == http.StatusSwitchingProtocols {
+		h.wroteHeader = true
+	}
+	h.ResponseWriter.WriteHeader(code)
+}
+
+func (h *headerRecorder) Write(b []byte) (int, error) {
+	h.wroteHeader = true
+	return h.ResponseWriter.Write(b)
+}
+
+func (h *headerRecorder) Flush() {
+	if f, ok := h.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (h *headerRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := h.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a hijacker: %T", h.ResponseWriter)
+	}
+
+	h.wroteHeader = true
+	return hijacker.Hijack()
+}
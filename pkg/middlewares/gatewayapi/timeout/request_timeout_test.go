@@ -0,0 +1,79 @@
+package timeout
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	ptypes "github.com/traefik/paerser/types"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestRequestTimeout(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		timeout      time.Duration
+		next         http.HandlerFunc
+		wantStatus   int
+		wantDeadline bool
+	}{
+		{
+			desc:    "disabled timeout",
+			timeout: 0,
+			next: func(rw http.ResponseWriter, req *http.Request) {
+				rw.WriteHeader(http.StatusOK)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			desc:    "response within the timeout",
+			timeout: time.Second,
+			next: func(rw http.ResponseWriter, req *http.Request) {
+				rw.WriteHeader(http.StatusOK)
+			},
+			wantStatus:   http.StatusOK,
+			wantDeadline: true,
+		},
+		{
+			desc:    "no response before the deadline",
+			timeout: 10 * time.Millisecond,
+			next: func(rw http.ResponseWriter, req *http.Request) {
+				<-req.Context().Done()
+			},
+			wantStatus:   http.StatusGatewayTimeout,
+			wantDeadline: true,
+		},
+		{
+			desc:    "error response written by the next handler",
+			timeout: 10 * time.Millisecond,
+			next: func(rw http.ResponseWriter, req *http.Request) {
+				<-req.Context().Done()
+				rw.WriteHeader(http.StatusBadGateway)
+			},
+			wantStatus:   http.StatusBadGateway,
+			wantDeadline: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var hasDeadline bool
+			next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				_, hasDeadline = req.Context().Deadline()
+				test.next(rw, req)
+			})
+
+			handler := NewRequestTimeout(t.Context(), next, dynamic.RequestTimeout{Timeout: ptypes.Duration(test.timeout)}, "test")
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://foo.com", http.NoBody))
+
+			assert.Equal(t, test.wantStatus, recorder.Code)
+			assert.Equal(t, test.wantDeadline, hasDeadline)
+		})
+	}
+}
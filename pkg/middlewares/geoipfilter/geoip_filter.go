@@ -0,0 +1,159 @@
+package geoipfilter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/geoip"
+	"github.com/traefik/traefik/v3/pkg/middlewares"
+	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+)
+
+const (
+	typeName = "GeoIPFilter"
+)
+
+// geoIPFilter is a middleware that rejects the requests based on the location of the client IP.
+type geoIPFilter struct {
+	next             http.Handler
+	geoIP            *geoip.GeoIP
+	clientIP         func(req *http.Request) string
+	allowedCountries []string
+	deniedCountries  []string
+	allowedASNs      []uint64
+	deniedASNs       []uint64
+	name             string
+	rejectStatusCode int
+}
+
+// New builds a new GeoIPFilter given lists of allowed and denied countries and autonomous systems.
+func New(ctx context.Context, next http.Handler, config dynamic.GeoIPFilter, geoIP *geoip.GeoIP, name string) (http.Handler, error) {
+	logger := middlewares.GetLogger(ctx, name, typeName)
+	logger.Debug().Msg("Creating middleware")
+
+	if geoIP == nil {
+		return nil, errors.New("the GeoIP databases are not configured, GeoIPFilter not created")
+	}
+
+	if len(config.AllowedCountries) == 0 && len(config.DeniedCountries) == 0 &&
+		len(config.AllowedASNs) == 0 && len(config.DeniedASNs) == 0 {
+		return nil, errors.New("allowed and denied countries and ASNs are empty, GeoIPFilter not created")
+	}
+
+	rejectStatusCode := config.RejectStatusCode
+	// If RejectStatusCode is not given, default to Forbidden (403).
+	if rejectStatusCode == 0 {
+		rejectStatusCode = http.StatusForbidden
+	} else if http.StatusText(rejectStatusCode) == "" {
+		return nil, fmt.Errorf("invalid HTTP status code %d", rejectStatusCode)
+	}
+
+	allowedCountries, err := parseCountries(config.AllowedCountries)
+	if err != nil {
+		return nil, err
+	}
+
+	deniedCountries, err := parseCountries(config.DeniedCountries)
+	if err != nil {
+		return nil, err
+	}
+
+	clientIP := geoIP.ClientIP
+	if config.IPStrategy != nil {
+		strategy, err := config.IPStrategy.Get()
+		if err != nil {
+			return nil, err
+		}
+
+		clientIP = strategy.GetIP
+	}
+
+	logger.Debug().Msgf("Setting up GeoIPFilter with allowed countries: %v, denied countries: %v, allowed ASNs: %v, denied ASNs: %v",
+		allowedCountries, deniedCountries, config.AllowedASNs, config.DeniedASNs)
+
+	return &geoIPFilter{
+		next:             next,
+		geoIP:            geoIP,
+		clientIP:         clientIP,
+		allowedCountries: allowedCountries,
+		deniedCountries:  deniedCountries,
+		allowedASNs:      config.AllowedASNs,
+		deniedASNs:       config.DeniedASNs,
+		name:             name,
+		rejectStatusCode: rejectStatusCode,
+	}, nil
+}
+
+func (f *geoIPFilter) GetTracingInformation() (string, string) {
+	return f.name, typeName
+}
+
+func (f *geoIPFilter) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	logger := middlewares.GetLogger(req.Context(), f.name, typeName)
+	ctx := logger.WithContext(req.Context())
+
+	clientIP := f.clientIP(req)
+
+	location, err := f.geoIP.LookupRequest(req, clientIP)
+	if err != nil {
+		logger.Debug().Msgf("Rejecting IP %s: %v", clientIP, err)
+		observability.SetStatusErrorf(req.Context(), "Rejecting IP %s: %v", clientIP, err)
+		middlewares.Reject(ctx, f.rejectStatusCode, rw)
+		return
+	}
+
+	if reason := f.rejectReason(location); reason != "" {
+		logger.Debug().Msgf("Rejecting IP %s: %s", clientIP, reason)
+		observability.SetStatusErrorf(req.Context(), "Rejecting IP %s: %s", clientIP, reason)
+		middlewares.Reject(ctx, f.rejectStatusCode, rw)
+		return
+	}
+	logger.Debug().Msgf("Accepting IP %s", clientIP)
+
+	f.next.ServeHTTP(rw, req)
+}
+
+// rejectReason returns why the location is rejected, or an empty string if it is accepted.
+// The denied lists take precedence over the allowed ones,
+// and a location must match one of the allowed lists when any is defined.
+func (f *geoIPFilter) rejectReason(location geoip.Location) string {
+	if location.Country != "" && slices.Contains(f.deniedCountries, location.Country) {
+		return fmt.Sprintf("denied country %s", location.Country)
+	}
+
+	if location.ASN != 0 && slices.Contains(f.deniedASNs, location.ASN) {
+		return fmt.Sprintf("denied ASN %d", location.ASN)
+	}
+
+	if len(f.allowedCountries) == 0 && len(f.allowedASNs) == 0 {
+		return ""
+	}
+
+	if location.Country != "" && slices.Contains(f.allowedCountries, location.Country) {
+		return ""
+	}
+
+	if location.ASN != 0 && slices.Contains(f.allowedASNs, location.ASN) {
+		return ""
+	}
+
+	return fmt.Sprintf("country %q and ASN %d not allowed", location.Country, location.ASN)
+}
+
+func parseCountries(values []string) ([]string, error) {
+	var countries []string
+	for _, value := range values {
+		country, err := geoip.ParseCountry(value)
+		if err != nil {
+			return nil, err
+		}
+
+		countries = append(countries, country)
+	}
+
+	return countries, nil
+}
@@ -0,0 +1,190 @@
+package geoipfilter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/geoip"
+)
+
+func TestNewGeoIPFilter(t *testing.T) {
+	geoIP := newGeoIP(t, nil)
+
+	testCases := []struct {
+		desc          string
+		filter        dynamic.GeoIPFilter
+		geoIP         *geoip.GeoIP
+		expectedError bool
+	}{
+		{
+			desc:          "empty config",
+			filter:        dynamic.GeoIPFilter{},
+			geoIP:         geoIP,
+			expectedError: true,
+		},
+		{
+			desc: "GeoIP not configured",
+			filter: dynamic.GeoIPFilter{
+				AllowedCountries: []string{"FR"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "invalid country",
+			filter: dynamic.GeoIPFilter{
+				AllowedCountries: []string{"France"},
+			},
+			geoIP:         geoIP,
+			expectedError: true,
+		},
+		{
+			desc: "invalid HTTP status code",
+			filter: dynamic.GeoIPFilter{
+				DeniedASNs:       []uint64{64512},
+				RejectStatusCode: 600,
+			},
+			geoIP:         geoIP,
+			expectedError: true,
+		},
+		{
+			desc: "valid config",
+			filter: dynamic.GeoIPFilter{
+				AllowedCountries: []string{"fr"},
+			},
+			geoIP: geoIP,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			filter, err := New(t.Context(), next, test.filter, test.geoIP, "traefikTest")
+
+			if test.expectedError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, filter)
+			}
+		})
+	}
+}
+
+func TestGeoIPFilter_ServeHTTP(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		filter     dynamic.GeoIPFilter
+		ipStrategy *dynamic.IPStrategy
+		remoteAddr string
+		xff        string
+		expected   int
+	}{
+		{
+			desc:       "allowed country",
+			filter:     dynamic.GeoIPFilter{AllowedCountries: []string{"FR"}},
+			remoteAddr: "1.2.3.4:1234",
+			expected:   http.StatusOK,
+		},
+		{
+			desc:       "not allowed country",
+			filter:     dynamic.GeoIPFilter{AllowedCountries: []string{"FR"}},
+			remoteAddr: "5.6.7.8:1234",
+			expected:   http.StatusForbidden,
+		},
+		{
+			desc:       "unknown location with allowed countries",
+			filter:     dynamic.GeoIPFilter{AllowedCountries: []string{"FR"}},
+			remoteAddr: "9.9.9.9:1234",
+			expected:   http.StatusForbidden,
+		},
+		{
+			desc:       "denied country",
+			filter:     dynamic.GeoIPFilter{DeniedCountries: []string{"DE"}},
+			remoteAddr: "5.6.7.8:1234",
+			expected:   http.StatusForbidden,
+		},
+		{
+			desc:       "unknown location with denied countries",
+			filter:     dynamic.GeoIPFilter{DeniedCountries: []string{"DE"}},
+			remoteAddr: "9.9.9.9:1234",
+			expected:   http.StatusOK,
+		},
+		{
+			desc:       "allowed ASN",
+			filter:     dynamic.GeoIPFilter{AllowedCountries: []string{"US"}, AllowedASNs: []uint64{64513}},
+			remoteAddr: "5.6.7.8:1234",
+			expected:   http.StatusOK,
+		},
+		{
+			desc:       "denied ASN takes precedence over allowed country",
+			filter:     dynamic.GeoIPFilter{AllowedCountries: []string{"FR"}, DeniedASNs: []uint64{64512}},
+			remoteAddr: "1.2.3.4:1234",
+			expected:   http.StatusForbidden,
+		},
+		{
+			desc: "custom reject status code",
+			filter: dynamic.GeoIPFilter{
+				DeniedCountries:  []string{"DE"},
+				RejectStatusCode: http.StatusNotFound,
+			},
+			remoteAddr: "5.6.7.8:1234",
+			expected:   http.StatusNotFound,
+		},
+		{
+			desc:       "static IP strategy",
+			filter:     dynamic.GeoIPFilter{AllowedCountries: []string{"FR"}},
+			ipStrategy: &dynamic.IPStrategy{Depth: 1},
+			remoteAddr: "5.6.7.8:1234",
+			xff:        "1.2.3.4",
+			expected:   http.StatusOK,
+		},
+		{
+			desc: "middleware IP strategy",
+			filter: dynamic.GeoIPFilter{
+				AllowedCountries: []string{"FR"},
+				IPStrategy:       &dynamic.IPStrategy{},
+			},
+			ipStrategy: &dynamic.IPStrategy{Depth: 1},
+			remoteAddr: "5.6.7.8:1234",
+			xff:        "1.2.3.4",
+			expected:   http.StatusForbidden,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			filter, err := New(t.Context(), next, test.filter, newGeoIP(t, test.ipStrategy), "traefikTest")
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, "http://10.10.10.10", nil)
+			req.RemoteAddr = test.remoteAddr
+			if test.xff != "" {
+				req.Header.Set("X-Forwarded-For", test.xff)
+			}
+
+			filter.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expected, recorder.Code)
+		})
+	}
+}
+
+func newGeoIP(t *testing.T, ipStrategy *dynamic.IPStrategy) *geoip.GeoIP {
+	t.Helper()
+
+	geoIP, err := geoip.New("../../geoip/fixtures/country.mmdb", "../../geoip/fixtures/asn.mmdb", ipStrategy)
+	require.NoError(t, err)
+
+	return geoIP
+}
@@ -4,7 +4,9 @@ package headers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
 	"github.com/traefik/traefik/v3/pkg/middlewares"
@@ -33,6 +35,11 @@ func New(ctx context.Context, next http.Handler, cfg dynamic.Headers, name strin
 		return nil, errors.New("headers configuration not valid")
 	}
 
+	if (cfg.ContentSecurityPolicyNonceHeader != "" || cfg.ContentSecurityPolicyNoncePlaceholder != "") &&
+		!strings.Contains(cfg.ContentSecurityPolicy, cspNoncePlaceholder) && !strings.Contains(cfg.ContentSecurityPolicyReportOnly, cspNoncePlaceholder) {
+		return nil, fmt.Errorf("content security policy nonce options require the %s placeholder in the content security policy", cspNoncePlaceholder)
+	}
+
 	var handler http.Handler
 	nextHandler := next
 
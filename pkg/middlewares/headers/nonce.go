@@ -0,0 +1,150 @@
+package headers
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+)
+
+// nonceInjector is a ResponseWriter replacing the nonce placeholder written by the service in HTML responses with the nonce.
+// Only the placeholder is replaced, so that the tags injected in the page through XSS do not get the nonce.
+// Compressed responses are left untouched.
+type nonceInjector struct {
+	rw          http.ResponseWriter
+	placeholder []byte
+	nonce       []byte
+
+	headersSent bool
+	inject      bool
+	// pending holds the end of the last write, which can be the beginning of a placeholder.
+	pending []byte
+}
+
+func newNonceInjector(rw http.ResponseWriter, placeholder, nonce string) *nonceInjector {
+	return &nonceInjector{
+		rw:          rw,
+		placeholder: []byte(placeholder),
+		nonce:       []byte(nonce),
+	}
+}
+func (n *nonceInjector) Header() http.Header {
+	return n.rw.Header()
+}
+
+func (n *nonceInjector) WriteHeader(code int) {
+	if n.headersSent {
+		return
+	}
+
+	// Handling informational headers.
+	if code >= 100 && code <= 199 && code != http.StatusSwitchingProtocols {
+		n.rw.WriteHeader(code)
+		return
+	}
+
+	n.headersSent = true
+	n.inject = isUncompressedHTML(n.rw.Header())
+	if n.inject {
+		// The length of the body changes with the injected attributes.
+		n.rw.Header().Del("Content-Length")
+	}
+
+	n.rw.WriteHeader(code)
+}
+
+func (n *nonceInjector) Write(b []byte) (int, error) {
+	if !n.headersSent {
+		n.WriteHeader(http.StatusOK)
+	}
+
+	if !n.inject {
+		return n.rw.Write(b)
+	}
+
+	data := b
+	if len(n.pending) > 0 {
+		data = append(n.pending, b...)
+		n.pending = nil
+	}
+
+	if _, err := n.rw.Write(n.rewrite(data)); err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+// close writes the pending data, and must be called once the response is complete.
+func (n *nonceInjector) close() {
+	if len(n.pending) == 0 {
+		return
+	}
+
+	_, _ = n.rw.Write(n.pending)
+	n.pending = nil
+}
+
+// Hijack hijacks the connection.
+func (n *nonceInjector) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := n.rw.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+
+	return nil, nil, fmt.Errorf("not a hijacker: %T", n.rw)
+}
+
+// Flush sends any buffered data to the client.
+// The pending data is kept, as the rest of the tag is not written yet.
+func (n *nonceInjector) Flush() {
+	if flusher, ok := n.rw.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// rewrite replaces the placeholders of data with the nonce.
+// A trailing incomplete placeholder is kept pending until the next write.
+func (n *nonceInjector) rewrite(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+
+	for {
+		i := bytes.Index(data, n.placeholder)
+		if i < 0 {
+			break
+		}
+
+		out = append(out, data[:i]...)
+		out = append(out, n.nonce...)
+		data = data[i+len(n.placeholder):]
+	}
+
+	keep := partialSuffix(data, n.placeholder)
+	if keep > 0 {
+		n.pending = append([]byte(nil), data[len(data)-keep:]...)
+	}
+
+	return append(out, data[:len(data)-keep]...)
+}
+
+// partialSuffix returns the length of the longest suffix of data which is a strict prefix of placeholder.
+func partialSuffix(data, placeholder []byte) int {
+	for size := min(len(data), len(placeholder)-1); size > 0; size-- {
+		if bytes.HasPrefix(placeholder, data[len(data)-size:]) {
+			return size
+		}
+	}
+
+	return 0
+}
+
+func isUncompressedHTML(header http.Header) bool {
+	if encoding := header.Get("Content-Encoding"); encoding != "" && encoding != "identity" {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+
+	return err == nil && mediaType == "text/html"
+}
@@ -0,0 +1,136 @@
+package headers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func Test_nonceInjector(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		header      http.Header
+		chunks      []string
+		expected    string
+		expectedLen string
+	}{
+		{
+			desc:     "HTML response",
+			header:   http.Header{"Content-Type": {"text/html; charset=utf-8"}, "Content-Length": {"42"}},
+			chunks:   []string{`<html><head><script nonce="{{NONCE}}" src="/app.js"></script><style nonce="{{NONCE}}">p{}</style></head></html>`},
+			expected: `<html><head><script nonce="foo" src="/app.js"></script><style nonce="foo">p{}</style></head></html>`,
+		},
+		{
+			desc:     "injected tags",
+			header:   http.Header{"Content-Type": {"text/html"}},
+			chunks:   []string{`<script nonce="{{NONCE}}">1</script><p><script>alert(1)</script><style>p{}</style></p>`},
+			expected: `<script nonce="foo">1</script><p><script>alert(1)</script><style>p{}</style></p>`,
+		},
+		{
+			desc:     "placeholders split across writes",
+			header:   http.Header{"Content-Type": {"text/html"}},
+			chunks:   []string{`<script nonce="{{NO`, `NCE}}">1</script><style nonce="{`, `{`, `NONCE`, `}}"></style>{{NON`},
+			expected: `<script nonce="foo">1</script><style nonce="foo"></style>{{NON`,
+		},
+		{
+			desc:     "partial placeholders",
+			header:   http.Header{"Content-Type": {"text/html"}},
+			chunks:   []string{`{{{NONCE}}`, `{{NONC}} {`, `{{NONCE}}`},
+			expected: `{foo{{NONC}} {foo`,
+		},
+		{
+			desc:        "not an HTML response",
+			header:      http.Header{"Content-Type": {"application/json"}, "Content-Length": {"21"}},
+			chunks:      []string{`{"nonce":"{{NONCE}}"}`},
+			expected:    `{"nonce":"{{NONCE}}"}`,
+			expectedLen: "21",
+		},
+		{
+			desc:     "compressed HTML response",
+			header:   http.Header{"Content-Type": {"text/html"}, "Content-Encoding": {"gzip"}},
+			chunks:   []string{`{{NONCE}}`},
+			expected: `{{NONCE}}`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := httptest.NewRecorder()
+			injector := newNonceInjector(recorder, "{{NONCE}}", "foo")
+
+			for name, values := range test.header {
+				injector.Header()[name] = values
+			}
+
+			for _, chunk := range test.chunks {
+				n, err := injector.Write([]byte(chunk))
+				require.NoError(t, err)
+				assert.Equal(t, len(chunk), n)
+			}
+			injector.close()
+
+			assert.Equal(t, test.expected, recorder.Body.String())
+			assert.Equal(t, test.expectedLen, recorder.Header().Get("Content-Length"))
+		})
+	}
+}
+
+func TestNew_contentSecurityPolicyNonce(t *testing.T) {
+	cfg := dynamic.Headers{
+		ContentSecurityPolicy:                 "script-src 'self' $NONCE",
+		ContentSecurityPolicyNonceHeader:      "X-CSP-Nonce",
+		ContentSecurityPolicyNoncePlaceholder: "{{NONCE}}",
+	}
+
+	var forwardedNonce string
+	next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		forwardedNonce = req.Header.Get("X-CSP-Nonce")
+
+		rw.Header().Set("Content-Type", "text/html")
+		_, _ = rw.Write([]byte(`<script nonce="{{NONCE}}" src="/app.js"></script><script>alert(1)</script>`))
+	})
+
+	handler, err := New(t.Context(), next, cfg, "foo")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+	req.Header.Set("X-CSP-Nonce", "forged")
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	require.NotEmpty(t, forwardedNonce)
+	assert.NotEqual(t, "forged", forwardedNonce)
+	assert.Equal(t, "script-src 'self' 'nonce-"+forwardedNonce+"'", recorder.Header().Get("Content-Security-Policy"))
+	assert.Equal(t, `<script nonce="`+forwardedNonce+`" src="/app.js"></script><script>alert(1)</script>`, recorder.Body.String())
+
+	// A new nonce is generated for each request.
+	firstNonce := forwardedNonce
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://example.com/", nil))
+
+	nonces := regexp.MustCompile(`'nonce-([^']+)'`).FindStringSubmatch(recorder.Header().Get("Content-Security-Policy"))
+	require.Len(t, nonces, 2)
+	assert.Equal(t, forwardedNonce, nonces[1])
+	assert.NotEqual(t, firstNonce, forwardedNonce)
+}
+
+func TestNew_contentSecurityPolicyNonceWithoutPlaceholder(t *testing.T) {
+	cfg := dynamic.Headers{
+		ContentSecurityPolicy:                 "script-src 'self'",
+		ContentSecurityPolicyNoncePlaceholder: "{{NONCE}}",
+	}
+
+	next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {})
+
+	_, err := New(t.Context(), next, cfg, "foo")
+	require.Error(t, err)
+}
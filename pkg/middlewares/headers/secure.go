@@ -9,6 +9,9 @@ import (
 	"k8s.io/utils/ptr"
 )
 
+// cspNoncePlaceholder is replaced by the nonce generated for each request in the content security policies.
+const cspNoncePlaceholder = "$NONCE"
+
 type secureHeader struct {
 	next   http.Handler
 	secure *secure.Secure
@@ -48,6 +51,19 @@ func newSecure(next http.Handler, cfg dynamic.Headers, contextKey string) *secur
 
 func (s secureHeader) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	s.secure.HandlerFuncWithNextForRequestOnly(rw, req, func(writer http.ResponseWriter, request *http.Request) {
-		s.next.ServeHTTP(middlewares.NewResponseModifier(writer, request, s.secure.ModifyResponseHeaders), request)
+		nonce := secure.CSPNonce(request.Context())
+		if nonce != "" && s.cfg.ContentSecurityPolicyNonceHeader != "" {
+			request.Header.Set(s.cfg.ContentSecurityPolicyNonceHeader, nonce)
+		}
+
+		writer = middlewares.NewResponseModifier(writer, request, s.secure.ModifyResponseHeaders)
+		if nonce != "" && s.cfg.ContentSecurityPolicyNoncePlaceholder != "" {
+			injector := newNonceInjector(writer, s.cfg.ContentSecurityPolicyNoncePlaceholder, nonce)
+			defer injector.close()
+
+			writer = injector
+		}
+
+		s.next.ServeHTTP(writer, request)
 	})
 }
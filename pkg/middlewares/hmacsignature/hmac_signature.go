@@ -0,0 +1,345 @@
+package hmacsignature
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/middlewares"
+	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+)
+
+const (
+	typeName = "HMACSignature"
+
+	defaultSignatureHeader = "X-Signature"
+	defaultSignatureParam  = "signature"
+	defaultKeyIDHeader     = "X-Signature-Key-Id"
+	defaultKeyIDParam      = "keyId"
+	defaultExpiresHeader   = "X-Signature-Expires"
+	defaultExpiresParam    = "expires"
+	defaultMaxBodyBytes    = 10 << 20
+)
+
+const (
+	componentMethod = "method"
+	componentPath   = "path"
+	componentQuery  = "query"
+	componentBody   = "body"
+)
+
+var defaultSignedComponents = []string{componentMethod, componentPath, componentQuery}
+
+var errBodyTooLarge = errors.New("request body too large")
+
+// hmacSignature is a middleware that rejects the requests which do not hold a valid and unexpired HMAC signature.
+type hmacSignature struct {
+	next             http.Handler
+	name             string
+	hash             func() hash.Hash
+	algorithm        string
+	keys             []dynamic.HMACKey
+	components       []string
+	signedHeaders    []string
+	signatureHeader  string
+	signatureParam   string
+	keyIDHeader      string
+	keyIDParam       string
+	expiresHeader    string
+	expiresParam     string
+	clockSkew        time.Duration
+	maxLifetime      time.Duration
+	maxBodyBytes     int64
+	rejectStatusCode int
+	now              func() time.Time
+}
+
+// New builds a new HMACSignature middleware.
+func New(ctx context.Context, next http.Handler, config dynamic.HMACSignature, name string) (http.Handler, error) {
+	logger := middlewares.GetLogger(ctx, name, typeName)
+	logger.Debug().Msg("Creating middleware")
+
+	if len(config.Keys) == 0 {
+		return nil, errors.New("keys are empty, HMACSignature not created")
+	}
+
+	for i, key := range config.Keys {
+		if key.Secret == "" {
+			return nil, fmt.Errorf("empty secret for key %d", i)
+		}
+	}
+
+	h := &hmacSignature{
+		next:             next,
+		name:             name,
+		algorithm:        strings.ToLower(config.Algorithm),
+		keys:             config.Keys,
+		components:       config.SignedComponents,
+		signedHeaders:    config.SignedHeaders,
+		signatureHeader:  valueOrDefault(config.SignatureHeader, defaultSignatureHeader),
+		signatureParam:   valueOrDefault(config.SignatureParam, defaultSignatureParam),
+		keyIDHeader:      valueOrDefault(config.KeyIDHeader, defaultKeyIDHeader),
+		keyIDParam:       valueOrDefault(config.KeyIDParam, defaultKeyIDParam),
+		expiresHeader:    valueOrDefault(config.ExpiresHeader, defaultExpiresHeader),
+		expiresParam:     valueOrDefault(config.ExpiresParam, defaultExpiresParam),
+		clockSkew:        time.Duration(config.ClockSkew),
+		maxLifetime:      time.Duration(config.MaxLifetime),
+		maxBodyBytes:     config.MaxBodyBytes,
+		rejectStatusCode: config.RejectStatusCode,
+		now:              time.Now,
+	}
+
+	switch h.algorithm {
+	case "", "sha256":
+		h.algorithm = "sha256"
+		h.hash = sha256.New
+	case "sha384":
+		h.hash = sha512.New384
+	case "sha512":
+		h.hash = sha512.New
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", config.Algorithm)
+	}
+
+	if len(h.components) == 0 {
+		h.components = defaultSignedComponents
+	}
+
+	for _, component := range h.components {
+		switch component {
+		case componentMethod, componentPath, componentQuery, componentBody:
+		default:
+			return nil, fmt.Errorf("unsupported signed component %q", component)
+		}
+	}
+
+	if h.clockSkew < 0 || h.maxLifetime < 0 {
+		return nil, errors.New("clockSkew and maxLifetime must be positive")
+	}
+
+	if h.maxBodyBytes <= 0 {
+		h.maxBodyBytes = defaultMaxBodyBytes
+	}
+
+	// If RejectStatusCode is not given, default to Unauthorized (401).
+	if h.rejectStatusCode == 0 {
+		h.rejectStatusCode = http.StatusUnauthorized
+	} else if http.StatusText(h.rejectStatusCode) == "" {
+		return nil, fmt.Errorf("invalid HTTP status code %d", h.rejectStatusCode)
+	}
+
+	return h, nil
+}
+
+func (h *hmacSignature) GetTracingInformation() (string, string) {
+	return h.name, typeName
+}
+
+func (h *hmacSignature) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	logger := middlewares.GetLogger(req.Context(), h.name, typeName)
+	ctx := logger.WithContext(req.Context())
+
+	if err := h.verify(req); err != nil {
+		statusCode := h.rejectStatusCode
+		if errors.Is(err, errBodyTooLarge) {
+			statusCode = http.StatusRequestEntityTooLarge
+		}
+
+		logger.Debug().Err(err).Msgf("Rejecting %s request to %s", req.Method, req.URL.Path)
+		observability.SetStatusErrorf(req.Context(), "Rejecting request: %s", err)
+		middlewares.Reject(ctx, statusCode, rw)
+		return
+	}
+
+	h.next.ServeHTTP(rw, req)
+}
+
+// verify checks the signature and the expiration time of the request.
+func (h *hmacSignature) verify(req *http.Request) error {
+	query := req.URL.Query()
+
+	signature := headerOrParam(req, query, h.signatureHeader, h.signatureParam)
+	if signature == "" {
+		return errors.New("missing signature")
+	}
+
+	expires := headerOrParam(req, query, h.expiresHeader, h.expiresParam)
+	if expires == "" {
+		return errors.New("missing expiration time")
+	}
+
+	if err := h.checkExpiration(expires); err != nil {
+		return err
+	}
+
+	keys := h.keys
+	if keyID := headerOrParam(req, query, h.keyIDHeader, h.keyIDParam); keyID != "" {
+		keys = nil
+		for _, key := range h.keys {
+			if key.ID == keyID {
+				keys = append(keys, key)
+			}
+		}
+
+		if len(keys) == 0 {
+			return fmt.Errorf("unknown key %q", keyID)
+		}
+	}
+
+	canonical, err := h.canonicalString(req, expires)
+	if err != nil {
+		return err
+	}
+
+	candidates := decodeSignature(strings.TrimPrefix(signature, h.algorithm+"="))
+
+	for _, key := range keys {
+		mac := hmac.New(h.hash, []byte(key.Secret))
+		mac.Write(canonical)
+		expected := mac.Sum(nil)
+
+		for _, candidate := range candidates {
+			if hmac.Equal(candidate, expected) {
+				return nil
+			}
+		}
+	}
+
+	return errors.New("invalid signature")
+}
+
+func (h *hmacSignature) checkExpiration(expires string) error {
+	timestamp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid expiration time %q", expires)
+	}
+
+	now := h.now()
+	expiresAt := time.Unix(timestamp, 0)
+
+	if now.After(expiresAt.Add(h.clockSkew)) {
+		return fmt.Errorf("signature expired at %s", expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	if h.maxLifetime > 0 && expiresAt.Sub(now) > h.maxLifetime+h.clockSkew {
+		return fmt.Errorf("expiration time %s exceeds the maximum lifetime", expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// canonicalString builds the string signed by the client, made of the signed components, the signed headers and the expiration time, separated by new lines.
+func (h *hmacSignature) canonicalString(req *http.Request, expires string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	for _, component := range h.components {
+		switch component {
+		case componentMethod:
+			buf.WriteString(req.Method)
+		case componentPath:
+			buf.WriteString(req.URL.EscapedPath())
+		case componentQuery:
+			query := req.URL.Query()
+			query.Del(h.signatureParam)
+			query.Del(h.keyIDParam)
+			buf.WriteString(query.Encode())
+		case componentBody:
+			digest, err := h.bodyDigest(req)
+			if err != nil {
+				return nil, err
+			}
+			buf.WriteString(digest)
+		}
+		buf.WriteByte('\n')
+	}
+
+	for _, name := range h.signedHeaders {
+		var values []string
+		for _, value := range req.Header.Values(name) {
+			values = append(values, strings.TrimSpace(value))
+		}
+
+		buf.WriteString(strings.ToLower(name))
+		buf.WriteByte(':')
+		buf.WriteString(strings.Join(values, ","))
+		buf.WriteByte('\n')
+	}
+
+	buf.WriteString(expires)
+
+	return buf.Bytes(), nil
+}
+
+// bodyDigest returns the hex-encoded hash of the request body, which is restored for the next handlers.
+func (h *hmacSignature) bodyDigest(req *http.Request) (string, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(io.LimitReader(req.Body, h.maxBodyBytes+1))
+		if err != nil {
+			return "", fmt.Errorf("reading request body: %w", err)
+		}
+
+		if int64(len(body)) > h.maxBodyBytes {
+			return "", errBodyTooLarge
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	hasher := h.hash()
+	hasher.Write(body)
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// decodeSignature returns the possible values of the signature, which can be hex or base64 encoded.
+func decodeSignature(signature string) [][]byte {
+	var candidates [][]byte
+
+	if decoded, err := hex.DecodeString(signature); err == nil {
+		candidates = append(candidates, decoded)
+	}
+
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(signature); err == nil {
+			candidates = append(candidates, decoded)
+		}
+	}
+
+	return candidates
+}
+
+func headerOrParam(req *http.Request, query url.Values, header, param string) string {
+	if value := req.Header.Get(header); value != "" {
+		return value
+	}
+
+	if values := query[param]; len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
@@ -0,0 +1,324 @@
+package hmacsignature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ptypes "github.com/traefik/paerser/types"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		config        dynamic.HMACSignature
+		expectedError bool
+	}{
+		{
+			desc:          "empty config",
+			config:        dynamic.HMACSignature{},
+			expectedError: true,
+		},
+		{
+			desc: "valid config",
+			config: dynamic.HMACSignature{
+				Keys:             []dynamic.HMACKey{{ID: "k1", Secret: "secret"}},
+				Algorithm:        "SHA512",
+				SignedComponents: []string{"method", "body"},
+				SignedHeaders:    []string{"Content-Type"},
+			},
+		},
+		{
+			desc: "empty secret",
+			config: dynamic.HMACSignature{
+				Keys: []dynamic.HMACKey{{ID: "k1"}},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "unsupported algorithm",
+			config: dynamic.HMACSignature{
+				Keys:      []dynamic.HMACKey{{Secret: "secret"}},
+				Algorithm: "md5",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "unsupported component",
+			config: dynamic.HMACSignature{
+				Keys:             []dynamic.HMACKey{{Secret: "secret"}},
+				SignedComponents: []string{"host"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "negative clock skew",
+			config: dynamic.HMACSignature{
+				Keys:      []dynamic.HMACKey{{Secret: "secret"}},
+				ClockSkew: ptypes.Duration(-time.Second),
+			},
+			expectedError: true,
+		},
+		{
+			desc: "invalid HTTP status code",
+			config: dynamic.HMACSignature{
+				Keys:             []dynamic.HMACKey{{Secret: "secret"}},
+				RejectStatusCode: 600,
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			handler, err := New(t.Context(), next, test.config, "traefikTest")
+
+			if test.expectedError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, handler)
+			}
+		})
+	}
+}
+
+func TestHMACSignature_ServeHTTP_signedURL(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	expires := strconv.FormatInt(now.Add(time.Hour).Unix(), 10)
+	expired := strconv.FormatInt(now.Add(-time.Minute).Unix(), 10)
+	skewed := strconv.FormatInt(now.Add(-10*time.Second).Unix(), 10)
+
+	config := dynamic.HMACSignature{
+		Keys: []dynamic.HMACKey{
+			{ID: "old", Secret: "old-secret"},
+			{ID: "new", Secret: "new-secret"},
+		},
+		ClockSkew:   ptypes.Duration(30 * time.Second),
+		MaxLifetime: ptypes.Duration(24 * time.Hour),
+	}
+
+	testCases := []struct {
+		desc           string
+		target         string
+		expectedStatus int
+	}{
+		{
+			desc:           "valid signature",
+			target:         "/files/report.pdf?expires=" + expires + "&signature=" + sign(sha256.New, "new-secret", "GET\n/files/report.pdf\nexpires="+expires+"\n"+expires, hex.EncodeToString),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "valid base64 signature with the rotated key",
+			target:         "/files/report.pdf?expires=" + expires + "&signature=" + sign(sha256.New, "old-secret", "GET\n/files/report.pdf\nexpires="+expires+"\n"+expires, base64.RawURLEncoding.EncodeToString),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "valid signature with key ID",
+			target:         "/files/report.pdf?expires=" + expires + "&keyId=new&signature=" + sign(sha256.New, "new-secret", "GET\n/files/report.pdf\nexpires="+expires+"\n"+expires, hex.EncodeToString),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "signature with another key ID",
+			target:         "/files/report.pdf?expires=" + expires + "&keyId=old&signature=" + sign(sha256.New, "new-secret", "GET\n/files/report.pdf\nexpires="+expires+"\n"+expires, hex.EncodeToString),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc:           "unknown key ID",
+			target:         "/files/report.pdf?expires=" + expires + "&keyId=foo&signature=" + sign(sha256.New, "new-secret", "GET\n/files/report.pdf\nexpires="+expires+"\n"+expires, hex.EncodeToString),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc:           "valid signature with sorted query",
+			target:         "/files?b=2&expires=" + expires + "&a=1&signature=" + sign(sha256.New, "new-secret", "GET\n/files\na=1&b=2&expires="+expires+"\n"+expires, hex.EncodeToString),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "tampered query",
+			target:         "/files?a=2&expires=" + expires + "&signature=" + sign(sha256.New, "new-secret", "GET\n/files\na=1&expires="+expires+"\n"+expires, hex.EncodeToString),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc:           "tampered path",
+			target:         "/files/other.pdf?expires=" + expires + "&signature=" + sign(sha256.New, "new-secret", "GET\n/files/report.pdf\nexpires="+expires+"\n"+expires, hex.EncodeToString),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc:           "unknown secret",
+			target:         "/files/report.pdf?expires=" + expires + "&signature=" + sign(sha256.New, "foo", "GET\n/files/report.pdf\nexpires="+expires+"\n"+expires, hex.EncodeToString),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc:           "expired signature",
+			target:         "/files/report.pdf?expires=" + expired + "&signature=" + sign(sha256.New, "new-secret", "GET\n/files/report.pdf\nexpires="+expired+"\n"+expired, hex.EncodeToString),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc:           "expired signature within the clock skew",
+			target:         "/files/report.pdf?expires=" + skewed + "&signature=" + sign(sha256.New, "new-secret", "GET\n/files/report.pdf\nexpires="+skewed+"\n"+skewed, hex.EncodeToString),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "expiration time exceeding the maximum lifetime",
+			target:         "/files/report.pdf?expires=4102444800&signature=" + sign(sha256.New, "new-secret", "GET\n/files/report.pdf\nexpires=4102444800\n4102444800", hex.EncodeToString),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc:           "invalid expiration time",
+			target:         "/files/report.pdf?expires=tomorrow&signature=" + sign(sha256.New, "new-secret", "GET\n/files/report.pdf\nexpires=tomorrow\ntomorrow", hex.EncodeToString),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc:           "missing expiration time",
+			target:         "/files/report.pdf?signature=" + sign(sha256.New, "new-secret", "GET\n/files/report.pdf\n\n", hex.EncodeToString),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc:           "missing signature",
+			target:         "/files/report.pdf?expires=" + expires,
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			handler, err := New(t.Context(), next, config, "traefikTest")
+			require.NoError(t, err)
+
+			handler.(*hmacSignature).now = func() time.Time { return now }
+
+			req := httptest.NewRequest(http.MethodGet, "http://example.com"+test.target, nil)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expectedStatus, recorder.Code)
+		})
+	}
+}
+
+func TestHMACSignature_ServeHTTP_signedRequest(t *testing.T) {
+	expires := strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10)
+	body := `{"event":"push"}`
+
+	config := dynamic.HMACSignature{
+		Keys:             []dynamic.HMACKey{{ID: "webhook", Secret: "secret"}},
+		Algorithm:        "sha512",
+		SignedComponents: []string{"method", "path", "body"},
+		SignedHeaders:    []string{"Content-Type", "X-Delivery"},
+		MaxBodyBytes:     32,
+	}
+
+	digest := sha512.Sum512([]byte(body))
+	canonical := "POST\n/hooks\n" + hex.EncodeToString(digest[:]) + "\ncontent-type:application/json\nx-delivery:42\n" + expires
+
+	testCases := []struct {
+		desc           string
+		body           string
+		headers        map[string]string
+		expectedStatus int
+	}{
+		{
+			desc: "valid signature",
+			body: body,
+			headers: map[string]string{
+				"X-Signature":         sign(sha512.New, "secret", canonical, hex.EncodeToString),
+				"X-Signature-Expires": expires,
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc: "valid prefixed signature",
+			body: body,
+			headers: map[string]string{
+				"X-Signature":         "sha512=" + sign(sha512.New, "secret", canonical, base64.StdEncoding.EncodeToString),
+				"X-Signature-Expires": expires,
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc: "tampered body",
+			body: `{"event":"pull"}`,
+			headers: map[string]string{
+				"X-Signature":         sign(sha512.New, "secret", canonical, hex.EncodeToString),
+				"X-Signature-Expires": expires,
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc: "tampered signed header",
+			body: body,
+			headers: map[string]string{
+				"X-Signature":         sign(sha512.New, "secret", canonical, hex.EncodeToString),
+				"X-Signature-Expires": expires,
+				"X-Delivery":          "43",
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc: "body too large",
+			body: strings.Repeat("a", 64),
+			headers: map[string]string{
+				"X-Signature":         sign(sha512.New, "secret", canonical, hex.EncodeToString),
+				"X-Signature-Expires": expires,
+			},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var forwardedBody []byte
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var err error
+				forwardedBody, err = io.ReadAll(r.Body)
+				require.NoError(t, err)
+			})
+
+			handler, err := New(t.Context(), next, config, "traefikTest")
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "http://example.com/hooks", strings.NewReader(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Delivery", "42")
+			for name, value := range test.headers {
+				req.Header.Set(name, value)
+			}
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expectedStatus, recorder.Code)
+			if test.expectedStatus == http.StatusOK {
+				assert.Equal(t, test.body, string(forwardedBody))
+			}
+		})
+	}
+}
+
+func sign(hashFunc func() hash.Hash, secret, canonical string, encode func([]byte) string) string {
+	mac := hmac.New(hashFunc, []byte(secret))
+	mac.Write([]byte(canonical))
+
+	return encode(mac.Sum(nil))
+}
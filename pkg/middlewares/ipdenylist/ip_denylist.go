@@ -0,0 +1,106 @@
+package ipdenylist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/ip"
+	"github.com/traefik/traefik/v3/pkg/middlewares"
+	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+)
+
+const (
+	typeName = "IPDenyLister"
+
+	defaultRefreshInterval = time.Hour
+)
+
+// ipDenyLister is a middleware that rejects the requests coming from a set of denied IPs.
+type ipDenyLister struct {
+	next             http.Handler
+	denyList         *ip.List
+	strategy         ip.Strategy
+	name             string
+	rejectStatusCode int
+}
+
+// New builds a new IPDenyLister given a list of CIDR-Strings, files and URLs to deny.
+func New(ctx context.Context, next http.Handler, config dynamic.IPDenyList, name string) (http.Handler, error) {
+	logger := middlewares.GetLogger(ctx, name, typeName)
+	logger.Debug().Msg("Creating middleware")
+
+	if len(config.SourceRange) == 0 && len(config.Files) == 0 && len(config.URLs) == 0 {
+		return nil, errors.New("sourceRange, files and urls are empty, IPDenyLister not created")
+	}
+
+	rejectStatusCode := config.RejectStatusCode
+	// If RejectStatusCode is not given, default to Forbidden (403).
+	if rejectStatusCode == 0 {
+		rejectStatusCode = http.StatusForbidden
+	} else if http.StatusText(rejectStatusCode) == "" {
+		return nil, fmt.Errorf("invalid HTTP status code %d", rejectStatusCode)
+	}
+
+	refreshInterval := time.Duration(config.RefreshInterval)
+	if refreshInterval <= 0 {
+		refreshInterval = defaultRefreshInterval
+	}
+
+	sources := ip.ListSources{
+		SourceRange: config.SourceRange,
+		Files:       config.Files,
+		URLs:        config.URLs,
+	}
+
+	denyList, err := ip.NewList(logger.WithContext(ctx), sources, refreshInterval)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load denied IPs: %w", err)
+	}
+
+	strategy, err := config.IPStrategy.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug().Msgf("Setting up IPDenyLister with %d denied ranges, %d files and %d URLs", len(config.SourceRange), len(config.Files), len(config.URLs))
+
+	return &ipDenyLister{
+		strategy:         strategy,
+		denyList:         denyList,
+		next:             next,
+		name:             name,
+		rejectStatusCode: rejectStatusCode,
+	}, nil
+}
+
+func (dl *ipDenyLister) GetTracingInformation() (string, string) {
+	return dl.name, typeName
+}
+
+func (dl *ipDenyLister) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	logger := middlewares.GetLogger(req.Context(), dl.name, typeName)
+	ctx := logger.WithContext(req.Context())
+
+	clientIP := dl.strategy.GetIP(req)
+	denied, err := dl.denyList.Contains(clientIP)
+	if err != nil {
+		logger.Debug().Msgf("Rejecting IP %s: %v", clientIP, err)
+		observability.SetStatusErrorf(req.Context(), "Rejecting IP %s: %v", clientIP, err)
+		middlewares.Reject(ctx, dl.rejectStatusCode, rw)
+		return
+	}
+
+	if denied {
+		logger.Debug().Msgf("Rejecting denied IP %s", clientIP)
+		observability.SetStatusErrorf(req.Context(), "Rejecting denied IP %s", clientIP)
+		middlewares.Reject(ctx, dl.rejectStatusCode, rw)
+		return
+	}
+	logger.Debug().Msgf("Accepting IP %s", clientIP)
+
+	dl.next.ServeHTTP(rw, req)
+}
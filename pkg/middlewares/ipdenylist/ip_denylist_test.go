@@ -0,0 +1,157 @@
+package ipdenylist
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestNewIPDenyLister(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		denyList      dynamic.IPDenyList
+		expectedError bool
+	}{
+		{
+			desc:          "empty config",
+			denyList:      dynamic.IPDenyList{},
+			expectedError: true,
+		},
+		{
+			desc: "invalid IP",
+			denyList: dynamic.IPDenyList{
+				SourceRange: []string{"foo"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "valid IP",
+			denyList: dynamic.IPDenyList{
+				SourceRange: []string{"10.10.10.10"},
+			},
+		},
+		{
+			desc: "missing file",
+			denyList: dynamic.IPDenyList{
+				Files: []string{filepath.Join(t.TempDir(), "missing.txt")},
+			},
+		},
+		{
+			desc: "invalid HTTP status code",
+			denyList: dynamic.IPDenyList{
+				SourceRange:      []string{"10.10.10.10"},
+				RejectStatusCode: 600,
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			denyLister, err := New(t.Context(), next, test.denyList, "traefikTest")
+
+			if test.expectedError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, denyLister)
+			}
+		})
+	}
+}
+
+func TestIPDenyLister_ServeHTTP(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "denylist.txt")
+	err := os.WriteFile(path, []byte("30.30.30.0/24\n"), 0o600)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc       string
+		denyList   dynamic.IPDenyList
+		remoteAddr string
+		xff        string
+		expected   int
+	}{
+		{
+			desc: "denied with remote address",
+			denyList: dynamic.IPDenyList{
+				SourceRange: []string{"20.20.20.20"},
+			},
+			remoteAddr: "20.20.20.20:1234",
+			expected:   403,
+		},
+		{
+			desc: "accepted with remote address",
+			denyList: dynamic.IPDenyList{
+				SourceRange: []string{"20.20.20.20"},
+			},
+			remoteAddr: "20.20.20.21:1234",
+			expected:   200,
+		},
+		{
+			desc: "denied with remote address from file, reject 404",
+			denyList: dynamic.IPDenyList{
+				Files:            []string{path},
+				RejectStatusCode: 404,
+			},
+			remoteAddr: "30.30.30.30:1234",
+			expected:   404,
+		},
+		{
+			desc: "denied with X-Forwarded-For",
+			denyList: dynamic.IPDenyList{
+				SourceRange: []string{"20.20.20.20"},
+				IPStrategy:  &dynamic.IPStrategy{Depth: 1},
+			},
+			remoteAddr: "10.10.10.10:1234",
+			xff:        "20.20.20.20",
+			expected:   403,
+		},
+		{
+			desc: "accepted with X-Forwarded-For",
+			denyList: dynamic.IPDenyList{
+				SourceRange: []string{"20.20.20.20"},
+				IPStrategy:  &dynamic.IPStrategy{Depth: 1},
+			},
+			remoteAddr: "20.20.20.20:1234",
+			xff:        "10.10.10.10",
+			expected:   200,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			denyLister, err := New(t.Context(), next, test.denyList, "traefikTest")
+			require.NoError(t, err)
+
+			// The files are loaded in the background.
+			assert.Eventually(t, func() bool { return denyLister.(*ipDenyLister).denyList.Len() > 0 }, 5*time.Second, 10*time.Millisecond)
+
+			recorder := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, "http://10.10.10.10", nil)
+			req.RemoteAddr = test.remoteAddr
+
+			if test.xff != "" {
+				req.Header.Set("X-Forwarded-For", test.xff)
+			}
+
+			denyLister.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expected, recorder.Code)
+		})
+	}
+}
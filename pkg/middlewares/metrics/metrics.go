@@ -18,6 +18,7 @@ import (
 	"github.com/traefik/traefik/v3/pkg/middlewares/retry"
 	"github.com/traefik/traefik/v3/pkg/observability/metrics"
 	traefiktls "github.com/traefik/traefik/v3/pkg/tls"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 )
 
@@ -179,12 +180,23 @@ func (m *metricsMiddleware) ServeHTTP(rw http.ResponseWriter, req *http.Request)
 	}
 
 	labels = append(labels, "code", strconv.Itoa(code))
-	m.reqDurationHistogram.With(labels...).ObserveFromStart(start)
+	m.reqDurationHistogram.With(labels...).ObserveFromStartWithExemplar(start, traceExemplar(ctx))
 	m.reqsCounter.With(req.Header, labels...).Add(1)
 	m.respsBytesCounter.With(labels...).Add(float64(capt.ResponseSize()))
 	m.reqsBytesCounter.With(labels...).Add(float64(capt.RequestSize()))
 }
 
+// traceExemplar returns the exemplar labels linking an observation to the current trace.
+// It returns nil when the request is not part of a sampled trace.
+func traceExemplar(ctx context.Context) map[string]string {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() || !spanCtx.IsSampled() {
+		return nil
+	}
+
+	return map[string]string{"trace_id": spanCtx.TraceID().String()}
+}
+
 func getRequestProtocol(req *http.Request) string {
 	switch {
 	case isWebsocketRequest(req):
@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/containous/alice"
+	gokitmetrics "github.com/go-kit/kit/metrics"
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/middlewares"
+	"github.com/traefik/traefik/v3/pkg/middlewares/capture"
+	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+	traefikobservability "github.com/traefik/traefik/v3/pkg/observability"
+	"github.com/traefik/traefik/v3/pkg/observability/metrics"
+)
+
+const nameServer = "metrics-server"
+
+type serverMetricsMiddleware struct {
+	next                          http.Handler
+	reqDurationHistogram          metrics.ScalableHistogram
+	connectDurationHistogram      gokitmetrics.Histogram
+	tlsHandshakeDurationHistogram gokitmetrics.Histogram
+	timeToFirstByteHistogram      gokitmetrics.Histogram
+	connsCounter                  gokitmetrics.Counter
+	baseLabels                    []string
+}
+
+// NewServerMiddleware creates a new metrics middleware for a service server,
+// collecting the upstream timings of the requests forwarded to it.
+func NewServerMiddleware(ctx context.Context, next http.Handler, registry metrics.Registry, serviceName, serverURL string) http.Handler {
+	middlewares.GetLogger(ctx, nameServer, typeName).Debug().Msg("Creating middleware")
+
+	return &serverMetricsMiddleware{
+		next:                          next,
+		reqDurationHistogram:          registry.ServiceServerReqDurationHistogram(),
+		connectDurationHistogram:      registry.ServiceServerConnectDurationHistogram(),
+		tlsHandshakeDurationHistogram: registry.ServiceServerTLSHandshakeDurationHistogram(),
+		timeToFirstByteHistogram:      registry.ServiceServerTimeToFirstByteHistogram(),
+		connsCounter:                  registry.ServiceServerConnsCounter(),
+		baseLabels:                    []string{"service", serviceName, "url", serverURL},
+	}
+}
+
+// ServerMetricsHandler returns the metrics service server handler.
+func ServerMetricsHandler(ctx context.Context, registry metrics.Registry, serviceName, serverURL string) alice.Constructor {
+	return func(next http.Handler) (http.Handler, error) {
+		if registry == nil || !registry.IsServerEnabled() {
+			return next, nil
+		}
+
+		return NewServerMiddleware(ctx, next, registry, serviceName, serverURL), nil
+	}
+}
+
+func (m *serverMetricsMiddleware) GetTracingInformation() (string, string) {
+	return nameServer, typeName
+}
+
+func (m *serverMetricsMiddleware) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if !observability.MetricsEnabled(req.Context()) {
+		m.next.ServeHTTP(rw, req)
+		return
+	}
+
+	ctx := req.Context()
+
+	capt, err := capture.FromContext(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("service", m.baseLabels[1]).Str("url", m.baseLabels[3]).Msg("Could not get Capture")
+		observability.SetStatusErrorf(ctx, "Could not get Capture")
+		return
+	}
+
+	next := m.next
+	if capt.NeedsReset(rw) {
+		next = capt.Reset(m.next)
+	}
+
+	upstreamCtx, timings := traefikobservability.WithUpstreamTimings(ctx)
+
+	start := time.Now()
+	next.ServeHTTP(rw, req.WithContext(upstreamCtx))
+
+	labels := append([]string{"method", getMethod(req), "code", strconv.Itoa(capt.StatusCode())}, m.baseLabels...)
+	m.reqDurationHistogram.With(labels...).ObserveFromStartWithExemplar(start, traceExemplar(ctx))
+
+	if reused, ok := timings.ConnReused(); ok {
+		m.connsCounter.With(append([]string{"reused", strconv.FormatBool(reused)}, m.baseLabels...)...).Add(1)
+
+		if !reused {
+			m.connectDurationHistogram.With(m.baseLabels...).Observe(timings.Connect().Seconds())
+		}
+	}
+
+	if tlsHandshake := timings.TLSHandshake(); tlsHandshake > 0 {
+		m.tlsHandshakeDurationHistogram.With(m.baseLabels...).Observe(tlsHandshake.Seconds())
+	}
+
+	if ttfb := timings.TimeToFirstByte(); ttfb > 0 {
+		m.timeToFirstByteHistogram.With(m.baseLabels...).Observe(ttfb.Seconds())
+	}
+}
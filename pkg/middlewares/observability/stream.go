@@ -0,0 +1,425 @@
+package observability
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/containous/alice"
+	"github.com/traefik/traefik/v3/pkg/middlewares"
+	"github.com/traefik/traefik/v3/pkg/observability/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/http/httpguts"
+)
+
+const (
+	streamTypeName = "StreamObservability"
+
+	streamTypeWebSocket = "websocket"
+	streamTypeGRPC      = "grpc"
+)
+
+type streamObservability struct {
+	next                  http.Handler
+	semConvMetricRegistry *metrics.SemConvMetricsRegistry
+}
+
+// StreamHandler returns the alice.Constructor for the stream observability middleware.
+// It records the messages and bytes exchanged in each direction on WebSocket and gRPC streams,
+// as well as the stream duration and the gRPC status code, in the current span and in the OpenTelemetry metrics.
+func StreamHandler(ctx context.Context, semConvMetricRegistry *metrics.SemConvMetricsRegistry) alice.Constructor {
+	return func(next http.Handler) (http.Handler, error) {
+		middlewares.GetLogger(ctx, "tracing", streamTypeName).Debug().Msg("Creating middleware")
+
+		return &streamObservability{
+			next:                  next,
+			semConvMetricRegistry: semConvMetricRegistry,
+		}, nil
+	}
+}
+
+func (s *streamObservability) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	tracingEnabled := TracingEnabled(ctx)
+	metricsEnabled := s.semConvMetricRegistry != nil && SemConvMetricsEnabled(ctx)
+	if !tracingEnabled && !metricsEnabled {
+		s.next.ServeHTTP(rw, req)
+		return
+	}
+
+	streamType := detectStreamType(req)
+	if streamType == "" {
+		s.next.ServeHTTP(rw, req)
+		return
+	}
+
+	stats := newStreamStats(streamType)
+
+	srw := &streamResponseWriter{rw: rw, stats: stats}
+	if req.Body != nil && req.Body != http.NoBody {
+		req.Body = &streamReadCloser{Closer: req.Body, streamReader: streamReader{Reader: req.Body, counter: &stats.received}}
+	}
+
+	start := time.Now()
+	s.next.ServeHTTP(srw, req)
+	duration := time.Since(start)
+
+	streamAttrs := []attribute.KeyValue{attribute.String("traefik.stream.type", streamType)}
+	if streamType == streamTypeGRPC {
+		streamAttrs = append(streamAttrs, semconv.RPCSystemGRPC)
+	}
+
+	// The gRPC status code is only relevant for the stream duration.
+	attrs := streamAttrs
+	if code, ok := grpcStatusCode(rw.Header()); ok && streamType == streamTypeGRPC {
+		attrs = append(attrs[:len(attrs):len(attrs)], attribute.Int("rpc.grpc.status_code", code))
+	}
+
+	receivedMessages, receivedBytes := stats.received.messages.Load(), stats.received.bytes.Load()
+	sentMessages, sentBytes := stats.sent.messages.Load(), stats.sent.bytes.Load()
+
+	if tracingEnabled {
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(attrs...)
+		span.SetAttributes(
+			attribute.Int64("traefik.stream.messages.received", receivedMessages),
+			attribute.Int64("traefik.stream.messages.sent", sentMessages),
+			attribute.Int64("traefik.stream.bytes.received", receivedBytes),
+			attribute.Int64("traefik.stream.bytes.sent", sentBytes),
+			attribute.Float64("traefik.stream.duration", duration.Seconds()),
+		)
+	}
+
+	if !metricsEnabled {
+		return
+	}
+
+	s.semConvMetricRegistry.StreamDuration().Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
+
+	receivedAttrs := metric.WithAttributes(append(streamAttrs[:len(streamAttrs):len(streamAttrs)], semconv.NetworkIODirectionReceive)...)
+	sentAttrs := metric.WithAttributes(append(streamAttrs[:len(streamAttrs):len(streamAttrs)], semconv.NetworkIODirectionTransmit)...)
+
+	s.semConvMetricRegistry.StreamMessages().Add(ctx, receivedMessages, receivedAttrs)
+	s.semConvMetricRegistry.StreamMessages().Add(ctx, sentMessages, sentAttrs)
+	s.semConvMetricRegistry.StreamIO().Add(ctx, receivedBytes, receivedAttrs)
+	s.semConvMetricRegistry.StreamIO().Add(ctx, sentBytes, sentAttrs)
+}
+
+// detectStreamType returns the type of stream carried by the request, or an empty string if it is not a stream.
+func detectStreamType(req *http.Request) string {
+	if httpguts.HeaderValuesContainsToken(req.Header["Connection"], "Upgrade") &&
+		strings.EqualFold(req.Header.Get("Upgrade"), streamTypeWebSocket) {
+		return streamTypeWebSocket
+	}
+
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
+		return streamTypeGRPC
+	}
+
+	return ""
+}
+
+// grpcStatusCode returns the gRPC status code sent by the server,
+// either in the trailers or in the headers of a trailers-only response.
+func grpcStatusCode(header http.Header) (int, bool) {
+	value := header.Get("Grpc-Status")
+	if value == "" {
+		value = header.Get(http.TrailerPrefix + "Grpc-Status")
+	}
+	if value == "" {
+		return 0, false
+	}
+
+	code, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return code, true
+}
+
+// streamStats holds the statistics of a stream.
+type streamStats struct {
+	received directionStats
+	sent     directionStats
+}
+
+func newStreamStats(streamType string) *streamStats {
+	stats := &streamStats{}
+
+	switch streamType {
+	case streamTypeWebSocket:
+		stats.received.parser = &webSocketParser{}
+		// The switching protocols response is written to the hijacked connection before the frames.
+		stats.sent.parser = &webSocketParser{skipHTTPHead: true}
+	case streamTypeGRPC:
+		stats.received.parser = &grpcParser{}
+		stats.sent.parser = &grpcParser{}
+	}
+
+	return stats
+}
+
+// directionStats holds the statistics of one direction of a stream.
+// The counters are read once the stream is over, while a copy goroutine might still be running,
+// hence the atomics.
+type directionStats struct {
+	parser   messageParser
+	messages atomic.Int64
+	bytes    atomic.Int64
+}
+
+func (d *directionStats) count(p []byte) {
+	if len(p) == 0 {
+		return
+	}
+
+	d.bytes.Add(int64(len(p)))
+	if messages := d.parser.parse(p); messages > 0 {
+		d.messages.Add(int64(messages))
+	}
+}
+
+// messageParser counts the messages contained in a byte stream, which can be split at any point.
+type messageParser interface {
+	parse(p []byte) int
+}
+
+// grpcParser counts the length-prefixed gRPC messages.
+// Each message starts with a 5 bytes header: a compression flag and the message length as a big-endian uint32.
+type grpcParser struct {
+	header    [5]byte
+	headerLen int
+	remaining uint64
+}
+
+func (g *grpcParser) parse(p []byte) int {
+	var messages int
+
+	for len(p) > 0 {
+		if g.remaining > 0 {
+			n := min(uint64(len(p)), g.remaining)
+			g.remaining -= n
+			p = p[n:]
+			continue
+		}
+
+		n := copy(g.header[g.headerLen:], p)
+		g.headerLen += n
+		p = p[n:]
+
+		if g.headerLen < len(g.header) {
+			break
+		}
+
+		messages++
+		g.headerLen = 0
+		g.remaining = uint64(binary.BigEndian.Uint32(g.header[1:]))
+	}
+
+	return messages
+}
+
+// webSocketParser counts the WebSocket data messages, as defined in RFC 6455.
+// A message ends with a data frame having the FIN bit set, control frames are not counted.
+type webSocketParser struct {
+	// skipHTTPHead is whether the stream starts with an HTTP message head to skip.
+	skipHTTPHead bool
+	// headEndLen is the length of the head terminating sequence read so far.
+	headEndLen int
+
+	header    [14]byte
+	headerLen int
+	remaining uint64
+}
+
+func (w *webSocketParser) parse(p []byte) int {
+	var messages int
+
+	for w.skipHTTPHead && len(p) > 0 {
+		const headEnd = "\r\n\r\n"
+
+		switch {
+		case p[0] == headEnd[w.headEndLen]:
+			w.headEndLen++
+		case p[0] == headEnd[0]:
+			w.headEndLen = 1
+		default:
+			w.headEndLen = 0
+		}
+		p = p[1:]
+
+		if w.headEndLen == len(headEnd) {
+			w.skipHTTPHead = false
+		}
+	}
+
+	for len(p) > 0 {
+		if w.remaining > 0 {
+			n := min(uint64(len(p)), w.remaining)
+			w.remaining -= n
+			p = p[n:]
+			continue
+		}
+
+		// The two first bytes are needed to compute the header size.
+		size := 2
+		if w.headerLen >= 2 {
+			size = webSocketHeaderSize(w.header[:2])
+		}
+
+		n := copy(w.header[w.headerLen:size], p)
+		w.headerLen += n
+		p = p[n:]
+
+		if w.headerLen < 2 || w.headerLen < webSocketHeaderSize(w.header[:2]) {
+			continue
+		}
+
+		fin := w.header[0]&0x80 != 0
+		opcode := w.header[0] & 0x0f
+		if fin && opcode < 0x8 {
+			messages++
+		}
+
+		w.remaining = webSocketPayloadLength(w.header[:w.headerLen])
+		w.headerLen = 0
+	}
+
+	return messages
+}
+
+// webSocketHeaderSize returns the size of a frame header given its first two bytes.
+func webSocketHeaderSize(b []byte) int {
+	size := 2
+
+	switch b[1] & 0x7f {
+	case 126:
+		size += 2
+	case 127:
+		size += 8
+	}
+
+	if b[1]&0x80 != 0 {
+		// Masking key.
+		size += 4
+	}
+
+	return size
+}
+
+// webSocketPayloadLength returns the payload length of a frame given its complete header.
+func webSocketPayloadLength(b []byte) uint64 {
+	switch length := b[1] & 0x7f; length {
+	case 126:
+		return uint64(binary.BigEndian.Uint16(b[2:4]))
+	case 127:
+		return binary.BigEndian.Uint64(b[2:10])
+	default:
+		return uint64(length)
+	}
+}
+
+// streamReadCloser counts the bytes and messages read from the request body.
+type streamReadCloser struct {
+	io.Closer
+	streamReader
+}
+
+// streamResponseWriter counts the bytes and messages written to the response,
+// or exchanged on the hijacked connection.
+type streamResponseWriter struct {
+	rw    http.ResponseWriter
+	stats *streamStats
+}
+
+func (s *streamResponseWriter) Header() http.Header {
+	return s.rw.Header()
+}
+
+func (s *streamResponseWriter) Write(b []byte) (int, error) {
+	n, err := s.rw.Write(b)
+	s.stats.sent.count(b[:n])
+
+	return n, err
+}
+
+func (s *streamResponseWriter) WriteHeader(status int) {
+	s.rw.WriteHeader(status)
+}
+
+func (s *streamResponseWriter) Flush() {
+	if f, ok := s.rw.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (s *streamResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := s.rw.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a hijacker: %T", s.rw)
+	}
+
+	conn, brw, err := h.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sconn := &streamConn{Conn: conn, stats: s.stats}
+
+	// The returned buffered reader and writer are also used to exchange data on the connection,
+	// so they are wrapped as well, the reader keeping the data already buffered from the client.
+	brw = bufio.NewReadWriter(
+		bufio.NewReader(&streamReader{Reader: brw.Reader, counter: &s.stats.received}),
+		bufio.NewWriter(sconn),
+	)
+
+	return sconn, brw, nil
+}
+
+// streamReader counts the bytes and messages read from a reader.
+type streamReader struct {
+	io.Reader
+
+	counter *directionStats
+}
+
+func (s *streamReader) Read(p []byte) (int, error) {
+	n, err := s.Reader.Read(p)
+	s.counter.count(p[:n])
+
+	return n, err
+}
+
+// streamConn counts the bytes and messages exchanged on a hijacked connection.
+type streamConn struct {
+	net.Conn
+
+	stats *streamStats
+}
+
+func (s *streamConn) Read(p []byte) (int, error) {
+	n, err := s.Conn.Read(p)
+	s.stats.received.count(p[:n])
+
+	return n, err
+}
+
+func (s *streamConn) Write(p []byte) (int, error) {
+	n, err := s.Conn.Write(p)
+	s.stats.sent.count(p[:n])
+
+	return n, err
+}
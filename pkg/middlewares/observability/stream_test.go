@@ -0,0 +1,317 @@
+package observability
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ptypes "github.com/traefik/paerser/types"
+	"github.com/traefik/traefik/v3/pkg/observability/metrics"
+	otypes "github.com/traefik/traefik/v3/pkg/observability/types"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestGRPCParser(t *testing.T) {
+	var stream []byte
+	stream = append(stream, grpcMessage("hello")...)
+	stream = append(stream, grpcMessage("")...)
+	stream = append(stream, grpcMessage(strings.Repeat("a", 300))...)
+
+	for _, chunkSize := range []int{1, 2, 5, 7, len(stream)} {
+		parser := &grpcParser{}
+
+		var messages int
+		for chunk := range slices.Chunk(stream, chunkSize) {
+			messages += parser.parse(chunk)
+		}
+
+		assert.Equal(t, 3, messages, "chunk size %d", chunkSize)
+	}
+}
+
+func TestWebSocketParser(t *testing.T) {
+	var stream []byte
+	// Unfragmented masked text message.
+	stream = append(stream, webSocketFrame(true, websocket.TextMessage, true, []byte("hello"))...)
+	// Ping control frame.
+	stream = append(stream, webSocketFrame(true, websocket.PingMessage, true, nil)...)
+	// Fragmented binary message, with a 16 bits extended payload length.
+	stream = append(stream, webSocketFrame(false, websocket.BinaryMessage, false, bytes.Repeat([]byte("a"), 200))...)
+	stream = append(stream, webSocketFrame(true, 0, false, []byte("end"))...)
+	// Unmasked message with a 64 bits extended payload length.
+	stream = append(stream, webSocketFrame(true, websocket.BinaryMessage, false, bytes.Repeat([]byte("b"), 70000))...)
+
+	for _, chunkSize := range []int{1, 3, 10, 1000, len(stream)} {
+		parser := &webSocketParser{}
+
+		var messages int
+		for chunk := range slices.Chunk(stream, chunkSize) {
+			messages += parser.parse(chunk)
+		}
+
+		assert.Equal(t, 3, messages, "chunk size %d", chunkSize)
+	}
+}
+
+func TestWebSocketParser_skipHTTPHead(t *testing.T) {
+	stream := []byte("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\n\r\n")
+	stream = append(stream, webSocketFrame(true, websocket.TextMessage, false, []byte("\r\n\r\n"))...)
+	stream = append(stream, webSocketFrame(true, websocket.TextMessage, false, []byte("hello"))...)
+
+	for _, chunkSize := range []int{1, 3, len(stream)} {
+		parser := &webSocketParser{skipHTTPHead: true}
+
+		var messages int
+		for chunk := range slices.Chunk(stream, chunkSize) {
+			messages += parser.parse(chunk)
+		}
+
+		assert.Equal(t, 2, messages, "chunk size %d", chunkSize)
+	}
+}
+
+func TestStreamHandler_webSocket(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(rw, req, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		for {
+			mt, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			require.NoError(t, conn.WriteMessage(mt, message))
+		}
+	})
+
+	span := &mockSpan{}
+
+	handler, err := StreamHandler(t.Context(), nil)(next)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		defer close(done)
+
+		ctx := WithObservability(trace.ContextWithSpan(req.Context(), span), Observability{TracingEnabled: true})
+		handler.ServeHTTP(rw, req.WithContext(ctx))
+	}))
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+
+	for _, message := range []string{"hello", "world"} {
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(message)))
+
+		_, got, err := conn.ReadMessage()
+		require.NoError(t, err)
+		assert.Equal(t, message, string(got))
+	}
+
+	require.NoError(t, conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")))
+	require.NoError(t, conn.Close())
+
+	// The span attributes are set once the stream is over, when the server handler returns.
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the stream to end")
+	}
+
+	attrs := attribute.NewSet(span.attributes...)
+
+	streamType, _ := attrs.Value("traefik.stream.type")
+	assert.Equal(t, "websocket", streamType.AsString())
+
+	received, _ := attrs.Value("traefik.stream.messages.received")
+	assert.Equal(t, int64(2), received.AsInt64())
+
+	sent, _ := attrs.Value("traefik.stream.messages.sent")
+	assert.Equal(t, int64(2), sent.AsInt64())
+
+	receivedBytes, _ := attrs.Value("traefik.stream.bytes.received")
+	assert.Positive(t, receivedBytes.AsInt64())
+
+	sentBytes, _ := attrs.Value("traefik.stream.bytes.sent")
+	assert.Positive(t, sentBytes.AsInt64())
+}
+
+func TestStreamHandler_gRPCMetrics(t *testing.T) {
+	var cfg otypes.OTLP
+	(&cfg).SetDefaults()
+	cfg.PushInterval = ptypes.Duration(10 * time.Millisecond)
+	rdr := sdkmetric.NewManualReader()
+
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(rdr))
+	// force the meter provider with manual reader to collect metrics for the test.
+	metrics.SetMeterProvider(meterProvider)
+
+	semConvMetricRegistry, err := metrics.NewSemConvMetricRegistry(t.Context(), &cfg)
+	require.NoError(t, err)
+	require.NotNil(t, semConvMetricRegistry)
+
+	next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var body bytes.Buffer
+		_, err := body.ReadFrom(req.Body)
+		require.NoError(t, err)
+
+		rw.Header().Set("Content-Type", "application/grpc")
+		rw.WriteHeader(http.StatusOK)
+		_, err = rw.Write(grpcMessage("response"))
+		require.NoError(t, err)
+
+		rw.Header().Set(http.TrailerPrefix+"Grpc-Status", "5")
+	})
+
+	handler, err := StreamHandler(t.Context(), semConvMetricRegistry)(next)
+	require.NoError(t, err)
+
+	body := append(grpcMessage("first"), grpcMessage("second")...)
+	req := httptest.NewRequest(http.MethodPost, "http://www.test.com/foo.Bar/Baz", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/grpc+proto")
+	req = req.WithContext(WithObservability(req.Context(), Observability{SemConvMetricsEnabled: true}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	got := metricdata.ResourceMetrics{}
+	require.NoError(t, rdr.Collect(t.Context(), &got))
+	require.Len(t, got.ScopeMetrics, 1)
+
+	var found int
+	for _, m := range got.ScopeMetrics[0].Metrics {
+		switch m.Name {
+		case "traefik.stream.duration":
+			found++
+
+			data, ok := m.Data.(metricdata.Histogram[float64])
+			require.True(t, ok)
+			require.Len(t, data.DataPoints, 1)
+
+			code, ok := data.DataPoints[0].Attributes.Value("rpc.grpc.status_code")
+			require.True(t, ok)
+			assert.Equal(t, int64(5), code.AsInt64())
+
+		case "traefik.stream.messages":
+			found++
+
+			assert.Equal(t, map[string]int64{"receive": 2, "transmit": 1}, sumByDirection(t, m))
+
+		case "traefik.stream.io":
+			found++
+
+			assert.Equal(t, map[string]int64{"receive": int64(len(body)), "transmit": int64(len(grpcMessage("response")))}, sumByDirection(t, m))
+		}
+	}
+
+	assert.Equal(t, 3, found)
+}
+
+func TestGRPCStatusCode(t *testing.T) {
+	tests := []struct {
+		desc     string
+		header   http.Header
+		wantCode int
+		wantOK   bool
+	}{
+		{
+			desc:   "no status",
+			header: http.Header{},
+		},
+		{
+			desc:     "trailers-only response",
+			header:   http.Header{"Grpc-Status": []string{"14"}},
+			wantCode: 14,
+			wantOK:   true,
+		},
+		{
+			desc:     "undeclared trailer",
+			header:   http.Header{http.TrailerPrefix + "Grpc-Status": []string{"0"}},
+			wantCode: 0,
+			wantOK:   true,
+		},
+		{
+			desc:   "invalid status",
+			header: http.Header{"Grpc-Status": []string{"foo"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			code, ok := grpcStatusCode(test.header)
+			assert.Equal(t, test.wantOK, ok)
+			assert.Equal(t, test.wantCode, code)
+		})
+	}
+}
+
+func sumByDirection(t *testing.T, m metricdata.Metrics) map[string]int64 {
+	t.Helper()
+
+	data, ok := m.Data.(metricdata.Sum[int64])
+	require.True(t, ok)
+
+	sums := make(map[string]int64)
+	for _, dp := range data.DataPoints {
+		direction, ok := dp.Attributes.Value("network.io.direction")
+		require.True(t, ok)
+
+		sums[direction.AsString()] += dp.Value
+	}
+
+	return sums
+}
+
+func grpcMessage(payload string) []byte {
+	message := make([]byte, 5, 5+len(payload))
+	binary.BigEndian.PutUint32(message[1:], uint32(len(payload)))
+
+	return append(message, payload...)
+}
+
+func webSocketFrame(fin bool, opcode byte, masked bool, payload []byte) []byte {
+	var frame []byte
+
+	b0 := opcode
+	if fin {
+		b0 |= 0x80
+	}
+	frame = append(frame, b0)
+
+	var maskBit byte
+	if masked {
+		maskBit = 0x80
+	}
+
+	switch {
+	case len(payload) < 126:
+		frame = append(frame, maskBit|byte(len(payload)))
+	case len(payload) <= 0xffff:
+		frame = append(frame, maskBit|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	default:
+		frame = append(frame, maskBit|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(len(payload)))
+	}
+
+	if masked {
+		// The masking key is not applied to the payload, as the parser does not read it.
+		frame = append(frame, 1, 2, 3, 4)
+	}
+
+	return append(frame, payload...)
+}
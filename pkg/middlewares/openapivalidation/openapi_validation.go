@@ -0,0 +1,390 @@
+package openapivalidation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/middlewares"
+	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+)
+
+const (
+	typeName = "OpenAPIValidation"
+
+	defaultMaxRequestBodyBytes = 10 << 20
+)
+
+// Prefixes of the reasons of the kin-openapi errors about the request content type.
+const (
+	reasonInvalidContentType     = "header Content-Type has unexpected value"
+	reasonUnsupportedContentType = "unsupported content type"
+)
+
+var errBodyTooLarge = errors.New("request body too large")
+
+// openAPIValidation is a middleware that validates the requests against the operations of an OpenAPI document.
+type openAPIValidation struct {
+	next                   http.Handler
+	name                   string
+	router                 routers.Router
+	reportOnly             bool
+	allowUnknownOperations bool
+	maxRequestBodyBytes    int64
+}
+
+// New builds a new OpenAPIValidation middleware.
+func New(ctx context.Context, next http.Handler, config dynamic.OpenAPIValidation, name string) (http.Handler, error) {
+	logger := middlewares.GetLogger(ctx, name, typeName)
+	logger.Debug().Msg("Creating middleware")
+
+	if config.File == "" {
+		return nil, errors.New("file is empty, OpenAPIValidation not created")
+	}
+
+	if config.MaxRequestBodyBytes < 0 {
+		return nil, fmt.Errorf("maxRequestBodyBytes must be positive, got %d", config.MaxRequestBodyBytes)
+	}
+
+	router, err := loadRouter(ctx, config.File, config.AllowExternalRefs)
+	if err != nil {
+		return nil, fmt.Errorf("loading OpenAPI document %q: %w", config.File, err)
+	}
+
+	maxRequestBodyBytes := config.MaxRequestBodyBytes
+	if maxRequestBodyBytes == 0 {
+		maxRequestBodyBytes = defaultMaxRequestBodyBytes
+	}
+
+	return &openAPIValidation{
+		next:                   next,
+		name:                   name,
+		router:                 router,
+		reportOnly:             config.ReportOnly,
+		allowUnknownOperations: config.AllowUnknownOperations,
+		maxRequestBodyBytes:    maxRequestBodyBytes,
+	}, nil
+}
+
+// loadRouter loads the OpenAPI document and builds the router matching the requests with its operations.
+func loadRouter(ctx context.Context, file string, allowExternalRefs bool) (routers.Router, error) {
+	loader := openapi3.NewLoader()
+	loader.Context = ctx
+
+	if allowExternalRefs {
+		path, err := resolvePath(file)
+		if err != nil {
+			return nil, err
+		}
+		dir := filepath.Dir(path)
+
+		loader.IsExternalRefsAllowed = true
+		// The loader does not check IsExternalRefsAllowed when ReadFromURIFunc is set:
+		// readFromDirectory restricts the references to the local files of the document directory.
+		loader.ReadFromURIFunc = readFromDirectory(dir)
+	}
+
+	doc, err := loader.LoadFromFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// The host of the requests is matched by the router rule,
+	// so only the base path of the servers is used to match the operations.
+	for _, server := range doc.Servers {
+		basePath, err := server.BasePath()
+		if err != nil {
+			return nil, fmt.Errorf("invalid server URL %q: %w", server.URL, err)
+		}
+
+		server.URL = basePath
+		server.Variables = nil
+	}
+
+	return legacy.NewRouter(doc, openapi3.DisableExamplesValidation())
+}
+
+// readFromDirectory returns a function reading the referenced files located in the given directory or its subdirectories.
+func readFromDirectory(dir string) openapi3.ReadFromURIFunc {
+	return func(_ *openapi3.Loader, location *url.URL) ([]byte, error) {
+		if (location.Scheme != "" && location.Scheme != "file") || location.Host != "" {
+			return nil, fmt.Errorf("reference to %q is not a local file", location)
+		}
+
+		path, err := resolvePath(filepath.FromSlash(location.Path))
+		if err != nil {
+			return nil, err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || !filepath.IsLocal(rel) {
+			return nil, fmt.Errorf("reference to %q is outside of the document directory", location.Path)
+		}
+
+		return os.ReadFile(path)
+	}
+}
+
+// resolvePath returns the absolute path of the given path, with its symbolic links evaluated.
+func resolvePath(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.EvalSymlinks(path)
+}
+
+func (o *openAPIValidation) GetTracingInformation() (string, string) {
+	return o.name, typeName
+}
+
+func (o *openAPIValidation) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	logger := middlewares.GetLogger(req.Context(), o.name, typeName)
+
+	statusCode, err := o.validate(req)
+	if err == nil {
+		o.next.ServeHTTP(rw, req)
+		return
+	}
+
+	if o.reportOnly {
+		logger.Warn().Err(err).Msgf("Invalid %s request to %s", req.Method, req.URL.Path)
+		o.next.ServeHTTP(rw, req)
+		return
+	}
+
+	logger.Debug().Err(err).Msgf("Rejecting %s request to %s", req.Method, req.URL.Path)
+	observability.SetStatusErrorf(req.Context(), "Rejecting request: %s", err)
+	reject(logger.WithContext(req.Context()), statusCode, err, rw)
+}
+
+// validate validates the request against the matching operation,
+// and returns the status code of the response to send when the request is invalid.
+func (o *openAPIValidation) validate(req *http.Request) (int, error) {
+	// The operations are matched on the path only, as the servers of the document are reduced to their base path.
+	routeURL := &url.URL{Path: req.URL.Path, RawPath: req.URL.RawPath}
+	routeReq := req.WithContext(req.Context())
+	routeReq.URL = routeURL
+
+	route, pathParams, err := o.router.FindRoute(routeReq)
+	if err != nil {
+		var routeErr *routers.RouteError
+		if !errors.As(err, &routeErr) {
+			return http.StatusInternalServerError, err
+		}
+
+		if o.allowUnknownOperations {
+			return 0, nil
+		}
+
+		if routeErr.Reason == routers.ErrMethodNotAllowed.Error() {
+			return http.StatusMethodNotAllowed, errors.New("no operation matches the request method")
+		}
+
+		return http.StatusNotFound, errors.New("no operation matches the request path")
+	}
+
+	options := &openapi3filter.Options{
+		MultiError: true,
+		// The authentication is left to the other middlewares and to the service.
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		// The request is forwarded as is.
+		SkipSettingDefaults: true,
+	}
+
+	if route.Operation.RequestBody != nil {
+		if err := o.bufferBody(req); err != nil {
+			if !errors.Is(err, errBodyTooLarge) {
+				return http.StatusBadRequest, fmt.Errorf("reading the request body: %w", err)
+			}
+
+			if !o.reportOnly {
+				return http.StatusRequestEntityTooLarge, err
+			}
+
+			// The body is too large to be validated, but can still be forwarded.
+			options.ExcludeRequestBody = true
+		}
+	}
+
+	err = openapi3filter.ValidateRequest(req.Context(), &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    options,
+	})
+	if err != nil {
+		return statusCode(err), err
+	}
+
+	return 0, nil
+}
+
+// bufferBody reads the request body in memory, so that it can be validated and then forwarded.
+// When the body is too large, the part already read is forwarded with the rest of the body.
+func (o *openAPIValidation) bufferBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+
+	if req.ContentLength > o.maxRequestBodyBytes {
+		return errBodyTooLarge
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, o.maxRequestBodyBytes+1))
+	if err != nil {
+		return err
+	}
+
+	if int64(len(body)) > o.maxRequestBodyBytes {
+		req.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), req.Body), req.Body}
+		return errBodyTooLarge
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.ContentLength = int64(len(body))
+
+	return nil
+}
+
+// statusCode returns the status code of the response to an invalid request.
+func statusCode(err error) int {
+	var reqErr *openapi3filter.RequestError
+	if errors.As(err, &reqErr) && reqErr.RequestBody != nil &&
+		(strings.HasPrefix(reqErr.Reason, reasonInvalidContentType) || strings.HasPrefix(reqErr.Reason, reasonUnsupportedContentType)) {
+		return http.StatusUnsupportedMediaType
+	}
+
+	return http.StatusBadRequest
+}
+
+// problem is a problem details object, as defined by RFC 9457 (formerly RFC 7807).
+type problem struct {
+	Type   string         `json:"type"`
+	Title  string         `json:"title"`
+	Status int            `json:"status"`
+	Detail string         `json:"detail"`
+	Errors []invalidParam `json:"errors,omitempty"`
+}
+
+// invalidParam describes a part of the request that does not match the OpenAPI document.
+type invalidParam struct {
+	In      string `json:"in"`
+	Name    string `json:"name,omitempty"`
+	Pointer string `json:"pointer,omitempty"`
+	Detail  string `json:"detail"`
+}
+
+func reject(ctx context.Context, statusCode int, err error, rw http.ResponseWriter) {
+	p := problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(statusCode),
+		Status: statusCode,
+		Detail: "The request does not match the OpenAPI specification.",
+		Errors: invalidParams(err),
+	}
+
+	if len(p.Errors) == 0 {
+		p.Detail = err.Error()
+	}
+
+	rw.Header().Set("Content-Type", "application/problem+json")
+	rw.Header().Set("X-Content-Type-Options", "nosniff")
+	rw.WriteHeader(statusCode)
+
+	if err := json.NewEncoder(rw).Encode(p); err != nil {
+		log.Ctx(ctx).Error().Err(err).Send()
+	}
+}
+
+// invalidParams flattens the validation errors into the list of the invalid parts of the request.
+func invalidParams(err error) []invalidParam {
+	// MultiError.As looks into the errors it holds, hence the type assertion.
+	if multiErr, ok := err.(openapi3.MultiError); ok {
+		var params []invalidParam
+		for _, e := range multiErr {
+			params = append(params, invalidParams(e)...)
+		}
+		return params
+	}
+
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return nil
+	}
+
+	param := invalidParam{In: "body"}
+	if reqErr.Parameter != nil {
+		param.In = reqErr.Parameter.In
+		param.Name = reqErr.Parameter.Name
+	}
+
+	schemaErrs := schemaErrors(reqErr.Err)
+	if len(schemaErrs) == 0 {
+		param.Detail = requestErrorDetail(reqErr)
+		return []invalidParam{param}
+	}
+
+	params := make([]invalidParam, 0, len(schemaErrs))
+	for _, schemaErr := range schemaErrs {
+		p := param
+		p.Detail = schemaErr.Reason
+		if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+			p.Pointer = "/" + strings.Join(pointer, "/")
+		}
+		params = append(params, p)
+	}
+
+	return params
+}
+
+// schemaErrors returns the schema validation errors held by err.
+func schemaErrors(err error) []*openapi3.SchemaError {
+	if multiErr, ok := err.(openapi3.MultiError); ok {
+		var schemaErrs []*openapi3.SchemaError
+		for _, e := range multiErr {
+			schemaErrs = append(schemaErrs, schemaErrors(e)...)
+		}
+		return schemaErrs
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		return []*openapi3.SchemaError{schemaErr}
+	}
+
+	return nil
+}
+
+// requestErrorDetail returns the reason of the request error, without the description of the parameter.
+func requestErrorDetail(err *openapi3filter.RequestError) string {
+	switch {
+	case err.Err == nil:
+		return err.Reason
+	case err.Reason == "" || err.Reason == err.Err.Error():
+		return err.Err.Error()
+	default:
+		return err.Reason + ": " + err.Err.Error()
+	}
+}
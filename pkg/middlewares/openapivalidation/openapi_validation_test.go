@@ -0,0 +1,274 @@
+package openapivalidation
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		config        dynamic.OpenAPIValidation
+		expectedError bool
+	}{
+		{
+			desc:   "valid configuration",
+			config: dynamic.OpenAPIValidation{File: "fixtures/petstore.yaml"},
+		},
+		{
+			desc:          "empty file",
+			config:        dynamic.OpenAPIValidation{},
+			expectedError: true,
+		},
+		{
+			desc:          "missing file",
+			config:        dynamic.OpenAPIValidation{File: "fixtures/missing.yaml"},
+			expectedError: true,
+		},
+		{
+			desc:          "external references not allowed",
+			config:        dynamic.OpenAPIValidation{File: "fixtures/refs/petstore.yaml"},
+			expectedError: true,
+		},
+		{
+			desc:   "external references allowed",
+			config: dynamic.OpenAPIValidation{File: "fixtures/refs/petstore.yaml", AllowExternalRefs: true},
+		},
+		{
+			desc:          "external reference outside of the document directory",
+			config:        dynamic.OpenAPIValidation{File: "fixtures/refs/outside.yaml", AllowExternalRefs: true},
+			expectedError: true,
+		},
+		{
+			desc:          "remote external reference",
+			config:        dynamic.OpenAPIValidation{File: "fixtures/refs/remote.yaml", AllowExternalRefs: true},
+			expectedError: true,
+		},
+		{
+			desc:          "negative maxRequestBodyBytes",
+			config:        dynamic.OpenAPIValidation{File: "fixtures/petstore.yaml", MaxRequestBodyBytes: -1},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {})
+
+			_, err := New(t.Context(), next, test.config, "foo")
+			if test.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOpenAPIValidation_ServeHTTP(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		config         dynamic.OpenAPIValidation
+		method         string
+		target         string
+		header         http.Header
+		body           string
+		expectedStatus int
+		expectedErrors []invalidParam
+		expectedBody   string
+	}{
+		{
+			desc:           "valid request",
+			method:         http.MethodGet,
+			target:         "/v1/pets?limit=10",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "valid path parameter",
+			method:         http.MethodGet,
+			target:         "/v1/pets/42",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "valid body",
+			method:         http.MethodPost,
+			target:         "/v1/pets",
+			header:         http.Header{"Content-Type": {"application/json"}, "X-Request-Id": {"0123abcd"}},
+			body:           `{"name":"Rex","age":3}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"name":"Rex","age":3}`,
+		},
+		{
+			desc:           "invalid query parameter",
+			method:         http.MethodGet,
+			target:         "/v1/pets?limit=1000",
+			expectedStatus: http.StatusBadRequest,
+			expectedErrors: []invalidParam{{In: "query", Name: "limit", Detail: "number must be at most 100"}},
+		},
+		{
+			desc:           "invalid path parameter",
+			method:         http.MethodGet,
+			target:         "/v1/pets/rex",
+			expectedStatus: http.StatusBadRequest,
+			expectedErrors: []invalidParam{{In: "path", Name: "petId", Detail: `value rex: an invalid integer: invalid syntax`}},
+		},
+		{
+			desc:           "missing header",
+			method:         http.MethodPost,
+			target:         "/v1/pets",
+			header:         http.Header{"Content-Type": {"application/json"}},
+			body:           `{"name":"Rex"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErrors: []invalidParam{{In: "header", Name: "X-Request-ID", Detail: "value is required but missing"}},
+		},
+		{
+			desc:           "invalid body",
+			method:         http.MethodPost,
+			target:         "/v1/pets",
+			header:         http.Header{"Content-Type": {"application/json"}, "X-Request-Id": {"0123abcd"}},
+			body:           `{"name":"","age":-1}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErrors: []invalidParam{
+				{In: "body", Pointer: "/name", Detail: "minimum string length is 1"},
+				{In: "body", Pointer: "/age", Detail: "number must be at least 0"},
+			},
+		},
+		{
+			desc:           "invalid content type",
+			method:         http.MethodPost,
+			target:         "/v1/pets",
+			header:         http.Header{"Content-Type": {"text/plain"}, "X-Request-Id": {"0123abcd"}},
+			body:           `Rex`,
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:           "too large body",
+			config:         dynamic.OpenAPIValidation{MaxRequestBodyBytes: 10},
+			method:         http.MethodPost,
+			target:         "/v1/pets",
+			header:         http.Header{"Content-Type": {"application/json"}, "X-Request-Id": {"0123abcd"}},
+			body:           `{"name":"Rex","age":3}`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			desc:           "unknown path",
+			method:         http.MethodGet,
+			target:         "/v1/owners",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			desc:           "path outside of the servers base path",
+			method:         http.MethodGet,
+			target:         "/pets",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			desc:           "unknown method",
+			method:         http.MethodDelete,
+			target:         "/v1/pets",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			desc:           "unknown operation allowed",
+			config:         dynamic.OpenAPIValidation{AllowUnknownOperations: true},
+			method:         http.MethodDelete,
+			target:         "/v1/pets",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "report only",
+			config:         dynamic.OpenAPIValidation{ReportOnly: true},
+			method:         http.MethodPost,
+			target:         "/v1/pets",
+			header:         http.Header{"Content-Type": {"application/json"}, "X-Request-Id": {"0123abcd"}},
+			body:           `{"age":-1}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"age":-1}`,
+		},
+		{
+			desc:           "report only with too large body",
+			config:         dynamic.OpenAPIValidation{ReportOnly: true, MaxRequestBodyBytes: 10},
+			method:         http.MethodPost,
+			target:         "/v1/pets",
+			header:         http.Header{"Content-Type": {"application/json"}, "X-Request-Id": {"0123abcd"}},
+			body:           `{"name":"Rex","age":3}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"name":"Rex","age":3}`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var forwardedBody string
+			next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				forwardedBody = string(body)
+			})
+
+			config := test.config
+			config.File = "fixtures/petstore.yaml"
+
+			handler, err := New(t.Context(), next, config, "foo")
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(test.method, "http://api.example.com"+test.target, strings.NewReader(test.body))
+			for name, values := range test.header {
+				req.Header[name] = values
+			}
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			require.Equal(t, test.expectedStatus, recorder.Code)
+			assert.Equal(t, test.expectedBody, forwardedBody)
+
+			if recorder.Code == http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, "application/problem+json", recorder.Header().Get("Content-Type"))
+
+			var p problem
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &p))
+			assert.Equal(t, test.expectedStatus, p.Status)
+			assert.Equal(t, http.StatusText(test.expectedStatus), p.Title)
+			assert.NotEmpty(t, p.Detail)
+
+			if test.expectedErrors != nil {
+				assert.ElementsMatch(t, test.expectedErrors, p.Errors)
+			}
+		})
+	}
+}
+
+func TestOpenAPIValidation_bodyReadError(t *testing.T) {
+	next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		t.Fatal("the request must not be forwarded")
+	})
+
+	handler, err := New(t.Context(), next, dynamic.OpenAPIValidation{File: "fixtures/petstore.yaml"}, "foo")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "http://api.example.com/v1/pets", iotest.ErrReader(errors.New("connection reset")))
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
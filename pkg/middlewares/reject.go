@@ -0,0 +1,17 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Reject writes a response with the given status code, and its status text as body.
+func Reject(ctx context.Context, statusCode int, rw http.ResponseWriter) {
+	rw.WriteHeader(statusCode)
+	_, err := rw.Write([]byte(http.StatusText(statusCode)))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Send()
+	}
+}
@@ -0,0 +1,116 @@
+package requestlimits
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	errJSONTooDeep      = errors.New("JSON nesting too deep")
+	errJSONArrayTooLong = errors.New("JSON array too long")
+)
+
+// jsonFrame is an object or an array being scanned.
+type jsonFrame struct {
+	array bool
+	// count is the number of elements of the array.
+	count int
+	// expectValue is true when the next value starts a new element of the array.
+	expectValue bool
+}
+
+// jsonScanner is a reader checking the nesting depth and the array lengths of the JSON document it reads.
+// It only tracks the structure of the document, and does not check whether it is valid JSON.
+type jsonScanner struct {
+	reader         io.Reader
+	maxDepth       int
+	maxArrayLength int
+
+	stack    []jsonFrame
+	inString bool
+	escaped  bool
+	err      error
+}
+
+func newJSONScanner(reader io.Reader, maxDepth, maxArrayLength int) *jsonScanner {
+	return &jsonScanner{
+		reader:         reader,
+		maxDepth:       maxDepth,
+		maxArrayLength: maxArrayLength,
+	}
+}
+
+func (s *jsonScanner) Read(p []byte) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	n, err := s.reader.Read(p)
+	if scanErr := s.scan(p[:n]); scanErr != nil {
+		s.err = scanErr
+		return n, scanErr
+	}
+
+	return n, err
+}
+
+func (s *jsonScanner) scan(data []byte) error {
+	for _, c := range data {
+		if s.inString {
+			switch {
+			case s.escaped:
+				s.escaped = false
+			case c == '\\':
+				s.escaped = true
+			case c == '"':
+				s.inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case ',':
+			if top := s.top(); top != nil && top.array {
+				top.expectValue = true
+			}
+			continue
+		case '}', ']':
+			if len(s.stack) > 0 {
+				s.stack = s.stack[:len(s.stack)-1]
+			}
+			continue
+		}
+
+		// The byte is the start or a part of a value.
+		if top := s.top(); top != nil && top.array && top.expectValue {
+			top.expectValue = false
+			top.count++
+			if s.maxArrayLength > 0 && top.count > s.maxArrayLength {
+				return fmt.Errorf("%w: more than %d elements", errJSONArrayTooLong, s.maxArrayLength)
+			}
+		}
+
+		switch c {
+		case '"':
+			s.inString = true
+		case '{', '[':
+			s.stack = append(s.stack, jsonFrame{array: c == '[', expectValue: c == '['})
+			if s.maxDepth > 0 && len(s.stack) > s.maxDepth {
+				return fmt.Errorf("%w: more than %d levels", errJSONTooDeep, s.maxDepth)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *jsonScanner) top() *jsonFrame {
+	if len(s.stack) == 0 {
+		return nil
+	}
+
+	return &s.stack[len(s.stack)-1]
+}
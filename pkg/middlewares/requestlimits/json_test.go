@@ -0,0 +1,83 @@
+package requestlimits
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_jsonScanner(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		maxDepth       int
+		maxArrayLength int
+		body           string
+		expectedErr    error
+	}{
+		{
+			desc:     "depth within limit",
+			maxDepth: 4,
+			body:     `{"a":{"b":[1,2,{}]}}`,
+		},
+		{
+			desc:        "too deep",
+			maxDepth:    4,
+			body:        `{"a":{"b":[1,2,{"c":{}}]}}`,
+			expectedErr: errJSONTooDeep,
+		},
+		{
+			desc:     "brackets in strings",
+			maxDepth: 1,
+			body:     `{"a":"[[[{{{\"]]]"}`,
+		},
+		{
+			desc:           "array within limit",
+			maxArrayLength: 3,
+			body:           `[1, "two", {"three": [4, 5, 6]}]`,
+		},
+		{
+			desc:           "empty arrays",
+			maxArrayLength: 1,
+			body:           `[[], [ ]]`,
+			expectedErr:    errJSONArrayTooLong,
+		},
+		{
+			desc:           "too long array",
+			maxArrayLength: 3,
+			body:           `{"a": [true, false, null, 1.5e3]}`,
+			expectedErr:    errJSONArrayTooLong,
+		},
+		{
+			desc:           "too long nested array",
+			maxArrayLength: 2,
+			body:           `[[1, 2], [[3, 4, 5]]]`,
+			expectedErr:    errJSONArrayTooLong,
+		},
+		{
+			desc:           "commas in objects",
+			maxArrayLength: 1,
+			body:           `[{"a": 1, "b": 2, "c": 3}]`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			// The document is read one byte at a time, to check the state is kept between reads.
+			scanner := newJSONScanner(iotest.OneByteReader(strings.NewReader(test.body)), test.maxDepth, test.maxArrayLength)
+
+			_, err := io.ReadAll(scanner)
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package requestlimits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/middlewares"
+	"github.com/traefik/traefik/v3/pkg/middlewares/buffering"
+	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+)
+
+const (
+	typeName = "RequestLimits"
+
+	defaultMaxRequestBodyBytes = 10 * 1024 * 1024
+)
+
+// requestLimits is a middleware that rejects the requests exceeding the size and complexity limits of their headers, URL, and body.
+type requestLimits struct {
+	next                http.Handler
+	name                string
+	limits              dynamic.RequestLimits
+	maxRequestBodyBytes int64
+	memRequestBodyBytes int64
+	headerStatusCode    int
+	urlStatusCode       int
+	bodyStatusCode      int
+}
+
+// New creates a RequestLimits middleware.
+func New(ctx context.Context, next http.Handler, config dynamic.RequestLimits, name string) (http.Handler, error) {
+	logger := middlewares.GetLogger(ctx, name, typeName)
+	logger.Debug().Msg("Creating middleware")
+
+	limits := map[string]int{
+		"maxHeaderCount":     config.MaxHeaderCount,
+		"maxHeaderLength":    config.MaxHeaderLength,
+		"maxURLLength":       config.MaxURLLength,
+		"maxQueryParams":     config.MaxQueryParams,
+		"maxMultipartParts":  config.MaxMultipartParts,
+		"maxJSONDepth":       config.MaxJSONDepth,
+		"maxJSONArrayLength": config.MaxJSONArrayLength,
+	}
+	for option, value := range limits {
+		if value < 0 {
+			return nil, fmt.Errorf("%s must be positive, got %d", option, value)
+		}
+	}
+
+	if config.MaxRequestBodyBytes < 0 {
+		return nil, fmt.Errorf("maxRequestBodyBytes must be positive, got %d", config.MaxRequestBodyBytes)
+	}
+
+	if config.MemRequestBodyBytes < 0 {
+		return nil, fmt.Errorf("memRequestBodyBytes must be positive, got %d", config.MemRequestBodyBytes)
+	}
+
+	headerStatusCode, err := statusCodeOrDefault(config.HeaderStatusCode, http.StatusRequestHeaderFieldsTooLarge)
+	if err != nil {
+		return nil, err
+	}
+
+	urlStatusCode, err := statusCodeOrDefault(config.URLStatusCode, http.StatusRequestURITooLong)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyStatusCode, err := statusCodeOrDefault(config.BodyStatusCode, http.StatusRequestEntityTooLarge)
+	if err != nil {
+		return nil, err
+	}
+
+	maxRequestBodyBytes := config.MaxRequestBodyBytes
+	if maxRequestBodyBytes == 0 {
+		maxRequestBodyBytes = defaultMaxRequestBodyBytes
+	}
+
+	memRequestBodyBytes := config.MemRequestBodyBytes
+	if memRequestBodyBytes == 0 {
+		memRequestBodyBytes = buffering.DefaultMemBodyBytes
+	}
+
+	return &requestLimits{
+		next:                next,
+		name:                name,
+		limits:              config,
+		maxRequestBodyBytes: maxRequestBodyBytes,
+		memRequestBodyBytes: memRequestBodyBytes,
+		headerStatusCode:    headerStatusCode,
+		urlStatusCode:       urlStatusCode,
+		bodyStatusCode:      bodyStatusCode,
+	}, nil
+}
+
+func statusCodeOrDefault(statusCode, defaultStatusCode int) (int, error) {
+	if statusCode == 0 {
+		return defaultStatusCode, nil
+	}
+
+	if http.StatusText(statusCode) == "" {
+		return 0, fmt.Errorf("invalid HTTP status code %d", statusCode)
+	}
+
+	return statusCode, nil
+}
+
+func (r *requestLimits) GetTracingInformation() (string, string) {
+	return r.name, typeName
+}
+
+func (r *requestLimits) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	logger := middlewares.GetLogger(req.Context(), r.name, typeName)
+	ctx := logger.WithContext(req.Context())
+
+	if err := r.checkHeaders(req); err != nil {
+		r.reject(ctx, rw, req, r.headerStatusCode, err)
+		return
+	}
+
+	if err := r.checkURL(req); err != nil {
+		r.reject(ctx, rw, req, r.urlStatusCode, err)
+		return
+	}
+
+	kind := r.bodyKind(req)
+	if kind == bodyUnchecked {
+		r.next.ServeHTTP(rw, req)
+		return
+	}
+
+	outReq := req.Clone(req.Context())
+
+	if kind == bodyJSON {
+		outReq.Body = struct {
+			io.Reader
+			io.Closer
+		}{newJSONScanner(req.Body, r.limits.MaxJSONDepth, r.limits.MaxJSONArrayLength), req.Body}
+	}
+
+	body, err := buffering.BufferRequestBody(outReq, r.memRequestBodyBytes, r.maxRequestBodyBytes)
+	if err != nil {
+		if buffering.IsBodyTooLarge(err) || errors.Is(err, errJSONTooDeep) || errors.Is(err, errJSONArrayTooLong) {
+			r.reject(ctx, rw, req, r.bodyStatusCode, err)
+			return
+		}
+
+		logger.Error().Err(err).Msg("Error while reading the request body")
+		observability.SetStatusErrorf(req.Context(), "Error while reading the request body: %v", err)
+		http.Error(rw, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	defer func() { _ = body.Close() }()
+
+	if kind == bodyMultipart {
+		if err := r.checkMultipart(req, body); err != nil {
+			r.reject(ctx, rw, req, r.bodyStatusCode, err)
+			return
+		}
+
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			logger.Error().Err(err).Msg("Error while reading the request body")
+			http.Error(rw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	size, err := body.Size()
+	if err != nil {
+		logger.Error().Err(err).Msg("Error while reading the request body size")
+		http.Error(rw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	outReq.Body = io.NopCloser(body)
+	outReq.ContentLength = size
+	outReq.TransferEncoding = nil
+	outReq.Header.Del("Transfer-Encoding")
+	if size > 0 {
+		outReq.Header.Set("Content-Length", strconv.FormatInt(size, 10))
+	}
+
+	r.next.ServeHTTP(rw, outReq)
+}
+
+// checkHeaders checks the number and the length of the request headers.
+func (r *requestLimits) checkHeaders(req *http.Request) error {
+	if r.limits.MaxHeaderCount == 0 && r.limits.MaxHeaderLength == 0 {
+		return nil
+	}
+
+	var count int
+	for name, values := range req.Header {
+		count += len(values)
+
+		if r.limits.MaxHeaderLength == 0 {
+			continue
+		}
+
+		for _, value := range values {
+			if len(name)+len(value) > r.limits.MaxHeaderLength {
+				return fmt.Errorf("header %s longer than %d bytes", name, r.limits.MaxHeaderLength)
+			}
+		}
+	}
+
+	if r.limits.MaxHeaderCount > 0 && count > r.limits.MaxHeaderCount {
+		return fmt.Errorf("more than %d headers", r.limits.MaxHeaderCount)
+	}
+
+	return nil
+}
+
+// checkURL checks the length of the request URL and the number of query parameters.
+func (r *requestLimits) checkURL(req *http.Request) error {
+	if r.limits.MaxURLLength > 0 {
+		requestURI := req.RequestURI
+		if requestURI == "" {
+			requestURI = req.URL.RequestURI()
+		}
+
+		if len(requestURI) > r.limits.MaxURLLength {
+			return fmt.Errorf("URL longer than %d bytes", r.limits.MaxURLLength)
+		}
+	}
+
+	if r.limits.MaxQueryParams > 0 {
+		var count int
+		for param := range strings.SplitSeq(req.URL.RawQuery, "&") {
+			if param != "" {
+				count++
+			}
+		}
+
+		if count > r.limits.MaxQueryParams {
+			return fmt.Errorf("more than %d query parameters", r.limits.MaxQueryParams)
+		}
+	}
+
+	return nil
+}
+
+// Kinds of request bodies.
+const (
+	bodyUnchecked = iota
+	bodyJSON
+	bodyMultipart
+)
+
+// bodyKind returns the kind of the request body, when it has to be checked.
+func (r *requestLimits) bodyKind(req *http.Request) int {
+	if req.Body == nil || req.Body == http.NoBody {
+		return bodyUnchecked
+	}
+
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		return bodyUnchecked
+	}
+
+	switch {
+	case (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")) &&
+		(r.limits.MaxJSONDepth > 0 || r.limits.MaxJSONArrayLength > 0):
+		return bodyJSON
+	case strings.HasPrefix(mediaType, "multipart/") && r.limits.MaxMultipartParts > 0:
+		return bodyMultipart
+	default:
+		return bodyUnchecked
+	}
+}
+
+// checkMultipart checks the number of parts of the multipart body.
+// Malformed bodies are left to the service.
+func (r *requestLimits) checkMultipart(req *http.Request, body io.Reader) error {
+	_, params, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if params["boundary"] == "" {
+		return nil
+	}
+
+	reader := multipart.NewReader(body, params["boundary"])
+
+	var count int
+	for {
+		part, err := reader.NextRawPart()
+		if err != nil {
+			return nil
+		}
+		_ = part.Close()
+
+		count++
+		if count > r.limits.MaxMultipartParts {
+			return fmt.Errorf("more than %d multipart parts", r.limits.MaxMultipartParts)
+		}
+	}
+}
+
+func (r *requestLimits) reject(ctx context.Context, rw http.ResponseWriter, req *http.Request, statusCode int, err error) {
+	log.Ctx(ctx).Debug().Err(err).Msgf("Rejecting %s request to %s", req.Method, req.URL.Path)
+	observability.SetStatusErrorf(req.Context(), "Rejecting request: %s", err)
+
+	middlewares.Reject(ctx, statusCode, rw)
+}
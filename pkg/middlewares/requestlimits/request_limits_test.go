@@ -0,0 +1,249 @@
+package requestlimits
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		config        dynamic.RequestLimits
+		expectedError bool
+	}{
+		{
+			desc:   "empty configuration",
+			config: dynamic.RequestLimits{},
+		},
+		{
+			desc:   "valid configuration",
+			config: dynamic.RequestLimits{MaxHeaderCount: 10, MaxJSONDepth: 5, BodyStatusCode: http.StatusBadRequest},
+		},
+		{
+			desc:          "negative limit",
+			config:        dynamic.RequestLimits{MaxQueryParams: -1},
+			expectedError: true,
+		},
+		{
+			desc:          "negative max request body bytes",
+			config:        dynamic.RequestLimits{MaxRequestBodyBytes: -1},
+			expectedError: true,
+		},
+		{
+			desc:          "invalid status code",
+			config:        dynamic.RequestLimits{HeaderStatusCode: 42},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {})
+
+			_, err := New(t.Context(), next, test.config, "foo")
+			if test.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRequestLimits_ServeHTTP(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		config         dynamic.RequestLimits
+		target         string
+		header         http.Header
+		body           string
+		chunked        bool
+		expectedStatus int
+	}{
+		{
+			desc:           "no limits",
+			target:         "/foo?a=1&b=2",
+			header:         http.Header{"Content-Type": {"application/json"}},
+			body:           `[[[[1, 2, 3]]]]`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "header count within limit",
+			config:         dynamic.RequestLimits{MaxHeaderCount: 2},
+			header:         http.Header{"X-Foo": {"foo", "bar"}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "too many headers",
+			config:         dynamic.RequestLimits{MaxHeaderCount: 2},
+			header:         http.Header{"X-Foo": {"foo", "bar"}, "X-Bar": {"bar"}},
+			expectedStatus: http.StatusRequestHeaderFieldsTooLarge,
+		},
+		{
+			desc:           "too long header",
+			config:         dynamic.RequestLimits{MaxHeaderLength: 10},
+			header:         http.Header{"X-Foo": {"foobar"}},
+			expectedStatus: http.StatusRequestHeaderFieldsTooLarge,
+		},
+		{
+			desc:           "too long header with custom status code",
+			config:         dynamic.RequestLimits{MaxHeaderLength: 10, HeaderStatusCode: http.StatusBadRequest},
+			header:         http.Header{"X-Foo": {"foobar"}},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			desc:           "URL length within limit",
+			config:         dynamic.RequestLimits{MaxURLLength: 10},
+			target:         "/foo?a=123",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "too long URL",
+			config:         dynamic.RequestLimits{MaxURLLength: 10},
+			target:         "/foo?a=1234",
+			expectedStatus: http.StatusRequestURITooLong,
+		},
+		{
+			desc:           "query parameters within limit",
+			config:         dynamic.RequestLimits{MaxQueryParams: 2},
+			target:         "/foo?a=1&&a=2",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "too many query parameters",
+			config:         dynamic.RequestLimits{MaxQueryParams: 2},
+			target:         "/foo?a=1&b=2&c",
+			expectedStatus: http.StatusRequestURITooLong,
+		},
+		{
+			desc:           "JSON within limits",
+			config:         dynamic.RequestLimits{MaxJSONDepth: 3, MaxJSONArrayLength: 3},
+			header:         http.Header{"Content-Type": {"application/json"}},
+			body:           `{"a": [1, 2, {"b": "c"}]}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "too deep JSON",
+			config:         dynamic.RequestLimits{MaxJSONDepth: 3},
+			header:         http.Header{"Content-Type": {"application/problem+json; charset=utf-8"}},
+			body:           `{"a": [1, 2, {"b": ["c"]}]}`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			desc:           "too long JSON array",
+			config:         dynamic.RequestLimits{MaxJSONArrayLength: 3, BodyStatusCode: http.StatusBadRequest},
+			header:         http.Header{"Content-Type": {"application/json"}},
+			body:           `{"a": [1, 2, 3, 4]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			desc:           "JSON body within size limit",
+			config:         dynamic.RequestLimits{MaxJSONDepth: 3, MaxRequestBodyBytes: 32, MemRequestBodyBytes: 4},
+			header:         http.Header{"Content-Type": {"application/json"}},
+			body:           `{"a": [1, 2, {"b": "c"}]}`,
+			chunked:        true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "too large JSON body",
+			config:         dynamic.RequestLimits{MaxJSONDepth: 3, MaxRequestBodyBytes: 16},
+			header:         http.Header{"Content-Type": {"application/json"}},
+			body:           `{"a": [1, 2, {"b": "c"}]}`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			desc:           "too large chunked JSON body buffered on disk",
+			config:         dynamic.RequestLimits{MaxJSONDepth: 3, MaxRequestBodyBytes: 16, MemRequestBodyBytes: 4},
+			header:         http.Header{"Content-Type": {"application/json"}},
+			body:           `{"a": [1, 2, {"b": "c"}]}`,
+			chunked:        true,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			desc:           "JSON limits with another content type",
+			config:         dynamic.RequestLimits{MaxJSONDepth: 1},
+			header:         http.Header{"Content-Type": {"text/plain"}},
+			body:           `[[[]]]`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "multipart parts within limit",
+			config:         dynamic.RequestLimits{MaxMultipartParts: 2},
+			header:         http.Header{"Content-Type": {"multipart/form-data; boundary=foo"}},
+			body:           multipartBody(t, "foo", 2),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "too many multipart parts",
+			config:         dynamic.RequestLimits{MaxMultipartParts: 2},
+			header:         http.Header{"Content-Type": {"multipart/form-data; boundary=foo"}},
+			body:           multipartBody(t, "foo", 3),
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var forwardedBody string
+			next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				forwardedBody = string(body)
+			})
+
+			handler, err := New(t.Context(), next, test.config, "foo")
+			require.NoError(t, err)
+
+			target := test.target
+			if target == "" {
+				target = "/"
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "http://example.com"+target, strings.NewReader(test.body))
+			req.RequestURI = target
+			if test.chunked {
+				req.ContentLength = -1
+				req.TransferEncoding = []string{"chunked"}
+			}
+			for name, values := range test.header {
+				req.Header[name] = values
+			}
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expectedStatus, recorder.Code)
+			if test.expectedStatus == http.StatusOK {
+				assert.Equal(t, test.body, forwardedBody)
+			}
+		})
+	}
+}
+
+func multipartBody(t *testing.T, boundary string, parts int) string {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	require.NoError(t, writer.SetBoundary(boundary))
+
+	for range parts {
+		require.NoError(t, writer.WriteField("foo", "bar"))
+	}
+	require.NoError(t, writer.Close())
+
+	return body.String()
+}
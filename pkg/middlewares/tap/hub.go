@@ -0,0 +1,169 @@
+package tap
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/traefik/traefik/v3/pkg/ip"
+	"github.com/traefik/traefik/v3/pkg/types"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// MaxRate is the maximum number of summaries per second sent to a subscriber.
+	MaxRate = 100
+	// maxSubscribers is the maximum number of concurrent subscribers.
+	maxSubscribers = 10
+	// bufferSize is the number of summaries buffered for a slow subscriber before dropping them.
+	bufferSize = 64
+)
+
+// ErrTooManySubscribers is returned when the maximum number of concurrent subscribers is reached.
+var ErrTooManySubscribers = errors.New("too many tap subscribers")
+
+// Summary is the summary of a request that went through an entry point.
+type Summary struct {
+	Time            time.Time     `json:"time"`
+	EntryPoint      string        `json:"entryPoint,omitempty"`
+	Router          string        `json:"router,omitempty"`
+	Service         string        `json:"service,omitempty"`
+	Server          string        `json:"server,omitempty"`
+	Method          string        `json:"method"`
+	Host            string        `json:"host"`
+	Path            string        `json:"path"`
+	ClientIP        string        `json:"clientIP"`
+	Status          int           `json:"status"`
+	OriginStatus    int           `json:"originStatus,omitempty"`
+	Duration        time.Duration `json:"duration"`
+	OriginDuration  time.Duration `json:"originDuration,omitempty"`
+	RequestHeaders  http.Header   `json:"requestHeaders,omitempty"`
+	ResponseHeaders http.Header   `json:"responseHeaders,omitempty"`
+}
+
+// Filter holds the criteria a summary must match to be sent to a subscriber.
+// A zero value criterion matches all the summaries.
+type Filter struct {
+	Router      string
+	StatusCodes types.HTTPCodeRanges
+	ClientIP    *ip.Checker
+}
+
+func (f Filter) match(summary Summary) bool {
+	if f.Router != "" && f.Router != summary.Router {
+		return false
+	}
+
+	if len(f.StatusCodes) > 0 && !f.StatusCodes.Contains(summary.Status) {
+		return false
+	}
+
+	if f.ClientIP != nil {
+		if ok, err := f.ClientIP.Contains(summary.ClientIP); err != nil || !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Subscription is a live feed of the summaries matching a filter.
+type Subscription struct {
+	filter  Filter
+	limiter *rate.Limiter
+	events  chan Summary
+	dropped atomic.Uint64
+}
+
+// Events returns the channel on which the matching summaries are sent.
+func (s *Subscription) Events() <-chan Summary {
+	return s.events
+}
+
+// Dropped returns the number of matching summaries dropped because of the rate limit or of a slow reader.
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Hub dispatches the request summaries to the subscribers.
+type Hub struct {
+	mu          sync.RWMutex
+	subscribers map[*Subscription]struct{}
+	// count is the number of subscribers, allowing to check for subscribers without locking.
+	count atomic.Int32
+}
+
+// NewHub creates a new Hub.
+func NewHub() *Hub {
+	return &Hub{subscribers: make(map[*Subscription]struct{})}
+}
+
+// Subscribe registers a new subscription for the summaries matching the filter, sent at most at the given rate.
+// The rate is capped to MaxRate.
+func (h *Hub) Subscribe(filter Filter, limit rate.Limit) (*Subscription, error) {
+	if limit <= 0 || limit > MaxRate {
+		limit = MaxRate
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.subscribers) >= maxSubscribers {
+		return nil, ErrTooManySubscribers
+	}
+
+	sub := &Subscription{
+		filter:  filter,
+		limiter: rate.NewLimiter(limit, 1),
+		events:  make(chan Summary, bufferSize),
+	}
+
+	h.subscribers[sub] = struct{}{}
+	h.count.Add(1)
+
+	return sub, nil
+}
+
+// Unsubscribe removes the given subscription and closes its events channel.
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(h.subscribers, sub)
+	h.count.Add(-1)
+	close(sub.events)
+}
+
+// HasSubscribers returns whether at least one subscriber is listening.
+func (h *Hub) HasSubscribers() bool {
+	return h != nil && h.count.Load() > 0
+}
+
+// Publish sends the summary to the matching subscribers, without ever blocking.
+func (h *Hub) Publish(summary Summary) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range h.subscribers {
+		if !sub.filter.match(summary) {
+			continue
+		}
+
+		if !sub.limiter.Allow() {
+			sub.dropped.Add(1)
+			continue
+		}
+
+		select {
+		case sub.events <- summary:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Package tap provides a middleware publishing a summary of each request to a live feed,
+// allowing to inspect the traffic without enabling the access logs.
+package tap
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/containous/alice"
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/middlewares/accesslog"
+	"github.com/traefik/traefik/v3/pkg/middlewares/capture"
+	"github.com/traefik/traefik/v3/pkg/observability/logs"
+	"github.com/traefik/traefik/v3/pkg/redactor"
+)
+
+const typeName = "Tap"
+
+type handler struct {
+	next     http.Handler
+	captured http.Handler
+	hub      *Hub
+}
+
+// WrapHandler returns an alice.Constructor publishing a summary of each request to the given hub.
+// It relies on the access log data table, filled by the router and service handlers, to retrieve the routing information.
+func WrapHandler(hub *Hub) alice.Constructor {
+	return func(next http.Handler) (http.Handler, error) {
+		h := &handler{next: next, hub: hub}
+
+		captured, err := capture.Wrap(http.HandlerFunc(h.serveTapped))
+		if err != nil {
+			return nil, err
+		}
+		h.captured = captured
+
+		return h, nil
+	}
+}
+
+func (h *handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	// Nothing is collected until someone listens to the feed.
+	if !h.hub.HasSubscribers() {
+		h.next.ServeHTTP(rw, req)
+		return
+	}
+
+	h.captured.ServeHTTP(rw, req)
+}
+
+func (h *handler) serveTapped(rw http.ResponseWriter, req *http.Request) {
+	start := time.Now()
+
+	logData := accesslog.GetLogData(req)
+	if logData == nil {
+		logData = &accesslog.LogData{Core: accesslog.CoreLogData{}}
+		req = req.WithContext(context.WithValue(req.Context(), accesslog.DataTableKey, logData))
+	}
+
+	h.next.ServeHTTP(rw, req)
+
+	capt, err := capture.FromContext(req.Context())
+	if err != nil {
+		log.Ctx(req.Context()).Error().Err(err).Str(logs.MiddlewareType, typeName).Msg("Could not get Capture")
+		return
+	}
+
+	clientIP, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		clientIP = req.RemoteAddr
+	}
+
+	summary := Summary{
+		Time:            start,
+		Method:          req.Method,
+		Host:            req.Host,
+		Path:            req.URL.Path,
+		ClientIP:        clientIP,
+		Status:          capt.StatusCode(),
+		Duration:        time.Since(start),
+		RequestHeaders:  redactor.RedactHeaders(req.Header),
+		ResponseHeaders: redactor.RedactHeaders(rw.Header()),
+	}
+
+	summary.EntryPoint, _ = logData.Core[logs.EntryPointName].(string)
+	summary.Router, _ = logData.Core[accesslog.RouterName].(string)
+	summary.Service, _ = logData.Core[accesslog.ServiceName].(string)
+	summary.Server, _ = logData.Core[accesslog.ServiceURL].(string)
+	summary.OriginStatus, _ = logData.Core[accesslog.OriginStatus].(int)
+	summary.OriginDuration, _ = logData.Core[accesslog.OriginDuration].(time.Duration)
+
+	h.hub.Publish(summary)
+}
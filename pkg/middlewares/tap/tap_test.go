@@ -0,0 +1,83 @@
+package tap
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/middlewares/accesslog"
+	"github.com/traefik/traefik/v3/pkg/types"
+)
+
+func TestHandler(t *testing.T) {
+	hub := NewHub()
+
+	next := accesslog.NewFieldHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Set-Cookie", "session=foo")
+		rw.WriteHeader(http.StatusTeapot)
+	}), accesslog.RouterName, "foo@file", nil)
+
+	handler, err := WrapHandler(hub)(next)
+	require.NoError(t, err)
+
+	// Without subscribers, no summary is built.
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/foo", nil))
+
+	sub, err := hub.Subscribe(Filter{Router: "foo@file"}, 0)
+	require.NoError(t, err)
+	t.Cleanup(func() { hub.Unsubscribe(sub) })
+
+	req := httptest.NewRequest(http.MethodGet, "/foo?bar=baz", nil)
+	req.Header.Set("Authorization", "Bearer foo")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, sub.Events(), 1)
+	summary := <-sub.Events()
+
+	assert.Equal(t, "foo@file", summary.Router)
+	assert.Equal(t, "/foo", summary.Path)
+	assert.Equal(t, "192.0.2.1", summary.ClientIP)
+	assert.Equal(t, http.StatusTeapot, summary.Status)
+	assert.Equal(t, "xxxx", summary.RequestHeaders.Get("Authorization"))
+	assert.Equal(t, "xxxx", summary.ResponseHeaders.Get("Set-Cookie"))
+}
+
+func TestHub_Publish(t *testing.T) {
+	hub := NewHub()
+
+	statusCodes, err := types.NewHTTPCodeRanges([]string{"500-599"})
+	require.NoError(t, err)
+
+	sub, err := hub.Subscribe(Filter{StatusCodes: statusCodes}, 1)
+	require.NoError(t, err)
+
+	hub.Publish(Summary{Status: http.StatusOK})
+	hub.Publish(Summary{Status: http.StatusBadGateway})
+	// Dropped by the rate limit.
+	hub.Publish(Summary{Status: http.StatusBadGateway})
+
+	assert.Len(t, sub.Events(), 1)
+	assert.Equal(t, uint64(1), sub.Dropped())
+
+	hub.Unsubscribe(sub)
+	assert.False(t, hub.HasSubscribers())
+
+	_, ok := <-sub.Events()
+	assert.True(t, ok)
+	_, ok = <-sub.Events()
+	assert.False(t, ok)
+}
+
+func TestHub_Subscribe_maxSubscribers(t *testing.T) {
+	hub := NewHub()
+
+	for range maxSubscribers {
+		_, err := hub.Subscribe(Filter{}, 0)
+		require.NoError(t, err)
+	}
+
+	_, err := hub.Subscribe(Filter{}, 0)
+	assert.ErrorIs(t, err, ErrTooManySubscribers)
+}
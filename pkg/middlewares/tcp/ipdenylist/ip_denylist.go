@@ -0,0 +1,89 @@
+package ipdenylist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/ip"
+	"github.com/traefik/traefik/v3/pkg/middlewares"
+	"github.com/traefik/traefik/v3/pkg/tcp"
+)
+
+const (
+	typeName = "IPDenyListerTCP"
+
+	defaultRefreshInterval = time.Hour
+)
+
+// ipDenyLister is a middleware that closes the connections coming from a set of denied IPs.
+type ipDenyLister struct {
+	next     tcp.Handler
+	denyList *ip.List
+	name     string
+}
+
+// New builds a new TCP IPDenyLister given a list of CIDR-Strings, files and URLs to deny.
+func New(ctx context.Context, next tcp.Handler, config dynamic.TCPIPDenyList, name string) (tcp.Handler, error) {
+	logger := middlewares.GetLogger(ctx, name, typeName)
+	logger.Debug().Msg("Creating middleware")
+
+	if len(config.SourceRange) == 0 && len(config.Files) == 0 && len(config.URLs) == 0 {
+		return nil, errors.New("sourceRange, files and urls are empty, IPDenyLister not created")
+	}
+
+	refreshInterval := time.Duration(config.RefreshInterval)
+	if refreshInterval <= 0 {
+		refreshInterval = defaultRefreshInterval
+	}
+
+	sources := ip.ListSources{
+		SourceRange: config.SourceRange,
+		Files:       config.Files,
+		URLs:        config.URLs,
+	}
+
+	denyList, err := ip.NewList(logger.WithContext(ctx), sources, refreshInterval)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load denied IPs: %w", err)
+	}
+
+	logger.Debug().Msgf("Setting up IPDenyLister with %d denied ranges, %d files and %d URLs", len(config.SourceRange), len(config.Files), len(config.URLs))
+
+	return &ipDenyLister{
+		denyList: denyList,
+		next:     next,
+		name:     name,
+	}, nil
+}
+
+func (dl *ipDenyLister) ServeTCP(conn tcp.WriteCloser) {
+	logger := middlewares.GetLogger(context.Background(), dl.name, typeName)
+
+	addr := conn.RemoteAddr().String()
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	denied, err := dl.denyList.Contains(host)
+	if err != nil {
+		logger.Error().Err(err).Msgf("Connection from %s rejected", addr)
+		conn.Close()
+		return
+	}
+
+	if denied {
+		logger.Debug().Msgf("Connection from denied IP %s rejected", addr)
+		conn.Close()
+		return
+	}
+
+	logger.Debug().Msgf("Connection from %s accepted", addr)
+
+	dl.next.ServeTCP(conn)
+}
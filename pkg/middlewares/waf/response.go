@@ -0,0 +1,201 @@
+package waf
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/corazawaf/coraza/v3/types"
+	"github.com/rs/zerolog"
+)
+
+// responseWriter evaluates the rules of the response headers and response body phases.
+// When the response body is inspected, the response is buffered by the transaction,
+// and only sent to the client once the rules of the response body phase are evaluated.
+type responseWriter struct {
+	rw       http.ResponseWriter
+	req      *http.Request
+	tx       types.Transaction
+	firewall *firewall
+
+	code        int
+	wroteHeader bool
+	headersSent bool
+	buffering   bool
+	interrupted bool
+	hijacked    bool
+}
+
+func newResponseWriter(rw http.ResponseWriter, req *http.Request, tx types.Transaction, f *firewall) *responseWriter {
+	return &responseWriter{rw: rw, req: req, tx: tx, firewall: f}
+}
+
+func (r *responseWriter) Header() http.Header {
+	return r.rw.Header()
+}
+
+func (r *responseWriter) WriteHeader(code int) {
+	if r.wroteHeader || r.interrupted {
+		return
+	}
+
+	// Handling informational headers.
+	if code >= 100 && code <= 199 && code != http.StatusSwitchingProtocols {
+		r.rw.WriteHeader(code)
+		return
+	}
+
+	r.wroteHeader = true
+	r.code = code
+
+	for name, values := range r.rw.Header() {
+		for _, value := range values {
+			r.tx.AddResponseHeader(name, value)
+		}
+	}
+
+	if interruption := r.tx.ProcessResponseHeaders(code, r.req.Proto); interruption != nil {
+		r.interrupt(interruption)
+		return
+	}
+
+	r.buffering = code != http.StatusSwitchingProtocols && r.tx.IsResponseBodyAccessible() && r.tx.IsResponseBodyProcessable()
+	if !r.buffering {
+		r.sendHeaders()
+	}
+}
+
+func (r *responseWriter) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+
+	if r.interrupted {
+		// The response of the service is discarded.
+		return len(b), nil
+	}
+
+	if !r.buffering {
+		return r.rw.Write(b)
+	}
+
+	interruption, n, err := r.tx.WriteResponseBody(b)
+	if interruption != nil {
+		r.interrupt(interruption)
+		return len(b), nil
+	}
+	if err != nil || n == len(b) {
+		return n, err
+	}
+
+	// The response body limit is reached, the rest of the body is not inspected.
+	if err := r.sendBuffered(); err != nil {
+		return n, err
+	}
+
+	written, err := r.rw.Write(b[n:])
+
+	return n + written, err
+}
+
+// Flush sends any buffered data to the client.
+// The response is not flushed while it is buffered for the inspection of its body.
+func (r *responseWriter) Flush() {
+	r.WriteHeader(http.StatusOK)
+
+	if r.interrupted || r.buffering {
+		return
+	}
+
+	if flusher, ok := r.rw.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack hijacks the connection.
+func (r *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := r.rw.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a hijacker: %T", r.rw)
+	}
+
+	conn, rw, err := h.Hijack()
+	if err == nil {
+		r.hijacked = true
+	}
+
+	return conn, rw, err
+}
+
+// close evaluates the rules of the response body phase, and sends the buffered response.
+// It must be called once the service has written the response.
+func (r *responseWriter) close(logger *zerolog.Logger) {
+	if r.hijacked {
+		return
+	}
+
+	r.WriteHeader(http.StatusOK)
+
+	if r.interrupted || !r.buffering {
+		return
+	}
+
+	interruption, err := r.tx.ProcessResponseBody()
+	if err != nil {
+		logger.Error().Err(err).Msg("Error while processing the response body")
+		r.interrupted = true
+		http.Error(r.rw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if interruption != nil {
+		r.interrupt(interruption)
+		return
+	}
+
+	if err := r.sendBuffered(); err != nil {
+		logger.Debug().Err(err).Msg("Error while sending the response body")
+	}
+}
+
+// sendBuffered sends the response headers and the buffered response body to the client.
+func (r *responseWriter) sendBuffered() error {
+	r.buffering = false
+
+	body, err := r.tx.ResponseBodyReader()
+	if err != nil {
+		return fmt.Errorf("reading buffered response body: %w", err)
+	}
+
+	r.sendHeaders()
+
+	_, err = io.Copy(r.rw, body)
+
+	return err
+}
+
+func (r *responseWriter) sendHeaders() {
+	if r.headersSent {
+		return
+	}
+
+	r.headersSent = true
+	r.rw.WriteHeader(r.code)
+}
+
+// interrupt replaces the response of the service with the one of the disruptive action.
+func (r *responseWriter) interrupt(interruption *types.Interruption) {
+	r.interrupted = true
+
+	if r.headersSent {
+		// The response of the service is already sent, so it is only truncated.
+		return
+	}
+	r.headersSent = true
+
+	for name := range r.rw.Header() {
+		r.rw.Header().Del(name)
+	}
+
+	r.firewall.interrupt(r.rw, r.req, interruption)
+}
@@ -0,0 +1,250 @@
+package waf
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+	"weak"
+
+	"github.com/corazawaf/coraza/v3"
+	"github.com/corazawaf/coraza/v3/experimental"
+	"github.com/corazawaf/coraza/v3/types"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// unsupportedDirectives are the directives accepted but ignored by Coraza.
+// They are rejected, as ignoring them would silently change the evaluation of the rules.
+var unsupportedDirectives = []string{
+	"SecArgumentSeparator",
+	"SecCookieFormat",
+	"SecRuleScript",
+	"SecRuleUpdateTargetByMsg",
+	"SecUnicodeMap",
+}
+
+// ruleSets holds the rule sets compiled for the WAF middlewares, by configuration.
+var ruleSets = newRuleSetCache()
+
+// ruleSet is a compiled rule set, shared by the WAF middlewares with the same configuration.
+type ruleSet struct {
+	waf coraza.WAF
+	// files are the files read while compiling the rule set, used to detect their changes.
+	files []ruleFile
+}
+
+type ruleFile struct {
+	path    string
+	modTime time.Time
+	size    int64
+}
+
+// newRuleSet compiles the rule set of the configuration.
+func newRuleSet(config dynamic.WAF) (*ruleSet, error) {
+	if err := checkDirectives("rules", config.Rules); err != nil {
+		return nil, err
+	}
+
+	fsys := &ruleFS{}
+
+	wafConfig := coraza.NewWAFConfig().WithRootFS(fsys)
+
+	for _, ruleFile := range config.RuleFiles {
+		if strings.Contains(ruleFile, "*") {
+			matches, err := filepath.Glob(ruleFile)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rule file pattern %q: %w", ruleFile, err)
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("no rule file matches %q", ruleFile)
+			}
+		}
+
+		wafConfig = wafConfig.WithDirectivesFromFile(ruleFile)
+	}
+
+	if strings.TrimSpace(config.Rules) != "" {
+		wafConfig = wafConfig.WithDirectives(config.Rules)
+	}
+
+	switch strings.ToLower(config.Mode) {
+	case modeBlocking:
+		wafConfig = wafConfig.WithDirectives("SecRuleEngine On")
+	case modeDetection:
+		wafConfig = wafConfig.WithDirectives("SecRuleEngine DetectionOnly")
+	}
+
+	if config.MaxRequestBodyBytes > 0 {
+		wafConfig = wafConfig.WithRequestBodyLimit(int(config.MaxRequestBodyBytes))
+	}
+
+	if config.MemRequestBodyBytes > 0 {
+		wafConfig = wafConfig.WithRequestBodyInMemoryLimit(int(config.MemRequestBodyBytes))
+	}
+
+	waf, err := coraza.NewWAF(wafConfig)
+	if fsys.err != nil {
+		return nil, fsys.err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ruleSet{waf: waf, files: fsys.files}, nil
+}
+
+// newTransaction creates a transaction evaluating the rule set against a request.
+func (r *ruleSet) newTransaction(ctx context.Context) types.Transaction {
+	if w, ok := r.waf.(experimental.WAFWithOptions); ok {
+		return w.NewTransactionWithOptions(experimental.Options{Context: ctx})
+	}
+
+	return r.waf.NewTransaction()
+}
+
+// changed reports whether one of the files read while compiling the rule set has changed since.
+func (r *ruleSet) changed() bool {
+	for _, file := range r.files {
+		info, err := os.Stat(file.path)
+		if err != nil || !info.ModTime().Equal(file.modTime) || info.Size() != file.size {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ruleSetCache holds the compiled rule sets, so that they are compiled once per distinct configuration,
+// instead of each time the routers are built.
+// The rule sets are referenced weakly, and removed once no middleware uses them anymore.
+type ruleSetCache struct {
+	mu       sync.Mutex
+	ruleSets map[string]weak.Pointer[ruleSet]
+}
+
+func newRuleSetCache() *ruleSetCache {
+	return &ruleSetCache{ruleSets: make(map[string]weak.Pointer[ruleSet])}
+}
+
+// get returns the rule set of the configuration, compiling it if it is not cached, or if its files have changed.
+func (c *ruleSetCache) get(config dynamic.WAF) (*ruleSet, error) {
+	key, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("computing rule set key: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if rs := c.ruleSets[string(key)].Value(); rs != nil && !rs.changed() {
+		return rs, nil
+	}
+
+	rs, err := newRuleSet(config)
+	if err != nil {
+		return nil, err
+	}
+
+	c.ruleSets[string(key)] = weak.Make(rs)
+	runtime.AddCleanup(rs, c.release, releasedRuleSet{key: string(key), waf: rs.waf})
+
+	return rs, nil
+}
+
+type releasedRuleSet struct {
+	key string
+	waf coraza.WAF
+}
+
+// release removes a rule set which is not used anymore from the cache, and closes it.
+func (c *ruleSetCache) release(released releasedRuleSet) {
+	c.mu.Lock()
+	if rs, ok := c.ruleSets[released.key]; ok && rs.Value() == nil {
+		delete(c.ruleSets, released.key)
+	}
+	c.mu.Unlock()
+
+	if closer, ok := released.waf.(io.Closer); ok {
+		_ = closer.Close()
+	}
+}
+
+// ruleFS is the file system from which Coraza reads the rule files.
+// It records the files read, and the unsupported directives they use.
+type ruleFS struct {
+	files []ruleFile
+	err   error
+}
+
+func (r *ruleFS) Open(name string) (fs.File, error) {
+	return os.Open(name)
+}
+
+func (r *ruleFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	return os.ReadDir(name)
+}
+
+func (r *ruleFS) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
+
+func (r *ruleFS) ReadFile(name string) ([]byte, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	r.files = append(r.files, ruleFile{path: name, modTime: info.ModTime(), size: info.Size()})
+
+	if err := checkDirectives(name, string(content)); err != nil && r.err == nil {
+		r.err = err
+	}
+
+	return content, nil
+}
+
+// checkDirectives returns an error if the directives use one of the unsupported directives.
+func checkDirectives(source, directives string) error {
+	scanner := bufio.NewScanner(strings.NewReader(directives))
+	scanner.Buffer(nil, 1024*1024)
+
+	var line int
+	var continued bool
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		isContinuation := continued
+		continued = strings.HasSuffix(text, "\\")
+
+		if isContinuation || text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		directive := strings.Fields(text)[0]
+		for _, unsupported := range unsupportedDirectives {
+			if strings.EqualFold(directive, unsupported) {
+				return fmt.Errorf("%s:%d: unsupported directive %s", source, line, unsupported)
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading %s: %w", source, err)
+	}
+
+	return nil
+}
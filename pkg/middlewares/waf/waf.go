@@ -0,0 +1,237 @@
+package waf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/corazawaf/coraza/v3/types"
+	"github.com/rs/zerolog"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/middlewares"
+	"github.com/traefik/traefik/v3/pkg/middlewares/accesslog"
+	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+)
+
+const (
+	typeName = "WAF"
+
+	// maxRequestBodyLimit is the maximum request body limit supported by Coraza.
+	maxRequestBodyLimit = 1 << 30
+)
+
+// Modes of the middleware.
+const (
+	modeBlocking  = "blocking"
+	modeDetection = "detection"
+)
+
+// Values of the WAFAction access log field.
+const (
+	actionDetected = "detected"
+	actionBlocked  = "blocked"
+)
+
+// firewall is a middleware evaluating SecLang rule sets with Coraza against the requests and their responses.
+type firewall struct {
+	next    http.Handler
+	name    string
+	ruleSet *ruleSet
+}
+
+// New creates a WAF middleware.
+func New(ctx context.Context, next http.Handler, config dynamic.WAF, name string) (http.Handler, error) {
+	logger := middlewares.GetLogger(ctx, name, typeName)
+	logger.Debug().Msg("Creating middleware")
+
+	if len(config.RuleFiles) == 0 && strings.TrimSpace(config.Rules) == "" {
+		return nil, errors.New("rule files and rules are empty, WAF not created")
+	}
+
+	switch strings.ToLower(config.Mode) {
+	case "", modeBlocking, modeDetection:
+	default:
+		return nil, fmt.Errorf("invalid mode %q, expected %s or %s", config.Mode, modeBlocking, modeDetection)
+	}
+
+	if config.MaxRequestBodyBytes < 0 || config.MaxRequestBodyBytes > maxRequestBodyLimit {
+		return nil, fmt.Errorf("maxRequestBodyBytes must be between 0 and %d, got %d", maxRequestBodyLimit, config.MaxRequestBodyBytes)
+	}
+
+	if config.MemRequestBodyBytes < 0 {
+		return nil, fmt.Errorf("memRequestBodyBytes must be positive, got %d", config.MemRequestBodyBytes)
+	}
+
+	rs, err := ruleSets.get(config)
+	if err != nil {
+		return nil, fmt.Errorf("loading WAF rules: %w", err)
+	}
+
+	return &firewall{
+		next:    next,
+		name:    name,
+		ruleSet: rs,
+	}, nil
+}
+
+func (f *firewall) GetTracingInformation() (string, string) {
+	return f.name, typeName
+}
+
+func (f *firewall) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	logger := middlewares.GetLogger(req.Context(), f.name, typeName)
+
+	tx := f.ruleSet.newTransaction(req.Context())
+	defer func() {
+		tx.ProcessLogging()
+		f.log(logger, req, tx)
+
+		if err := tx.Close(); err != nil {
+			logger.Error().Err(err).Msg("Error while closing the WAF transaction")
+		}
+	}()
+
+	if tx.IsRuleEngineOff() {
+		f.next.ServeHTTP(rw, req)
+		return
+	}
+
+	interruption, err := processRequest(tx, req)
+	if err != nil {
+		logger.Error().Err(err).Msg("Error while processing the request")
+		observability.SetStatusErrorf(req.Context(), "Error while processing the request: %v", err)
+		http.Error(rw, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if interruption != nil {
+		f.interrupt(rw, req, interruption)
+		return
+	}
+
+	writer := newResponseWriter(rw, req, tx, f)
+	f.next.ServeHTTP(writer, req)
+	writer.close(logger)
+}
+
+// processRequest evaluates the rules of the request headers and request body phases.
+// When the request body is inspected, it is buffered by the transaction, and forwarded from its buffer.
+func processRequest(tx types.Transaction, req *http.Request) (*types.Interruption, error) {
+	host, port, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	clientPort, _ := strconv.Atoi(port)
+
+	tx.ProcessConnection(host, clientPort, "", 0)
+	tx.ProcessURI(req.URL.String(), req.Method, req.Proto)
+
+	for name, values := range req.Header {
+		for _, value := range values {
+			tx.AddRequestHeader(name, value)
+		}
+	}
+
+	// The Host and Transfer-Encoding headers are removed from the request headers by the Go HTTP server.
+	if req.Host != "" {
+		tx.AddRequestHeader("Host", req.Host)
+		tx.SetServerName(req.Host)
+	}
+
+	for _, encoding := range req.TransferEncoding {
+		tx.AddRequestHeader("Transfer-Encoding", encoding)
+	}
+
+	if interruption := tx.ProcessRequestHeaders(); interruption != nil {
+		return interruption, nil
+	}
+
+	if tx.IsRequestBodyAccessible() && req.Body != nil && req.Body != http.NoBody {
+		interruption, _, err := tx.ReadRequestBodyFrom(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+
+		if interruption != nil {
+			return interruption, nil
+		}
+
+		buffered, err := tx.RequestBodyReader()
+		if err != nil {
+			return nil, fmt.Errorf("reading buffered request body: %w", err)
+		}
+
+		// The part of the body beyond the body limit, if any, is not buffered and forwarded as is.
+		req.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(buffered, req.Body), req.Body}
+	}
+
+	return tx.ProcessRequestBody()
+}
+
+// interrupt writes the response of the given disruptive action.
+func (f *firewall) interrupt(rw http.ResponseWriter, req *http.Request, interruption *types.Interruption) {
+	observability.SetStatusErrorf(req.Context(), "Request blocked by WAF rule %d", interruption.RuleID)
+
+	status := interruption.Status
+	if status == 0 {
+		status = http.StatusForbidden
+	}
+
+	switch interruption.Action {
+	case "redirect":
+		http.Redirect(rw, req, interruption.Data, status)
+		return
+	case "drop":
+		rw.Header().Set("Connection", "close")
+	}
+
+	http.Error(rw, http.StatusText(status), status)
+}
+
+// log writes the matched rules into the logs and the access logs.
+func (f *firewall) log(logger *zerolog.Logger, req *http.Request, tx types.Transaction) {
+	var interruptedBy int
+	if interruption := tx.Interruption(); interruption != nil {
+		interruptedBy = interruption.RuleID
+	}
+
+	var ids []string
+	for _, rule := range tx.MatchedRules() {
+		// The rules without the log action, such as the setup ones, are not reported,
+		// unless they interrupted the transaction.
+		if l, ok := rule.(interface{ Log() bool }); ok && !l.Log() && rule.Rule().ID() != interruptedBy {
+			continue
+		}
+
+		ids = append(ids, strconv.Itoa(rule.Rule().ID()))
+
+		logger.Debug().
+			Int("ruleID", rule.Rule().ID()).
+			Str("severity", rule.Rule().Severity().String()).
+			Str("data", rule.Data()).
+			Msgf("WAF rule matched: %s", rule.Message())
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	action := actionDetected
+	if tx.IsInterrupted() {
+		action = actionBlocked
+	}
+
+	logData := accesslog.GetLogData(req)
+	if logData != nil {
+		logData.Core[accesslog.WAFMatchedRules] = strings.Join(ids, ",")
+		logData.Core[accesslog.WAFAction] = action
+	}
+}
@@ -0,0 +1,411 @@
+package waf
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/middlewares/accesslog"
+)
+
+const testRules = `
+SecRequestBodyAccess On
+SecResponseBodyAccess On
+SecResponseBodyMimeType text/plain
+SecRule ARGS "@rx (?i)union\s+select" "id:1,phase:2,deny,status:403,msg:'SQL injection'"
+SecRule REQUEST_HEADERS:User-Agent "@contains nikto" "id:2,phase:1,t:lowercase,deny,status:403"
+SecRule ARGS:next "@beginsWith http" "id:3,phase:1,redirect:https://example.com/blocked"
+SecRule RESPONSE_HEADERS:X-Leak "@streq secret" "id:4,phase:3,deny,status:502"
+SecRule REQUEST_FILENAME "@beginsWith /detect" "id:5,phase:1,log,pass,msg:'Detected'"
+SecRule RESPONSE_BODY "@contains secret-token" "id:6,phase:4,deny,status:502"
+SecRule ARGS:xss "@detectXSS" "id:7,phase:2,deny,status:403"
+SecRule ARGS:sqli "@detectSQLi" "id:8,phase:2,deny,status:403"
+`
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		config        dynamic.WAF
+		expectedError bool
+	}{
+		{
+			desc:          "empty config",
+			config:        dynamic.WAF{},
+			expectedError: true,
+		},
+		{
+			desc: "invalid mode",
+			config: dynamic.WAF{
+				Rules: testRules,
+				Mode:  "foo",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "invalid max request body bytes",
+			config: dynamic.WAF{
+				Rules:               testRules,
+				MaxRequestBodyBytes: -1,
+			},
+			expectedError: true,
+		},
+		{
+			desc: "max request body bytes above the Coraza limit",
+			config: dynamic.WAF{
+				Rules:               testRules,
+				MaxRequestBodyBytes: 2 << 30,
+			},
+			expectedError: true,
+		},
+		{
+			desc: "invalid rules",
+			config: dynamic.WAF{
+				Rules: `SecRule ARGS "@foo bar" "id:1,phase:1,deny"`,
+			},
+			expectedError: true,
+		},
+		{
+			desc: "missing rule file",
+			config: dynamic.WAF{
+				RuleFiles: []string{"./fixtures/missing.conf"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "unsupported directive",
+			config: dynamic.WAF{
+				Rules: `SecRuleScript /etc/traefik/rule.lua "block"`,
+			},
+			expectedError: true,
+		},
+		{
+			desc: "unsupported directive in a rule file",
+			config: dynamic.WAF{
+				RuleFiles: []string{"./fixtures/unsupported.conf"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "unknown directive",
+			config: dynamic.WAF{
+				Rules: `SecFoo On`,
+			},
+			expectedError: true,
+		},
+		{
+			desc: "unsupported regular expression",
+			config: dynamic.WAF{
+				Rules: `SecRule ARGS "@rx foo(?!bar)" "id:1,phase:1,deny"`,
+			},
+			expectedError: true,
+		},
+		{
+			desc: "rule file pattern without match",
+			config: dynamic.WAF{
+				RuleFiles: []string{"./fixtures/missing/*.conf"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "valid config",
+			config: dynamic.WAF{
+				Rules: testRules,
+				Mode:  "detection",
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			handler, err := New(t.Context(), next, test.config, "traefikTest")
+			if test.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, handler)
+		})
+	}
+}
+
+func TestWAF_ServeHTTP(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		config           dynamic.WAF
+		method           string
+		target           string
+		header           http.Header
+		body             string
+		responseHeader   http.Header
+		expectedStatus   int
+		expectedLocation string
+		expectedBody     string
+		expectedRules    string
+		expectedAction   string
+	}{
+		{
+			desc:           "clean request",
+			config:         dynamic.WAF{Rules: testRules},
+			target:         "/?q=foo",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "foo",
+		},
+		{
+			desc:           "blocked by the request headers",
+			config:         dynamic.WAF{Rules: testRules},
+			target:         "/",
+			header:         http.Header{"User-Agent": {"Nikto/2.1.6"}},
+			expectedStatus: http.StatusForbidden,
+			expectedRules:  "2",
+			expectedAction: "blocked",
+		},
+		{
+			desc:           "blocked by the query",
+			config:         dynamic.WAF{Rules: testRules},
+			target:         "/?q=1+UNION+SELECT+password",
+			expectedStatus: http.StatusForbidden,
+			expectedRules:  "1",
+			expectedAction: "blocked",
+		},
+		{
+			desc:           "blocked by the body",
+			config:         dynamic.WAF{Rules: testRules},
+			method:         http.MethodPost,
+			target:         "/",
+			header:         http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
+			body:           "q=1 union select password",
+			expectedStatus: http.StatusForbidden,
+			expectedRules:  "1",
+			expectedAction: "blocked",
+		},
+		{
+			desc:           "body forwarded",
+			config:         dynamic.WAF{Rules: testRules},
+			method:         http.MethodPost,
+			target:         "/",
+			header:         http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
+			body:           "q=bar",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "q=bar",
+		},
+		{
+			desc: "body too large",
+			config: dynamic.WAF{
+				Rules:               testRules,
+				MaxRequestBodyBytes: 4,
+			},
+			method:         http.MethodPost,
+			target:         "/",
+			body:           "q=bar",
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			desc: "body buffered beyond the memory limit",
+			config: dynamic.WAF{
+				Rules:               testRules,
+				MemRequestBodyBytes: 5,
+			},
+			method:         http.MethodPost,
+			target:         "/",
+			header:         http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
+			body:           "q=bar&r=1 union select password",
+			expectedStatus: http.StatusForbidden,
+			expectedRules:  "1",
+			expectedAction: "blocked",
+		},
+		{
+			desc:           "blocked by libinjection XSS detection",
+			config:         dynamic.WAF{Rules: testRules},
+			target:         "/?xss=%3Cscript%3Ealert(1)%3C/script%3E",
+			expectedStatus: http.StatusForbidden,
+			expectedRules:  "7",
+			expectedAction: "blocked",
+		},
+		{
+			desc:           "blocked by libinjection SQL injection detection",
+			config:         dynamic.WAF{Rules: testRules},
+			target:         "/?sqli=1%27%20OR%20%271%27=%271",
+			expectedStatus: http.StatusForbidden,
+			expectedRules:  "8",
+			expectedAction: "blocked",
+		},
+		{
+			desc:             "redirected",
+			config:           dynamic.WAF{Rules: testRules},
+			target:           "/?next=http://evil.com",
+			expectedStatus:   http.StatusFound,
+			expectedLocation: "https://example.com/blocked",
+			expectedRules:    "3",
+			expectedAction:   "blocked",
+		},
+		{
+			desc:           "blocked by the response headers",
+			config:         dynamic.WAF{Rules: testRules},
+			target:         "/",
+			responseHeader: http.Header{"X-Leak": {"secret"}},
+			expectedStatus: http.StatusBadGateway,
+			expectedBody:   http.StatusText(http.StatusBadGateway) + "\n",
+			expectedRules:  "4",
+			expectedAction: "blocked",
+		},
+		{
+			desc:           "blocked by the response body",
+			config:         dynamic.WAF{Rules: testRules},
+			target:         "/leak",
+			expectedStatus: http.StatusBadGateway,
+			expectedBody:   http.StatusText(http.StatusBadGateway) + "\n",
+			expectedRules:  "6",
+			expectedAction: "blocked",
+		},
+		{
+			desc: "detection mode",
+			config: dynamic.WAF{
+				Rules: testRules,
+				Mode:  "detection",
+			},
+			target:         "/?q=1+UNION+SELECT+password",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "foo",
+			expectedRules:  "1",
+			expectedAction: "detected",
+		},
+		{
+			desc:           "logged rule",
+			config:         dynamic.WAF{Rules: testRules},
+			target:         "/detect",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "foo",
+			expectedRules:  "5",
+			expectedAction: "detected",
+		},
+		{
+			desc: "rule files",
+			config: dynamic.WAF{
+				RuleFiles: []string{"./fixtures/setup.conf"},
+			},
+			target:         "/?q=1+UNION+SELECT+password",
+			expectedStatus: http.StatusForbidden,
+			expectedRules:  "942100,949110",
+			expectedAction: "blocked",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				for name, values := range test.responseHeader {
+					rw.Header()[name] = values
+				}
+
+				if req.Method == http.MethodPost {
+					body, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					assert.Equal(t, int64(len(body)), req.ContentLength)
+
+					_, _ = rw.Write(body)
+					return
+				}
+
+				rw.Header().Set("Content-Type", "text/plain")
+
+				if req.URL.Path == "/leak" {
+					_, _ = rw.Write([]byte("secret-"))
+					_, _ = rw.Write([]byte("token"))
+					return
+				}
+
+				_, _ = rw.Write([]byte("foo"))
+			})
+
+			handler, err := New(t.Context(), next, test.config, "traefikTest")
+			require.NoError(t, err)
+
+			method := test.method
+			if method == "" {
+				method = http.MethodGet
+			}
+
+			var body io.Reader
+			if test.body != "" {
+				body = strings.NewReader(test.body)
+			}
+
+			req := httptest.NewRequest(method, "http://localhost"+test.target, body)
+			for name, values := range test.header {
+				req.Header[name] = values
+			}
+
+			logData := &accesslog.LogData{Core: accesslog.CoreLogData{}}
+			req = req.WithContext(context.WithValue(req.Context(), accesslog.DataTableKey, logData))
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expectedStatus, recorder.Code)
+			assert.Equal(t, test.expectedLocation, recorder.Header().Get("Location"))
+			if test.expectedBody != "" {
+				assert.Equal(t, test.expectedBody, recorder.Body.String())
+			}
+			if test.responseHeader != nil && test.expectedStatus == http.StatusBadGateway {
+				assert.Empty(t, recorder.Header().Get("X-Leak"))
+			}
+
+			if test.expectedRules == "" {
+				assert.NotContains(t, logData.Core, accesslog.WAFMatchedRules)
+				return
+			}
+
+			assert.Equal(t, test.expectedRules, logData.Core[accesslog.WAFMatchedRules])
+			assert.Equal(t, test.expectedAction, logData.Core[accesslog.WAFAction])
+		})
+	}
+}
+
+func TestNew_ruleSetCache(t *testing.T) {
+	ruleFile := filepath.Join(t.TempDir(), "rules.conf")
+	require.NoError(t, os.WriteFile(ruleFile, []byte(`SecRule ARGS "@streq foo" "id:1,phase:1,deny"`), 0o600))
+
+	config := dynamic.WAF{RuleFiles: []string{ruleFile}}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	first, err := New(t.Context(), next, config, "first")
+	require.NoError(t, err)
+
+	second, err := New(t.Context(), next, config, "second")
+	require.NoError(t, err)
+
+	// The rule set is compiled once for the same configuration.
+	assert.Same(t, first.(*firewall).ruleSet, second.(*firewall).ruleSet)
+
+	other, err := New(t.Context(), next, dynamic.WAF{RuleFiles: []string{ruleFile}, Mode: modeDetection}, "other")
+	require.NoError(t, err)
+
+	assert.NotSame(t, first.(*firewall).ruleSet, other.(*firewall).ruleSet)
+
+	// The rule set is compiled again once its rule files change.
+	require.NoError(t, os.WriteFile(ruleFile, []byte(`SecRule ARGS "@streq bar" "id:1,phase:1,deny"`), 0o600))
+	require.NoError(t, os.Chtimes(ruleFile, time.Now(), time.Now().Add(time.Minute)))
+
+	third, err := New(t.Context(), next, config, "third")
+	require.NoError(t, err)
+
+	assert.NotSame(t, first.(*firewall).ruleSet, third.(*firewall).ruleSet)
+
+	recorder := httptest.NewRecorder()
+	third.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/?q=bar", nil))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -8,23 +9,39 @@ import (
 	"strings"
 
 	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/fingerprint"
+	"github.com/traefik/traefik/v3/pkg/geoip"
 	"github.com/traefik/traefik/v3/pkg/ip"
 	"github.com/traefik/traefik/v3/pkg/middlewares/requestdecorator"
 	"github.com/traefik/traefik/v3/pkg/muxer"
 )
 
 var httpFuncs = matcherBuilderFuncs{
-	"ClientIP":     expectNParameters(clientIP, 1),
-	"Method":       expectNParameters(method, 1),
-	"Host":         expectNParameters(host, 1),
-	"HostRegexp":   expectNParameters(hostRegexp, 1),
-	"Path":         expectNParameters(path, 1),
-	"PathRegexp":   expectNParameters(pathRegexp, 1),
-	"PathPrefix":   expectNParameters(pathPrefix, 1),
-	"Header":       expectNParameters(header, 2),
-	"HeaderRegexp": expectNParameters(headerRegexp, 2),
-	"Query":        expectNParameters(query, 1, 2),
-	"QueryRegexp":  expectNParameters(queryRegexp, 1, 2),
+	"ClientIP":               expectNParameters(clientIP, 1),
+	"ClientCountry":          expectNParameters(geoIPNotConfigured, 1),
+	"ClientASN":              expectNParameters(geoIPNotConfigured, 1),
+	"ClientJA3":              expectNParameters(clientJA3, 1),
+	"ClientJA4":              expectNParameters(clientJA4, 1),
+	"ClientHTTP2Fingerprint": expectNParameters(clientHTTP2Fingerprint, 1),
+	"Method":                 expectNParameters(method, 1),
+	"Host":                   expectNParameters(host, 1),
+	"HostRegexp":             expectNParameters(hostRegexp, 1),
+	"Path":                   expectNParameters(path, 1),
+	"PathRegexp":             expectNParameters(pathRegexp, 1),
+	"PathPrefix":             expectNParameters(pathPrefix, 1),
+	"Header":                 expectNParameters(header, 2),
+	"HeaderRegexp":           expectNParameters(headerRegexp, 2),
+	"Query":                  expectNParameters(query, 1, 2),
+	"QueryRegexp":            expectNParameters(queryRegexp, 1, 2),
+}
+
+// WithGeoIP enables the ClientCountry and ClientASN matchers,
+// which locate the client IP, selected with the GeoIP IP strategy, in the GeoIP databases.
+func WithGeoIP(geoIP *geoip.GeoIP) Options {
+	return func(syntaxFuncs map[string]matcherBuilderFuncs) {
+		syntaxFuncs["v3"]["ClientCountry"] = expectNParameters(clientCountry(geoIP), 1)
+		syntaxFuncs["v3"]["ClientASN"] = expectNParameters(clientASN(geoIP), 1)
+	}
 }
 
 func expectNParameters(fn func(*matchersTree, ...string) error, n ...int) func(*matchersTree, ...string) error {
@@ -58,6 +75,79 @@ func clientIP(tree *matchersTree, clientIP ...string) error {
 	return nil
 }
 
+func geoIPNotConfigured(_ *matchersTree, _ ...string) error {
+	return errors.New("GeoIP matchers require the GeoIP databases to be configured")
+}
+
+func clientCountry(geoIP *geoip.GeoIP) matcherBuilderFunc {
+	return func(tree *matchersTree, countries ...string) error {
+		country, err := geoip.ParseCountry(countries[0])
+		if err != nil {
+			return err
+		}
+
+		tree.matcher = func(req *http.Request) bool {
+			location, err := geoIP.LookupRequest(req, geoIP.ClientIP(req))
+			if err != nil {
+				log.Ctx(req.Context()).Warn().Err(err).Msg("ClientCountry matcher: could not locate client IP")
+				return false
+			}
+
+			return location.Country == country
+		}
+
+		return nil
+	}
+}
+
+func clientASN(geoIP *geoip.GeoIP) matcherBuilderFunc {
+	return func(tree *matchersTree, asns ...string) error {
+		asn, err := geoip.ParseASN(asns[0])
+		if err != nil {
+			return err
+		}
+
+		tree.matcher = func(req *http.Request) bool {
+			location, err := geoIP.LookupRequest(req, geoIP.ClientIP(req))
+			if err != nil {
+				log.Ctx(req.Context()).Warn().Err(err).Msg("ClientASN matcher: could not locate client IP")
+				return false
+			}
+
+			return location.ASN == asn
+		}
+
+		return nil
+	}
+}
+
+func clientJA3(tree *matchersTree, ja3 ...string) error {
+	tree.matcher = func(req *http.Request) bool {
+		fingerprints := fingerprint.FromContext(req.Context())
+		return fingerprints != nil && fingerprints.JA3 != "" && strings.EqualFold(fingerprints.JA3, ja3[0])
+	}
+
+	return nil
+}
+
+func clientJA4(tree *matchersTree, ja4 ...string) error {
+	tree.matcher = func(req *http.Request) bool {
+		fingerprints := fingerprint.FromContext(req.Context())
+		return fingerprints != nil && fingerprints.JA4 != "" && strings.EqualFold(fingerprints.JA4, ja4[0])
+	}
+
+	return nil
+}
+
+func clientHTTP2Fingerprint(tree *matchersTree, http2Fingerprint ...string) error {
+	tree.matcher = func(req *http.Request) bool {
+		fingerprints := fingerprint.FromContext(req.Context())
+		return fingerprints != nil && fingerprints.HTTP2() != "" && fingerprints.HTTP2() == http2Fingerprint[0]
+	}
+
+	return nil
+}
+
 func method(tree *matchersTree, methods ...string) error {
 	method := strings.ToUpper(methods[0])
 
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -8,6 +9,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/fingerprint"
+	"github.com/traefik/traefik/v3/pkg/geoip"
 	"github.com/traefik/traefik/v3/pkg/middlewares/requestdecorator"
 )
 
@@ -97,6 +100,208 @@ func TestClientIPMatcher(t *testing.T) {
 	}
 }
 
+func TestGeoIPMatchers(t *testing.T) {
+	geoIP, err := geoip.New("../../geoip/fixtures/country.mmdb", "../../geoip/fixtures/asn.mmdb", nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc          string
+		rule          string
+		geoIP         *geoip.GeoIP
+		expected      map[string]int
+		expectedError bool
+	}{
+		{
+			desc:          "GeoIP not configured",
+			rule:          "ClientCountry(`FR`)",
+			expectedError: true,
+		},
+		{
+			desc:          "invalid ClientCountry matcher",
+			rule:          "ClientCountry(`France`)",
+			geoIP:         geoIP,
+			expectedError: true,
+		},
+		{
+			desc:          "invalid ClientCountry matcher (too many parameters)",
+			rule:          "ClientCountry(`FR`, `DE`)",
+			geoIP:         geoIP,
+			expectedError: true,
+		},
+		{
+			desc:          "invalid ClientASN matcher",
+			rule:          "ClientASN(`foo`)",
+			geoIP:         geoIP,
+			expectedError: true,
+		},
+		{
+			desc:  "valid ClientCountry matcher",
+			rule:  "ClientCountry(`fr`)",
+			geoIP: geoIP,
+			expected: map[string]int{
+				"1.2.3.4":     http.StatusOK,
+				"5.6.7.8":     http.StatusNotFound,
+				"9.9.9.9":     http.StatusNotFound,
+				"2001:db8::1": http.StatusNotFound,
+			},
+		},
+		{
+			desc:  "valid ClientASN matcher",
+			rule:  "ClientASN(`AS64513`)",
+			geoIP: geoIP,
+			expected: map[string]int{
+				"1.2.3.4": http.StatusNotFound,
+				"5.6.7.8": http.StatusOK,
+			},
+		},
+		{
+			desc:  "valid ClientCountry and ClientASN matchers",
+			rule:  "ClientCountry(`US`) || ClientASN(`64512`)",
+			geoIP: geoIP,
+			expected: map[string]int{
+				"1.2.3.4":     http.StatusOK,
+				"5.6.7.8":     http.StatusNotFound,
+				"2001:db8::1": http.StatusOK,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var opts []Options
+			if test.geoIP != nil {
+				opts = append(opts, WithGeoIP(test.geoIP))
+			}
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			parser, err := NewSyntaxParser(opts...)
+			require.NoError(t, err)
+
+			muxer := NewMuxer(parser, nil)
+
+			err = muxer.AddRoute(test.rule, "", 0, "", handler)
+			if test.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			results := make(map[string]int)
+			for remoteAddr := range test.expected {
+				w := httptest.NewRecorder()
+
+				req := httptest.NewRequest(http.MethodGet, "https://example.com", http.NoBody)
+				req.RemoteAddr = net.JoinHostPort(remoteAddr, "1234")
+
+				muxer.ServeHTTP(w, req)
+				results[remoteAddr] = w.Code
+			}
+			assert.Equal(t, test.expected, results)
+		})
+	}
+}
+
+func TestClientFingerprintMatchers(t *testing.T) {
+	fingerprints := &fingerprint.Fingerprints{
+		JA3: "cd08e31494f9531f560d64c695473da9",
+		JA4: "t13d1516h2_8daaf6152771_e5627efa2ab1",
+	}
+	fingerprints.SetHTTP2("1:65536;2:0;4:6291456;6:262144|15663105|0|m,a,s,p")
+
+	testCases := []struct {
+		desc          string
+		rule          string
+		fingerprints  *fingerprint.Fingerprints
+		expected      int
+		expectedError bool
+	}{
+		{
+			desc:          "invalid ClientJA3 matcher (too many parameters)",
+			rule:          "ClientJA3(`foo`, `bar`)",
+			expectedError: true,
+		},
+		{
+			desc:          "invalid ClientJA4 matcher (no parameter)",
+			rule:          "ClientJA4()",
+			expectedError: true,
+		},
+		{
+			desc:         "matching ClientJA3 matcher",
+			rule:         "ClientJA3(`CD08E31494F9531F560D64C695473DA9`)",
+			fingerprints: fingerprints,
+			expected:     http.StatusOK,
+		},
+		{
+			desc:         "not matching ClientJA3 matcher",
+			rule:         "ClientJA3(`ada70206e40642a3e4461f35503241d5`)",
+			fingerprints: fingerprints,
+			expected:     http.StatusNotFound,
+		},
+		{
+			desc:         "matching ClientJA4 matcher",
+			rule:         "ClientJA4(`t13d1516h2_8daaf6152771_e5627efa2ab1`)",
+			fingerprints: fingerprints,
+			expected:     http.StatusOK,
+		},
+		{
+			desc:         "matching ClientHTTP2Fingerprint matcher",
+			rule:         "ClientHTTP2Fingerprint(`1:65536;2:0;4:6291456;6:262144|15663105|0|m,a,s,p`)",
+			fingerprints: fingerprints,
+			expected:     http.StatusOK,
+		},
+		{
+			desc:         "not matching ClientHTTP2Fingerprint matcher",
+			rule:         "ClientHTTP2Fingerprint(`1:65536;4:131072;5:16384|12517377|0|m,p,a,s`)",
+			fingerprints: fingerprints,
+			expected:     http.StatusNotFound,
+		},
+		{
+			desc:         "unknown HTTP/2 fingerprint",
+			rule:         "ClientHTTP2Fingerprint(`1:65536;2:0;4:6291456;6:262144|15663105|0|m,a,s,p`)",
+			fingerprints: &fingerprint.Fingerprints{},
+			expected:     http.StatusNotFound,
+		},
+		{
+			desc:     "no fingerprints",
+			rule:     "ClientJA4(`t13d1516h2_8daaf6152771_e5627efa2ab1`) || ClientJA3(`cd08e31494f9531f560d64c695473da9`)",
+			expected: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			parser, err := NewSyntaxParser()
+			require.NoError(t, err)
+
+			muxer := NewMuxer(parser, nil)
+
+			err = muxer.AddRoute(test.rule, "", 0, "", handler)
+			if test.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "https://example.com", http.NoBody)
+			if test.fingerprints != nil {
+				req = req.WithContext(fingerprint.NewContext(req.Context(), test.fingerprints))
+			}
+
+			w := httptest.NewRecorder()
+			muxer.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expected, w.Code)
+		})
+	}
+}
+
 func TestMethodMatcher(t *testing.T) {
 	testCases := []struct {
 		desc          string
@@ -34,9 +34,10 @@ func WithMatcher(syntax, matcherName string, builderFunc func(params ...string)
 }
 
 func NewSyntaxParser(opts ...Options) (SyntaxParser, error) {
+	// The matchers are cloned so that the options do not alter the other parsers.
 	syntaxFuncs := map[string]matcherBuilderFuncs{
-		"v2": httpFuncsV2,
-		"v3": httpFuncs,
+		"v2": maps.Clone(httpFuncsV2),
+		"v3": maps.Clone(httpFuncs),
 	}
 
 	for _, opt := range opts {
@@ -1,6 +1,7 @@
 package tcp
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"slices"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/go-acme/lego/v5/challenge/tlsalpn01"
 	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/geoip"
 	"github.com/traefik/traefik/v3/pkg/ip"
 	"github.com/traefik/traefik/v3/pkg/muxer"
 )
@@ -15,10 +17,21 @@ import (
 var tcpFuncs = map[string]func(*matchersTree, ...string) error{
 	"ALPN":          expect1Parameter(alpn),
 	"ClientIP":      expect1Parameter(clientIP),
+	"ClientCountry": expect1Parameter(geoIPNotConfigured),
+	"ClientASN":     expect1Parameter(geoIPNotConfigured),
 	"HostSNI":       expect1Parameter(hostSNI),
 	"HostSNIRegexp": expect1Parameter(hostSNIRegexp),
 }
 
+// WithGeoIP enables the ClientCountry and ClientASN matchers,
+// which locate the client IP in the GeoIP databases.
+func WithGeoIP(geoIP *geoip.GeoIP) Option {
+	return func(matcherFuncs map[string]func(*matchersTree, ...string) error) {
+		matcherFuncs["ClientCountry"] = expect1Parameter(clientCountry(geoIP))
+		matcherFuncs["ClientASN"] = expect1Parameter(clientASN(geoIP))
+	}
+}
+
 func expect1Parameter(fn func(*matchersTree, ...string) error) func(*matchersTree, ...string) error {
 	return func(route *matchersTree, s ...string) error {
 		if len(s) != 1 {
@@ -62,6 +75,52 @@ func clientIP(tree *matchersTree, clientIP ...string) error {
 	return nil
 }
 
+func geoIPNotConfigured(_ *matchersTree, _ ...string) error {
+	return errors.New("GeoIP matchers require the GeoIP databases to be configured")
+}
+
+func clientCountry(geoIP *geoip.GeoIP) func(*matchersTree, ...string) error {
+	return func(tree *matchersTree, countries ...string) error {
+		country, err := geoip.ParseCountry(countries[0])
+		if err != nil {
+			return err
+		}
+
+		tree.matcher = func(meta ConnData) bool {
+			location, err := meta.locations.Lookup(geoIP, meta.remoteIP)
+			if err != nil {
+				log.Warn().Err(err).Msg("ClientCountry matcher: could not locate remote address")
+				return false
+			}
+
+			return location.Country == country
+		}
+
+		return nil
+	}
+}
+
+func clientASN(geoIP *geoip.GeoIP) func(*matchersTree, ...string) error {
+	return func(tree *matchersTree, asns ...string) error {
+		asn, err := geoip.ParseASN(asns[0])
+		if err != nil {
+			return err
+		}
+
+		tree.matcher = func(meta ConnData) bool {
+			location, err := meta.locations.Lookup(geoIP, meta.remoteIP)
+			if err != nil {
+				log.Warn().Err(err).Msg("ClientASN matcher: could not locate remote address")
+				return false
+			}
+
+			return location.ASN == asn
+		}
+
+		return nil
+	}
+}
+
 var hostOrIP = regexp.MustCompile(`^(\*\.)?[[:word:]\.\-\:]+$`)
 
 // hostSNI checks if the SNI Host of the connection match the matcher host.
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/geoip"
 	"github.com/traefik/traefik/v3/pkg/tcp"
 )
 
@@ -321,6 +322,86 @@ func Test_ClientIP(t *testing.T) {
 	}
 }
 
+func Test_GeoIP(t *testing.T) {
+	geoIP, err := geoip.New("../../geoip/fixtures/country.mmdb", "../../geoip/fixtures/asn.mmdb", nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc     string
+		rule     string
+		geoIP    *geoip.GeoIP
+		expected map[string]bool
+		buildErr bool
+	}{
+		{
+			desc:     "GeoIP not configured",
+			rule:     "ClientCountry(`FR`)",
+			buildErr: true,
+		},
+		{
+			desc:     "Invalid ClientCountry matcher",
+			rule:     "ClientCountry(`France`)",
+			geoIP:    geoIP,
+			buildErr: true,
+		},
+		{
+			desc:     "Invalid ClientASN matcher",
+			rule:     "ClientASN(`0`)",
+			geoIP:    geoIP,
+			buildErr: true,
+		},
+		{
+			desc:  "valid ClientCountry matcher",
+			rule:  "ClientCountry(`DE`)",
+			geoIP: geoIP,
+			expected: map[string]bool{
+				"5.6.7.8": true,
+				"1.2.3.4": false,
+				"9.9.9.9": false,
+			},
+		},
+		{
+			desc:  "valid ClientASN matcher",
+			rule:  "ClientASN(`64512`)",
+			geoIP: geoIP,
+			expected: map[string]bool{
+				"1.2.3.4": true,
+				"5.6.7.8": false,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var opts []Option
+			if test.geoIP != nil {
+				opts = append(opts, WithGeoIP(test.geoIP))
+			}
+
+			muxer, err := NewMuxer(nil, opts...)
+			require.NoError(t, err)
+
+			err = muxer.AddRoute(test.rule, "", 0, "", tcp.HandlerFunc(func(conn tcp.WriteCloser) {}))
+			if test.buildErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for remoteIP, match := range test.expected {
+				meta := ConnData{
+					remoteIP: remoteIP,
+				}
+
+				handler, _ := muxer.Match(meta)
+				assert.Equal(t, match, handler != nil, remoteIP)
+			}
+		})
+	}
+}
+
 func Test_ALPN(t *testing.T) {
 	testCases := []struct {
 		desc     string
@@ -2,12 +2,14 @@ package tcp
 
 import (
 	"fmt"
+	"maps"
 	"net"
 	"slices"
 	"sort"
 	"strings"
 
 	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/geoip"
 	"github.com/traefik/traefik/v3/pkg/rules"
 	"github.com/traefik/traefik/v3/pkg/tcp"
 	"github.com/traefik/traefik/v3/pkg/types"
@@ -19,6 +21,8 @@ type ConnData struct {
 	serverName string
 	remoteIP   string
 	alpnProtos []string
+	// locations memoizes the locations of the remote IP looked up by the matchers of the connection.
+	locations *geoip.Locations
 }
 
 // NewConnData builds a connData struct from the given parameters.
@@ -35,22 +39,32 @@ func NewConnData(serverName string, remoteAddr net.Addr, alpnProtos []string) (C
 		serverName: types.CanonicalDomain(serverName),
 		remoteIP:   remoteIP,
 		alpnProtos: alpnProtos,
+		locations:  &geoip.Locations{},
 	}, nil
 }
 
+// Option configures the v3 syntax matchers of a Muxer.
+type Option func(map[string]func(*matchersTree, ...string) error)
+
 // Muxer defines a muxer that handles TCP routing with rules.
 type Muxer struct {
 	routes routes
 
 	parser              predicate.Parser
 	parserV2            predicate.Parser
+	matcherFuncs        map[string]func(*matchersTree, ...string) error
 	providersPrecedence []string
 }
 
 // NewMuxer returns a TCP muxer.
-func NewMuxer(providersPrecedence []string) (*Muxer, error) {
+func NewMuxer(providersPrecedence []string, opts ...Option) (*Muxer, error) {
+	matcherFuncs := maps.Clone(tcpFuncs)
+	for _, opt := range opts {
+		opt(matcherFuncs)
+	}
+
 	var matcherNames []string
-	for matcherName := range tcpFuncs {
+	for matcherName := range matcherFuncs {
 		matcherNames = append(matcherNames, matcherName)
 	}
 
@@ -75,6 +89,7 @@ func NewMuxer(providersPrecedence []string) (*Muxer, error) {
 	return &Muxer{
 		parser:              parser,
 		parserV2:            parserV2,
+		matcherFuncs:        matcherFuncs,
 		providersPrecedence: providersPrecedence,
 	}, nil
 }
@@ -144,7 +159,7 @@ func (m *Muxer) AddRoute(rule string, syntax string, priority int, providerName
 			return fmt.Errorf("error while parsing rule %s: %w", rule, err)
 		}
 
-		matcherFuncs = tcpFuncs
+		matcherFuncs = m.matcherFuncs
 	}
 
 	buildTree, ok := parse.(rules.TreeBuilder)
@@ -30,10 +30,13 @@ var (
 const (
 	ddConfigReloadsName           = "config.reload.total"
 	ddLastConfigReloadSuccessName = "config.reload.lastSuccessTimestamp"
+	ddConfigSnapshotTimestampName = "config.snapshot.timestamp"
 	ddOpenConnsName               = "open.connections"
 
 	ddTLSCertsNotAfterTimestampName = "tls.certs.notAfterTimestamp"
 
+	ddCSPViolationsName = "csp.violations.total"
+
 	ddEntryPointReqsName        = "entrypoint.request.total"
 	ddEntryPointReqsTLSName     = "entrypoint.request.tls.total"
 	ddEntryPointReqDurationName = "entrypoint.request.duration"
@@ -73,8 +76,10 @@ func RegisterDatadog(ctx context.Context, config *otypes.Datadog) Registry {
 	registry := &standardRegistry{
 		configReloadsCounter:           datadogClient.NewCounter(ddConfigReloadsName, 1.0),
 		lastConfigReloadSuccessGauge:   datadogClient.NewGauge(ddLastConfigReloadSuccessName),
+		configSnapshotTimestampGauge:   datadogClient.NewGauge(ddConfigSnapshotTimestampName),
 		openConnectionsGauge:           datadogClient.NewGauge(ddOpenConnsName),
 		tlsCertsNotAfterTimestampGauge: datadogClient.NewGauge(ddTLSCertsNotAfterTimestampName),
+		cspViolationsCounter:           datadogClient.NewCounter(ddCSPViolationsName, 1.0),
 	}
 
 	if config.AddEntryPointsLabels {
@@ -27,10 +27,13 @@ var (
 const (
 	influxDBConfigReloadsName           = "traefik.config.reload.total"
 	influxDBLastConfigReloadSuccessName = "traefik.config.reload.lastSuccessTimestamp"
+	influxDBConfigSnapshotTimestampName = "traefik.config.snapshot.timestamp"
 	influxDBOpenConnsName               = "traefik.open.connections"
 
 	influxDBTLSCertsNotAfterTimestampName = "traefik.tls.certs.notAfterTimestamp"
 
+	influxDBCSPViolationsName = "traefik.csp.violations.total"
+
 	influxDBEntryPointReqsName        = "traefik.entrypoint.requests.total"
 	influxDBEntryPointReqsTLSName     = "traefik.entrypoint.requests.tls.total"
 	influxDBEntryPointReqDurationName = "traefik.entrypoint.request.duration"
@@ -82,8 +85,10 @@ func RegisterInfluxDB2(ctx context.Context, config *otypes.InfluxDB2) Registry {
 	registry := &standardRegistry{
 		configReloadsCounter:           influxDB2Store.NewCounter(influxDBConfigReloadsName),
 		lastConfigReloadSuccessGauge:   influxDB2Store.NewGauge(influxDBLastConfigReloadSuccessName),
+		configSnapshotTimestampGauge:   influxDB2Store.NewGauge(influxDBConfigSnapshotTimestampName),
 		openConnectionsGauge:           influxDB2Store.NewGauge(influxDBOpenConnsName),
 		tlsCertsNotAfterTimestampGauge: influxDB2Store.NewGauge(influxDBTLSCertsNotAfterTimestampName),
+		cspViolationsCounter:           influxDB2Store.NewCounter(influxDBCSPViolationsName),
 	}
 
 	if config.AddEntryPointsLabels {
@@ -18,17 +18,24 @@ type Registry interface {
 	IsRouterEnabled() bool
 	// IsSvcEnabled shows whether metrics instrumentation is enabled on services.
 	IsSvcEnabled() bool
+	// IsServerEnabled shows whether metrics instrumentation is enabled on services servers.
+	IsServerEnabled() bool
 
 	// server metrics
 
 	ConfigReloadsCounter() metrics.Counter
 	LastConfigReloadSuccessGauge() metrics.Gauge
+	ConfigSnapshotTimestampGauge() metrics.Gauge
 	OpenConnectionsGauge() metrics.Gauge
 
 	// TLS
 
 	TLSCertsNotAfterTimestampGauge() metrics.Gauge
 
+	// security metrics
+
+	CSPViolationsCounter() metrics.Counter
+
 	// entry point metrics
 
 	EntryPointReqsCounter() CounterWithHeaders
@@ -54,6 +61,14 @@ type Registry interface {
 	ServiceServerUpGauge() metrics.Gauge
 	ServiceReqsBytesCounter() metrics.Counter
 	ServiceRespsBytesCounter() metrics.Counter
+
+	// service server metrics
+
+	ServiceServerReqDurationHistogram() ScalableHistogram
+	ServiceServerConnectDurationHistogram() metrics.Histogram
+	ServiceServerTLSHandshakeDurationHistogram() metrics.Histogram
+	ServiceServerTimeToFirstByteHistogram() metrics.Histogram
+	ServiceServerConnsCounter() metrics.Counter
 }
 
 // NewVoidRegistry is a noop implementation of metrics.Registry.
@@ -68,8 +83,10 @@ func NewVoidRegistry() Registry {
 func NewMultiRegistry(registries []Registry) Registry {
 	var configReloadsCounter []metrics.Counter
 	var lastConfigReloadSuccessGauge []metrics.Gauge
+	var configSnapshotTimestampGauge []metrics.Gauge
 	var openConnectionsGauge []metrics.Gauge
 	var tlsCertsNotAfterTimestampGauge []metrics.Gauge
+	var cspViolationsCounter []metrics.Counter
 	var entryPointReqsCounter []CounterWithHeaders
 	var entryPointReqsTLSCounter []metrics.Counter
 	var entryPointReqDurationHistogram []ScalableHistogram
@@ -87,6 +104,11 @@ func NewMultiRegistry(registries []Registry) Registry {
 	var serviceServerUpGauge []metrics.Gauge
 	var serviceReqsBytesCounter []metrics.Counter
 	var serviceRespsBytesCounter []metrics.Counter
+	var serviceServerReqDurationHistogram []ScalableHistogram
+	var serviceServerConnectDurationHistogram []metrics.Histogram
+	var serviceServerTLSHandshakeDurationHistogram []metrics.Histogram
+	var serviceServerTimeToFirstByteHistogram []metrics.Histogram
+	var serviceServerConnsCounter []metrics.Counter
 
 	for _, r := range registries {
 		if r.ConfigReloadsCounter() != nil {
@@ -95,12 +117,18 @@ func NewMultiRegistry(registries []Registry) Registry {
 		if r.LastConfigReloadSuccessGauge() != nil {
 			lastConfigReloadSuccessGauge = append(lastConfigReloadSuccessGauge, r.LastConfigReloadSuccessGauge())
 		}
+		if r.ConfigSnapshotTimestampGauge() != nil {
+			configSnapshotTimestampGauge = append(configSnapshotTimestampGauge, r.ConfigSnapshotTimestampGauge())
+		}
 		if r.OpenConnectionsGauge() != nil {
 			openConnectionsGauge = append(openConnectionsGauge, r.OpenConnectionsGauge())
 		}
 		if r.TLSCertsNotAfterTimestampGauge() != nil {
 			tlsCertsNotAfterTimestampGauge = append(tlsCertsNotAfterTimestampGauge, r.TLSCertsNotAfterTimestampGauge())
 		}
+		if r.CSPViolationsCounter() != nil {
+			cspViolationsCounter = append(cspViolationsCounter, r.CSPViolationsCounter())
+		}
 		if r.EntryPointReqsCounter() != nil {
 			entryPointReqsCounter = append(entryPointReqsCounter, r.EntryPointReqsCounter())
 		}
@@ -152,16 +180,34 @@ func NewMultiRegistry(registries []Registry) Registry {
 		if r.ServiceRespsBytesCounter() != nil {
 			serviceRespsBytesCounter = append(serviceRespsBytesCounter, r.ServiceRespsBytesCounter())
 		}
+		if r.ServiceServerReqDurationHistogram() != nil {
+			serviceServerReqDurationHistogram = append(serviceServerReqDurationHistogram, r.ServiceServerReqDurationHistogram())
+		}
+		if r.ServiceServerConnectDurationHistogram() != nil {
+			serviceServerConnectDurationHistogram = append(serviceServerConnectDurationHistogram, r.ServiceServerConnectDurationHistogram())
+		}
+		if r.ServiceServerTLSHandshakeDurationHistogram() != nil {
+			serviceServerTLSHandshakeDurationHistogram = append(serviceServerTLSHandshakeDurationHistogram, r.ServiceServerTLSHandshakeDurationHistogram())
+		}
+		if r.ServiceServerTimeToFirstByteHistogram() != nil {
+			serviceServerTimeToFirstByteHistogram = append(serviceServerTimeToFirstByteHistogram, r.ServiceServerTimeToFirstByteHistogram())
+		}
+		if r.ServiceServerConnsCounter() != nil {
+			serviceServerConnsCounter = append(serviceServerConnsCounter, r.ServiceServerConnsCounter())
+		}
 	}
 
 	return &standardRegistry{
 		epEnabled:                      len(entryPointReqsCounter) > 0 || len(entryPointReqDurationHistogram) > 0,
 		svcEnabled:                     len(serviceReqsCounter) > 0 || len(serviceReqDurationHistogram) > 0 || len(serviceRetriesCounter) > 0 || len(serviceServerUpGauge) > 0,
 		routerEnabled:                  len(routerReqsCounter) > 0 || len(routerReqDurationHistogram) > 0,
+		serverEnabled:                  len(serviceServerReqDurationHistogram) > 0 || len(serviceServerConnsCounter) > 0,
 		configReloadsCounter:           multi.NewCounter(configReloadsCounter...),
 		lastConfigReloadSuccessGauge:   multi.NewGauge(lastConfigReloadSuccessGauge...),
+		configSnapshotTimestampGauge:   multi.NewGauge(configSnapshotTimestampGauge...),
 		openConnectionsGauge:           multi.NewGauge(openConnectionsGauge...),
 		tlsCertsNotAfterTimestampGauge: multi.NewGauge(tlsCertsNotAfterTimestampGauge...),
+		cspViolationsCounter:           multi.NewCounter(cspViolationsCounter...),
 		entryPointReqsCounter:          NewMultiCounterWithHeaders(entryPointReqsCounter...),
 		entryPointReqsTLSCounter:       multi.NewCounter(entryPointReqsTLSCounter...),
 		entryPointReqDurationHistogram: MultiHistogram(entryPointReqDurationHistogram),
@@ -179,6 +225,12 @@ func NewMultiRegistry(registries []Registry) Registry {
 		serviceServerUpGauge:           multi.NewGauge(serviceServerUpGauge...),
 		serviceReqsBytesCounter:        multi.NewCounter(serviceReqsBytesCounter...),
 		serviceRespsBytesCounter:       multi.NewCounter(serviceRespsBytesCounter...),
+
+		serviceServerReqDurationHistogram:          MultiHistogram(serviceServerReqDurationHistogram),
+		serviceServerConnectDurationHistogram:      multi.NewHistogram(serviceServerConnectDurationHistogram...),
+		serviceServerTLSHandshakeDurationHistogram: multi.NewHistogram(serviceServerTLSHandshakeDurationHistogram...),
+		serviceServerTimeToFirstByteHistogram:      multi.NewHistogram(serviceServerTimeToFirstByteHistogram...),
+		serviceServerConnsCounter:                  multi.NewCounter(serviceServerConnsCounter...),
 	}
 }
 
@@ -186,10 +238,13 @@ type standardRegistry struct {
 	epEnabled                      bool
 	routerEnabled                  bool
 	svcEnabled                     bool
+	serverEnabled                  bool
 	configReloadsCounter           metrics.Counter
 	lastConfigReloadSuccessGauge   metrics.Gauge
+	configSnapshotTimestampGauge   metrics.Gauge
 	openConnectionsGauge           metrics.Gauge
 	tlsCertsNotAfterTimestampGauge metrics.Gauge
+	cspViolationsCounter           metrics.Counter
 	entryPointReqsCounter          CounterWithHeaders
 	entryPointReqsTLSCounter       metrics.Counter
 	entryPointReqDurationHistogram ScalableHistogram
@@ -207,6 +262,12 @@ type standardRegistry struct {
 	serviceServerUpGauge           metrics.Gauge
 	serviceReqsBytesCounter        metrics.Counter
 	serviceRespsBytesCounter       metrics.Counter
+
+	serviceServerReqDurationHistogram          ScalableHistogram
+	serviceServerConnectDurationHistogram      metrics.Histogram
+	serviceServerTLSHandshakeDurationHistogram metrics.Histogram
+	serviceServerTimeToFirstByteHistogram      metrics.Histogram
+	serviceServerConnsCounter                  metrics.Counter
 }
 
 func (r *standardRegistry) IsEpEnabled() bool {
@@ -221,6 +282,10 @@ func (r *standardRegistry) IsSvcEnabled() bool {
 	return r.svcEnabled
 }
 
+func (r *standardRegistry) IsServerEnabled() bool {
+	return r.serverEnabled
+}
+
 func (r *standardRegistry) ConfigReloadsCounter() metrics.Counter {
 	return r.configReloadsCounter
 }
@@ -229,6 +294,10 @@ func (r *standardRegistry) LastConfigReloadSuccessGauge() metrics.Gauge {
 	return r.lastConfigReloadSuccessGauge
 }
 
+func (r *standardRegistry) ConfigSnapshotTimestampGauge() metrics.Gauge {
+	return r.configSnapshotTimestampGauge
+}
+
 func (r *standardRegistry) OpenConnectionsGauge() metrics.Gauge {
 	return r.openConnectionsGauge
 }
@@ -237,6 +306,10 @@ func (r *standardRegistry) TLSCertsNotAfterTimestampGauge() metrics.Gauge {
 	return r.tlsCertsNotAfterTimestampGauge
 }
 
+func (r *standardRegistry) CSPViolationsCounter() metrics.Counter {
+	return r.cspViolationsCounter
+}
+
 func (r *standardRegistry) EntryPointReqsCounter() CounterWithHeaders {
 	return r.entryPointReqsCounter
 }
@@ -305,12 +378,40 @@ func (r *standardRegistry) ServiceRespsBytesCounter() metrics.Counter {
 	return r.serviceRespsBytesCounter
 }
 
+func (r *standardRegistry) ServiceServerReqDurationHistogram() ScalableHistogram {
+	return r.serviceServerReqDurationHistogram
+}
+
+func (r *standardRegistry) ServiceServerConnectDurationHistogram() metrics.Histogram {
+	return r.serviceServerConnectDurationHistogram
+}
+
+func (r *standardRegistry) ServiceServerTLSHandshakeDurationHistogram() metrics.Histogram {
+	return r.serviceServerTLSHandshakeDurationHistogram
+}
+
+func (r *standardRegistry) ServiceServerTimeToFirstByteHistogram() metrics.Histogram {
+	return r.serviceServerTimeToFirstByteHistogram
+}
+
+func (r *standardRegistry) ServiceServerConnsCounter() metrics.Counter {
+	return r.serviceServerConnsCounter
+}
+
 // ScalableHistogram is a Histogram with a predefined time unit,
 // used when producing observations without explicitly setting the observed value.
 type ScalableHistogram interface {
 	With(labelValues ...string) ScalableHistogram
 	Observe(v float64)
 	ObserveFromStart(start time.Time)
+	// ObserveFromStartWithExemplar behaves like ObserveFromStart,
+	// but attaches the given exemplar labels to the observation when the underlying histogram supports it.
+	ObserveFromStartWithExemplar(start time.Time, exemplar map[string]string)
+}
+
+// exemplarHistogram is implemented by histograms able to attach exemplars to their observations.
+type exemplarHistogram interface {
+	ObserveWithExemplar(v float64, exemplar map[string]string)
 }
 
 // HistogramWithScale is a histogram that will convert its observed value to the specified unit.
@@ -327,6 +428,11 @@ func (s *HistogramWithScale) With(labelValues ...string) ScalableHistogram {
 
 // ObserveFromStart implements ScalableHistogram.
 func (s *HistogramWithScale) ObserveFromStart(start time.Time) {
+	s.ObserveFromStartWithExemplar(start, nil)
+}
+
+// ObserveFromStartWithExemplar implements ScalableHistogram.
+func (s *HistogramWithScale) ObserveFromStartWithExemplar(start time.Time, exemplar map[string]string) {
 	if s.unit <= 0 {
 		return
 	}
@@ -335,6 +441,12 @@ func (s *HistogramWithScale) ObserveFromStart(start time.Time) {
 	if d < 0 {
 		d = 0
 	}
+
+	if eh, ok := s.histogram.(exemplarHistogram); ok && len(exemplar) > 0 {
+		eh.ObserveWithExemplar(d, exemplar)
+		return
+	}
+
 	s.histogram.Observe(d)
 }
 
@@ -364,6 +476,13 @@ func (h MultiHistogram) ObserveFromStart(start time.Time) {
 	}
 }
 
+// ObserveFromStartWithExemplar implements ScalableHistogram.
+func (h MultiHistogram) ObserveFromStartWithExemplar(start time.Time, exemplar map[string]string) {
+	for _, histogram := range h {
+		histogram.ObserveFromStartWithExemplar(start, exemplar)
+	}
+}
+
 // Observe implements ScalableHistogram.
 func (h MultiHistogram) Observe(v float64) {
 	for _, histogram := range h {
@@ -116,6 +116,8 @@ func (c *histogramMock) Start() {}
 
 func (c *histogramMock) ObserveFromStart(t time.Time) {}
 
+func (c *histogramMock) ObserveFromStartWithExemplar(t time.Time, _ map[string]string) {}
+
 func (c *histogramMock) Observe(v float64) {
 	c.lastHistogramValue = v
 }
@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
@@ -45,6 +46,10 @@ type SemConvMetricsRegistry struct {
 	httpServerRequestDuration httpconv.ServerRequestDuration
 	// client metrics
 	httpClientRequestDuration httpconv.ClientRequestDuration
+	// stream metrics
+	streamDuration metric.Float64Histogram
+	streamMessages metric.Int64Counter
+	streamIO       metric.Int64Counter
 }
 
 // NewSemConvMetricRegistry registers all stables semantic conventions metrics.
@@ -77,9 +82,34 @@ func NewSemConvMetricRegistry(ctx context.Context, config *otypes.OTLP) (*SemCon
 		return nil, fmt.Errorf("can't build httpClientRequestDuration histogram: %w", err)
 	}
 
+	streamDuration, err := meter.Float64Histogram("traefik.stream.duration",
+		metric.WithDescription("Duration of WebSocket and gRPC streams."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(config.ExplicitBoundaries...))
+	if err != nil {
+		return nil, fmt.Errorf("can't build streamDuration histogram: %w", err)
+	}
+
+	streamMessages, err := meter.Int64Counter("traefik.stream.messages",
+		metric.WithDescription("Number of messages exchanged on WebSocket and gRPC streams."),
+		metric.WithUnit("{message}"))
+	if err != nil {
+		return nil, fmt.Errorf("can't build streamMessages counter: %w", err)
+	}
+
+	streamIO, err := meter.Int64Counter("traefik.stream.io",
+		metric.WithDescription("Number of bytes exchanged on WebSocket and gRPC streams."),
+		metric.WithUnit("By"))
+	if err != nil {
+		return nil, fmt.Errorf("can't build streamIO counter: %w", err)
+	}
+
 	return &SemConvMetricsRegistry{
 		httpServerRequestDuration: httpServerRequestDuration,
 		httpClientRequestDuration: httpClientRequestDuration,
+		streamDuration:            streamDuration,
+		streamMessages:            streamMessages,
+		streamIO:                  streamIO,
 	}, nil
 }
 
@@ -101,6 +131,33 @@ func (s *SemConvMetricsRegistry) HTTPClientRequestDuration() httpconv.ClientRequ
 	return s.httpClientRequestDuration
 }
 
+// StreamDuration returns the stream duration histogram.
+func (s *SemConvMetricsRegistry) StreamDuration() metric.Float64Histogram {
+	if s == nil || s.streamDuration == nil {
+		return noop.Float64Histogram{}
+	}
+
+	return s.streamDuration
+}
+
+// StreamMessages returns the stream messages counter.
+func (s *SemConvMetricsRegistry) StreamMessages() metric.Int64Counter {
+	if s == nil || s.streamMessages == nil {
+		return noop.Int64Counter{}
+	}
+
+	return s.streamMessages
+}
+
+// StreamIO returns the stream bytes counter.
+func (s *SemConvMetricsRegistry) StreamIO() metric.Int64Counter {
+	if s == nil || s.streamIO == nil {
+		return noop.Int64Counter{}
+	}
+
+	return s.streamIO
+}
+
 // RegisterOpenTelemetry registers all OpenTelemetry metrics.
 func RegisterOpenTelemetry(ctx context.Context, config *otypes.OTLP) Registry {
 	if openTelemetryMeterProvider == nil {
@@ -124,8 +181,10 @@ func RegisterOpenTelemetry(ctx context.Context, config *otypes.OTLP) Registry {
 		svcEnabled:                     config.AddServicesLabels,
 		configReloadsCounter:           newOTLPCounterFrom(meter, configReloadsTotalName, "Config reloads"),
 		lastConfigReloadSuccessGauge:   newOTLPGaugeFrom(meter, configLastReloadSuccessName, "Last config reload success", "ms"),
+		configSnapshotTimestampGauge:   newOTLPGaugeFrom(meter, configSnapshotTimestampName, "Creation timestamp of the configuration snapshot served for a provider, 0 once the provider is ready", "s"),
 		openConnectionsGauge:           newOTLPGaugeFrom(meter, openConnectionsName, "How many open connections exist, by entryPoint and protocol", "1"),
 		tlsCertsNotAfterTimestampGauge: newOTLPGaugeFrom(meter, tlsCertsNotAfterTimestampName, "Certificate expiration timestamp", "s"),
+		cspViolationsCounter:           newOTLPCounterFrom(meter, cspViolationsTotalName, "How many Content-Security-Policy violations were reported, partitioned by directive and disposition."),
 	}
 
 	if config.AddEntryPointsLabels {
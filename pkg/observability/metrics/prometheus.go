@@ -24,12 +24,16 @@ const (
 	metricConfigPrefix          = MetricNamePrefix + "config_"
 	configReloadsTotalName      = metricConfigPrefix + "reloads_total"
 	configLastReloadSuccessName = metricConfigPrefix + "last_reload_success"
+	configSnapshotTimestampName = metricConfigPrefix + "snapshot_timestamp"
 	openConnectionsName         = MetricNamePrefix + "open_connections"
 
 	// TLS.
 	metricsTLSPrefix              = MetricNamePrefix + "tls_"
 	tlsCertsNotAfterTimestampName = metricsTLSPrefix + "certs_not_after"
 
+	// CSP.
+	cspViolationsTotalName = MetricNamePrefix + "csp_violations_total"
+
 	// entry point.
 	metricEntryPointPrefix        = MetricNamePrefix + "entrypoint_"
 	entryPointReqsTotalName       = metricEntryPointPrefix + "requests_total"
@@ -55,6 +59,14 @@ const (
 	serviceServerUpName        = metricServicePrefix + "server_up"
 	serviceReqsBytesTotalName  = metricServicePrefix + "requests_bytes_total"
 	serviceRespsBytesTotalName = metricServicePrefix + "responses_bytes_total"
+
+	// service server level.
+	metricServiceServerPrefix             = metricServicePrefix + "server_"
+	serviceServerReqDurationName          = metricServiceServerPrefix + "request_duration_seconds"
+	serviceServerConnectDurationName      = metricServiceServerPrefix + "connect_duration_seconds"
+	serviceServerTLSHandshakeDurationName = metricServiceServerPrefix + "tls_handshake_duration_seconds"
+	serviceServerTimeToFirstByteName      = metricServiceServerPrefix + "time_to_first_byte_seconds"
+	serviceServerConnsTotalName           = metricServiceServerPrefix + "connections_total"
 )
 
 // promState holds all metric state internally and acts as the only Collector we register for Prometheus.
@@ -74,8 +86,9 @@ var promState = newPrometheusState()
 var promRegistry = stdprometheus.NewRegistry()
 
 // PrometheusHandler exposes Prometheus routes.
+// The OpenMetrics format is negotiated with the scraper, which is required to expose the histogram exemplars.
 func PrometheusHandler() http.Handler {
-	return promhttp.HandlerFor(promRegistry, promhttp.HandlerOpts{})
+	return promhttp.HandlerFor(promRegistry, promhttp.HandlerOpts{EnableOpenMetrics: true})
 }
 
 // RegisterPrometheus registers all Prometheus metrics.
@@ -116,10 +129,18 @@ func initStandardRegistry(config *otypes.Prometheus) Registry {
 		Name: configLastReloadSuccessName,
 		Help: "Last config reload success",
 	}, []string{})
+	configSnapshotTimestamp := newGaugeFrom(stdprometheus.GaugeOpts{
+		Name: configSnapshotTimestampName,
+		Help: "Creation timestamp of the configuration snapshot served for a provider, 0 once the provider is ready",
+	}, []string{"provider"})
 	tlsCertsNotAfterTimestamp := newGaugeFrom(stdprometheus.GaugeOpts{
 		Name: tlsCertsNotAfterTimestampName,
 		Help: "Certificate expiration timestamp",
 	}, []string{"cn", "serial", "sans"})
+	cspViolations := newCounterFrom(stdprometheus.CounterOpts{
+		Name: cspViolationsTotalName,
+		Help: "How many Content-Security-Policy violations were reported, partitioned by directive and disposition.",
+	}, []string{"directive", "disposition"})
 	openConnections := newGaugeFrom(stdprometheus.GaugeOpts{
 		Name: openConnectionsName,
 		Help: "How many open connections exist, by entryPoint and protocol",
@@ -128,7 +149,9 @@ func initStandardRegistry(config *otypes.Prometheus) Registry {
 	promState.vectors = []vector{
 		configReloads.cv,
 		lastConfigReloadSuccess.gv,
+		configSnapshotTimestamp.gv,
 		tlsCertsNotAfterTimestamp.gv,
+		cspViolations.cv,
 		openConnections.gv,
 	}
 
@@ -136,9 +159,12 @@ func initStandardRegistry(config *otypes.Prometheus) Registry {
 		epEnabled:                      config.AddEntryPointsLabels,
 		routerEnabled:                  config.AddRoutersLabels,
 		svcEnabled:                     config.AddServicesLabels,
+		serverEnabled:                  config.AddServersLabels,
 		configReloadsCounter:           configReloads,
 		lastConfigReloadSuccessGauge:   lastConfigReloadSuccess,
+		configSnapshotTimestampGauge:   configSnapshotTimestamp,
 		tlsCertsNotAfterTimestampGauge: tlsCertsNotAfterTimestamp,
+		cspViolationsCounter:           cspViolations,
 		openConnectionsGauge:           openConnections,
 	}
 
@@ -267,6 +293,47 @@ func initStandardRegistry(config *otypes.Prometheus) Registry {
 		reg.serviceRespsBytesCounter = serviceRespsBytesTotal
 	}
 
+	if config.AddServersLabels {
+		serviceServerReqDurations := newHistogramFrom(stdprometheus.HistogramOpts{
+			Name:    serviceServerReqDurationName,
+			Help:    "How long it took to process the request on a service server, partitioned by status code and method.",
+			Buckets: buckets,
+		}, []string{"code", "method", "service", "url"})
+		serviceServerConnectDurations := newHistogramFrom(stdprometheus.HistogramOpts{
+			Name:    serviceServerConnectDurationName,
+			Help:    "How long it took to establish a new connection to a service server.",
+			Buckets: buckets,
+		}, []string{"service", "url"})
+		serviceServerTLSHandshakeDurations := newHistogramFrom(stdprometheus.HistogramOpts{
+			Name:    serviceServerTLSHandshakeDurationName,
+			Help:    "How long it took to perform the TLS handshake with a service server.",
+			Buckets: buckets,
+		}, []string{"service", "url"})
+		serviceServerTimeToFirstByte := newHistogramFrom(stdprometheus.HistogramOpts{
+			Name:    serviceServerTimeToFirstByteName,
+			Help:    "How long it took for a service server to send the first byte of its response.",
+			Buckets: buckets,
+		}, []string{"service", "url"})
+		serviceServerConns := newCounterFrom(stdprometheus.CounterOpts{
+			Name: serviceServerConnsTotalName,
+			Help: "How many connections were used to forward requests to a service server, partitioned by whether they were reused.",
+		}, []string{"reused", "service", "url"})
+
+		promState.vectors = append(promState.vectors,
+			serviceServerReqDurations.hv,
+			serviceServerConnectDurations.hv,
+			serviceServerTLSHandshakeDurations.hv,
+			serviceServerTimeToFirstByte.hv,
+			serviceServerConns.cv,
+		)
+
+		reg.serviceServerReqDurationHistogram, _ = NewHistogramWithScale(serviceServerReqDurations, time.Second)
+		reg.serviceServerConnectDurationHistogram = serviceServerConnectDurations
+		reg.serviceServerTLSHandshakeDurationHistogram = serviceServerTLSHandshakeDurations
+		reg.serviceServerTimeToFirstByteHistogram = serviceServerTimeToFirstByte
+		reg.serviceServerConnsCounter = serviceServerConns
+	}
+
 	return reg
 }
 
@@ -636,6 +703,18 @@ func (h *histogram) Observe(value float64) {
 	h.collector.Observe(value)
 }
 
+// ObserveWithExemplar observes the given value and attaches the exemplar labels to it.
+// It falls back to a plain observation if the collector does not support exemplars.
+func (h *histogram) ObserveWithExemplar(value float64, exemplar map[string]string) {
+	eo, ok := h.collector.(stdprometheus.ExemplarObserver)
+	if !ok {
+		h.collector.Observe(value)
+		return
+	}
+
+	eo.ObserveWithExemplar(value, exemplar)
+}
+
 func (h *histogram) Describe(ch chan<- *stdprometheus.Desc) {
 	h.hv.Describe(ch)
 }
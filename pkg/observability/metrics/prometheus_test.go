@@ -10,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
 	otypes "github.com/traefik/traefik/v3/pkg/observability/types"
 	th "github.com/traefik/traefik/v3/pkg/testhelpers"
@@ -104,6 +105,10 @@ func TestPrometheus(t *testing.T) {
 
 	prometheusRegistry.ConfigReloadsCounter().Add(1)
 	prometheusRegistry.LastConfigReloadSuccessGauge().Set(float64(time.Now().Unix()))
+	prometheusRegistry.
+		ConfigSnapshotTimestampGauge().
+		With("provider", "consul").
+		Set(float64(time.Now().Unix()))
 	prometheusRegistry.
 		OpenConnectionsGauge().
 		With("entrypoint", "test", "protocol", "TCP").
@@ -114,6 +119,11 @@ func TestPrometheus(t *testing.T) {
 		With("cn", "value", "serial", "value", "sans", "value").
 		Set(float64(time.Now().Unix()))
 
+	prometheusRegistry.
+		CSPViolationsCounter().
+		With("directive", "script-src-elem", "disposition", "enforce").
+		Add(1)
+
 	prometheusRegistry.
 		EntryPointReqsCounter().
 		With(map[string][]string{"User-Agent": {"foobar"}}, "code", strconv.Itoa(http.StatusOK), "method", http.MethodGet, "protocol", "http", "entrypoint", "http").
@@ -198,6 +208,13 @@ func TestPrometheus(t *testing.T) {
 			name:   configLastReloadSuccessName,
 			assert: buildTimestampAssert(t, configLastReloadSuccessName),
 		},
+		{
+			name: configSnapshotTimestampName,
+			labels: map[string]string{
+				"provider": "consul",
+			},
+			assert: buildTimestampAssert(t, configSnapshotTimestampName),
+		},
 		{
 			name: openConnectionsName,
 			labels: map[string]string{
@@ -215,6 +232,14 @@ func TestPrometheus(t *testing.T) {
 			},
 			assert: buildTimestampAssert(t, tlsCertsNotAfterTimestampName),
 		},
+		{
+			name: cspViolationsTotalName,
+			labels: map[string]string{
+				"directive":   "script-src-elem",
+				"disposition": "enforce",
+			},
+			assert: buildCounterAssert(t, cspViolationsTotalName, 1),
+		},
 		{
 			name: entryPointReqsTotalName,
 			labels: map[string]string{
@@ -738,3 +763,132 @@ func buildTimestampAssert(t *testing.T, metricName string) func(family *dto.Metr
 		}
 	}
 }
+
+func TestPrometheusExemplars(t *testing.T) {
+	t.Cleanup(promState.reset)
+
+	prometheusRegistry := RegisterPrometheus(t.Context(), &otypes.Prometheus{AddServicesLabels: true})
+	defer promRegistry.Unregister(promState)
+
+	conf := dynamic.Configuration{
+		HTTP: th.BuildConfiguration(
+			th.WithServices(
+				th.WithService("service", th.WithServiceServersLoadBalancer(th.WithServers(th.WithServer("http://localhost:9000")))),
+			),
+		),
+	}
+	OnConfigurationUpdate(conf, nil)
+
+	labelNamesValues := []string{
+		"service", "service",
+		"code", strconv.Itoa(http.StatusOK),
+		"method", http.MethodGet,
+		"protocol", "http",
+	}
+	prometheusRegistry.
+		ServiceReqDurationHistogram().
+		With(labelNamesValues...).
+		ObserveFromStartWithExemplar(time.Now(), map[string]string{"trace_id": "4bf92f3577b34da6a3ce929d0e0e4736"})
+
+	delayForTrackingCompletion()
+
+	metric := findMetricByLabelNamesValues(findMetricFamily(serviceReqDurationName, mustScrape()), labelNamesValues...)
+	require.NotNil(t, metric)
+
+	var exemplars []*dto.Exemplar
+	for _, bucket := range metric.GetHistogram().GetBucket() {
+		if bucket.GetExemplar() != nil {
+			exemplars = append(exemplars, bucket.GetExemplar())
+		}
+	}
+
+	require.Len(t, exemplars, 1)
+	require.Len(t, exemplars[0].GetLabel(), 1)
+	assert.Equal(t, "trace_id", exemplars[0].GetLabel()[0].GetName())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", exemplars[0].GetLabel()[0].GetValue())
+}
+
+func TestPrometheusServerMetrics(t *testing.T) {
+	t.Cleanup(promState.reset)
+
+	prometheusRegistry := RegisterPrometheus(t.Context(), &otypes.Prometheus{AddServicesLabels: true, AddServersLabels: true})
+	defer promRegistry.Unregister(promState)
+
+	require.True(t, prometheusRegistry.IsServerEnabled())
+
+	conf := dynamic.Configuration{
+		HTTP: th.BuildConfiguration(
+			th.WithServices(
+				th.WithService("service", th.WithServiceServersLoadBalancer(th.WithServers(th.WithServer("http://localhost:9000")))),
+			),
+		),
+	}
+	OnConfigurationUpdate(conf, nil)
+
+	serverLabels := []string{"service", "service", "url", "http://localhost:9000"}
+
+	prometheusRegistry.
+		ServiceServerReqDurationHistogram().
+		With(append([]string{"code", strconv.Itoa(http.StatusOK), "method", http.MethodGet}, serverLabels...)...).
+		ObserveFromStart(time.Now())
+	prometheusRegistry.ServiceServerConnectDurationHistogram().With(serverLabels...).Observe(0.01)
+	prometheusRegistry.ServiceServerTLSHandshakeDurationHistogram().With(serverLabels...).Observe(0.02)
+	prometheusRegistry.ServiceServerTimeToFirstByteHistogram().With(serverLabels...).Observe(0.03)
+	prometheusRegistry.ServiceServerConnsCounter().With(append([]string{"reused", "false"}, serverLabels...)...).Add(1)
+
+	delayForTrackingCompletion()
+
+	metricsFamilies := mustScrape()
+
+	testCases := []struct {
+		name   string
+		labels map[string]string
+		assert func(*dto.MetricFamily)
+	}{
+		{
+			name: serviceServerReqDurationName,
+			labels: map[string]string{
+				"code":    "200",
+				"method":  http.MethodGet,
+				"service": "service",
+				"url":     "http://localhost:9000",
+			},
+			assert: buildHistogramAssert(t, serviceServerReqDurationName, 1),
+		},
+		{
+			name:   serviceServerConnectDurationName,
+			labels: map[string]string{"service": "service", "url": "http://localhost:9000"},
+			assert: buildHistogramAssert(t, serviceServerConnectDurationName, 1),
+		},
+		{
+			name:   serviceServerTLSHandshakeDurationName,
+			labels: map[string]string{"service": "service", "url": "http://localhost:9000"},
+			assert: buildHistogramAssert(t, serviceServerTLSHandshakeDurationName, 1),
+		},
+		{
+			name:   serviceServerTimeToFirstByteName,
+			labels: map[string]string{"service": "service", "url": "http://localhost:9000"},
+			assert: buildHistogramAssert(t, serviceServerTimeToFirstByteName, 1),
+		},
+		{
+			name:   serviceServerConnsTotalName,
+			labels: map[string]string{"reused": "false", "service": "service", "url": "http://localhost:9000"},
+			assert: buildCounterAssert(t, serviceServerConnsTotalName, 1),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			family := findMetricFamily(test.name, metricsFamilies)
+			require.NotNil(t, family, "gathered metrics do not contain %q", test.name)
+
+			for _, label := range family.GetMetric()[0].GetLabel() {
+				val, ok := test.labels[label.GetName()]
+				assert.True(t, ok, "%q metric contains unexpected label %q", test.name, label.GetName())
+				assert.Equal(t, val, label.GetValue(), "label %q in metric %q has wrong value", label.GetName(), test.name)
+			}
+
+			test.assert(family)
+		})
+	}
+}
@@ -19,10 +19,13 @@ var (
 const (
 	statsdConfigReloadsName           = "config.reload.total"
 	statsdLastConfigReloadSuccessName = "config.reload.lastSuccessTimestamp"
+	statsdConfigSnapshotTimestampName = "config.snapshot.timestamp"
 	statsdOpenConnectionsName         = "open.connections"
 
 	statsdTLSCertsNotAfterTimestampName = "tls.certs.notAfterTimestamp"
 
+	statsdCSPViolationsName = "csp.violations.total"
+
 	statsdEntryPointReqsName        = "entrypoint.request.total"
 	statsdEntryPointReqsTLSName     = "entrypoint.request.tls.total"
 	statsdEntryPointReqDurationName = "entrypoint.request.duration"
@@ -60,7 +63,9 @@ func RegisterStatsd(ctx context.Context, config *otypes.Statsd) Registry {
 	registry := &standardRegistry{
 		configReloadsCounter:           statsdClient.NewCounter(statsdConfigReloadsName, 1.0),
 		lastConfigReloadSuccessGauge:   statsdClient.NewGauge(statsdLastConfigReloadSuccessName),
+		configSnapshotTimestampGauge:   statsdClient.NewGauge(statsdConfigSnapshotTimestampName),
 		tlsCertsNotAfterTimestampGauge: statsdClient.NewGauge(statsdTLSCertsNotAfterTimestampName),
+		cspViolationsCounter:           statsdClient.NewCounter(statsdCSPViolationsName, 1.0),
 		openConnectionsGauge:           statsdClient.NewGauge(statsdOpenConnectionsName),
 	}
 
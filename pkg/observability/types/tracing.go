@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/rs/zerolog/log"
+	ptypes "github.com/traefik/paerser/types"
 	ttypes "github.com/traefik/traefik/v3/pkg/types"
 	"github.com/traefik/traefik/v3/pkg/version"
 	"go.opentelemetry.io/otel"
@@ -52,8 +53,28 @@ func (c *OTelTracing) SetDefaults() {
 	c.HTTP.SetDefaults()
 }
 
+// TracingSampling holds the rule-based tail sampling configuration.
+type TracingSampling struct {
+	Rules      []TracingSamplingRule `description:"Rules forcing the sampling of the matching traces." json:"rules,omitempty" toml:"rules,omitempty" yaml:"rules,omitempty" export:"true"`
+	BufferSize int                   `description:"Maximum number of traces kept in memory while waiting for a sampling decision." json:"bufferSize,omitempty" toml:"bufferSize,omitempty" yaml:"bufferSize,omitempty" export:"true"`
+}
+
+// SetDefaults sets the default values.
+func (s *TracingSampling) SetDefaults() {
+	s.BufferSize = 10000
+}
+
+// TracingSamplingRule defines the conditions under which a trace is always sampled.
+// All the defined conditions must match for the rule to apply.
+type TracingSamplingRule struct {
+	Router      string          `description:"Keep traces going through the given router." json:"router,omitempty" toml:"router,omitempty" yaml:"router,omitempty" export:"true"`
+	PathPrefix  string          `description:"Keep traces of requests whose path starts with the given prefix." json:"pathPrefix,omitempty" toml:"pathPrefix,omitempty" yaml:"pathPrefix,omitempty" export:"true"`
+	StatusCodes []string        `description:"Keep traces of requests answered with a status code in the specified range." json:"statusCodes,omitempty" toml:"statusCodes,omitempty" yaml:"statusCodes,omitempty" export:"true"`
+	MinDuration ptypes.Duration `description:"Keep traces of requests that took longer than the specified duration." json:"minDuration,omitempty" toml:"minDuration,omitempty" yaml:"minDuration,omitempty" export:"true"`
+}
+
 // Setup sets up the tracer.
-func (c *OTelTracing) Setup(ctx context.Context, serviceName string, sampleRate float64, resourceAttributes map[string]string) (trace.Tracer, io.Closer, error) {
+func (c *OTelTracing) Setup(ctx context.Context, serviceName string, sampleRate float64, sampling *TracingSampling, resourceAttributes map[string]string) (trace.Tracer, io.Closer, error) {
 	var (
 		err      error
 		exporter *otlptrace.Exporter
@@ -95,11 +116,24 @@ func (c *OTelTracing) Setup(ctx context.Context, serviceName string, sampleRate
 
 	// Register the trace exporter with a TracerProvider, using a batch
 	// span processor to aggregate spans before export.
-	bsp := sdktrace.NewBatchSpanProcessor(exporter)
+	var spanProcessor sdktrace.SpanProcessor = sdktrace.NewBatchSpanProcessor(exporter)
+	sampler := sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRate))
+
+	// With sampling rules, the traces which are not sampled are still recorded,
+	// and the decision to keep them is deferred until the local root span ends.
+	if sampling != nil && len(sampling.Rules) > 0 {
+		spanProcessor, err = newTailSamplingProcessor(spanProcessor, sampling)
+		if err != nil {
+			return nil, nil, fmt.Errorf("setting up tail sampling: %w", err)
+		}
+
+		sampler = recordingSampler{Sampler: sampler}
+	}
+
 	tracerProvider := sdktrace.NewTracerProvider(
-		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRate))),
+		sdktrace.WithSampler(sampler),
 		sdktrace.WithResource(res),
-		sdktrace.WithSpanProcessor(bsp),
+		sdktrace.WithSpanProcessor(spanProcessor),
 	)
 
 	otel.SetTracerProvider(tracerProvider)
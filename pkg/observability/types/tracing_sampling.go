@@ -0,0 +1,248 @@
+package types
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	ttypes "github.com/traefik/traefik/v3/pkg/types"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const routerNameAttribute = "traefik.router.name"
+
+// recordingSampler records the spans dropped by the wrapped sampler without sampling them,
+// so that the tail sampling processor can still keep their trace when it matches a rule.
+// The sampled flag, which is propagated downstream, stays the decision of the wrapped sampler.
+type recordingSampler struct {
+	sdktrace.Sampler
+}
+
+// ShouldSample implements sdktrace.Sampler.
+func (s recordingSampler) ShouldSample(parameters sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	result := s.Sampler.ShouldSample(parameters)
+	if result.Decision == sdktrace.Drop {
+		result.Decision = sdktrace.RecordOnly
+	}
+
+	return result
+}
+
+// Description implements sdktrace.Sampler.
+func (s recordingSampler) Description() string {
+	return fmt.Sprintf("Recording{%s}", s.Sampler.Description())
+}
+
+// sampledSpan marks as sampled a span recorded but not sampled, whose trace has been kept by a sampling rule,
+// as the span processors only export the sampled spans.
+type sampledSpan struct {
+	sdktrace.ReadOnlySpan
+}
+
+// SpanContext implements sdktrace.ReadOnlySpan.
+func (s sampledSpan) SpanContext() trace.SpanContext {
+	spanContext := s.ReadOnlySpan.SpanContext()
+
+	return spanContext.WithTraceFlags(spanContext.TraceFlags().WithSampled(true))
+}
+
+// tailSamplingProcessor is a span processor buffering the spans of a trace
+// until its local root span ends, and forwarding them to the next processor
+// only if the trace matches one of the sampling rules or has been sampled.
+type tailSamplingProcessor struct {
+	next       sdktrace.SpanProcessor
+	rules      []samplingRule
+	bufferSize int
+
+	mu     sync.Mutex
+	traces map[trace.TraceID]*list.Element
+	// order holds the pending traces from the oldest to the newest, to evict the oldest ones when the buffer is full.
+	order *list.List
+}
+
+type pendingTrace struct {
+	traceID trace.TraceID
+	// sampled is the sampling decision of the sampler, which is shared by the spans of the trace.
+	sampled bool
+	spans   []sdktrace.ReadOnlySpan
+	routers []string
+}
+
+type samplingRule struct {
+	router      string
+	pathPrefix  string
+	statusCodes ttypes.HTTPCodeRanges
+	minDuration time.Duration
+}
+
+func newTailSamplingProcessor(next sdktrace.SpanProcessor, sampling *TracingSampling) (*tailSamplingProcessor, error) {
+	if sampling.BufferSize <= 0 {
+		return nil, errors.New("buffer size must be greater than zero")
+	}
+
+	var rules []samplingRule
+	for i, rule := range sampling.Rules {
+		statusCodes, err := ttypes.NewHTTPCodeRanges(rule.StatusCodes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing status codes of rule %d: %w", i, err)
+		}
+
+		rules = append(rules, samplingRule{
+			router:      rule.Router,
+			pathPrefix:  rule.PathPrefix,
+			statusCodes: statusCodes,
+			minDuration: time.Duration(rule.MinDuration),
+		})
+	}
+
+	return &tailSamplingProcessor{
+		next:       next,
+		rules:      rules,
+		bufferSize: sampling.BufferSize,
+		traces:     make(map[trace.TraceID]*list.Element),
+		order:      list.New(),
+	}, nil
+}
+
+// OnStart implements sdktrace.SpanProcessor.
+func (p *tailSamplingProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	p.next.OnStart(parent, s)
+}
+
+// OnEnd implements sdktrace.SpanProcessor.
+func (p *tailSamplingProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	traceID := s.SpanContext().TraceID()
+
+	p.mu.Lock()
+
+	elt, ok := p.traces[traceID]
+	if !ok {
+		if p.order.Len() >= p.bufferSize {
+			// The oldest trace is evicted without having seen its root span,
+			// so the decision can only rely on the sampler one.
+			oldest := p.remove(p.order.Front())
+			if oldest.sampled {
+				defer p.forward(oldest.spans, false)
+			}
+		}
+
+		elt = p.order.PushBack(&pendingTrace{traceID: traceID, sampled: s.SpanContext().IsSampled()})
+		p.traces[traceID] = elt
+	}
+
+	pending := elt.Value.(*pendingTrace)
+	pending.spans = append(pending.spans, s)
+	for _, attr := range s.Attributes() {
+		if attr.Key == routerNameAttribute {
+			pending.routers = append(pending.routers, attr.Value.AsString())
+		}
+	}
+
+	// The sampling decision is made when the local root span ends,
+	// as it holds the request path, the response status code and the request duration.
+	if s.Parent().IsValid() && !s.Parent().IsRemote() {
+		p.mu.Unlock()
+		return
+	}
+
+	p.remove(elt)
+	p.mu.Unlock()
+
+	switch {
+	case pending.sampled:
+		p.forward(pending.spans, false)
+	case p.matchRules(pending, s):
+		p.forward(pending.spans, true)
+	}
+}
+
+// Shutdown implements sdktrace.SpanProcessor.
+// The traces still waiting for their root span are kept only if they have been sampled.
+func (p *tailSamplingProcessor) Shutdown(ctx context.Context) error {
+	p.flushPending()
+
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush implements sdktrace.SpanProcessor.
+func (p *tailSamplingProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+func (p *tailSamplingProcessor) flushPending() {
+	p.mu.Lock()
+	var spans []sdktrace.ReadOnlySpan
+	for p.order.Len() > 0 {
+		pending := p.remove(p.order.Front())
+		if pending.sampled {
+			spans = append(spans, pending.spans...)
+		}
+	}
+	p.mu.Unlock()
+
+	p.forward(spans, false)
+}
+
+// remove removes the given pending trace from the buffer. The lock must be held by the caller.
+func (p *tailSamplingProcessor) remove(elt *list.Element) *pendingTrace {
+	pending := p.order.Remove(elt).(*pendingTrace)
+	delete(p.traces, pending.traceID)
+
+	return pending
+}
+
+// forward forwards the given spans to the next processor,
+// marking them as sampled when their trace has only been kept by a sampling rule.
+func (p *tailSamplingProcessor) forward(spans []sdktrace.ReadOnlySpan, markSampled bool) {
+	for _, span := range spans {
+		if markSampled {
+			span = sampledSpan{ReadOnlySpan: span}
+		}
+
+		p.next.OnEnd(span)
+	}
+}
+
+func (p *tailSamplingProcessor) matchRules(pending *pendingTrace, root sdktrace.ReadOnlySpan) bool {
+	var path string
+	var statusCode int
+	for _, attr := range root.Attributes() {
+		switch attr.Key {
+		case semconv.URLPathKey:
+			path = attr.Value.AsString()
+		case semconv.HTTPResponseStatusCodeKey:
+			statusCode = int(attr.Value.AsInt64())
+		}
+	}
+
+	duration := root.EndTime().Sub(root.StartTime())
+
+	for _, rule := range p.rules {
+		if rule.router != "" && !slices.Contains(pending.routers, rule.router) {
+			continue
+		}
+
+		if rule.pathPrefix != "" && !strings.HasPrefix(path, rule.pathPrefix) {
+			continue
+		}
+
+		if len(rule.statusCodes) > 0 && !rule.statusCodes.Contains(statusCode) {
+			continue
+		}
+
+		if rule.minDuration > 0 && duration < rule.minDuration {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ptypes "github.com/traefik/paerser/types"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTailSamplingProcessor(t *testing.T) {
+	rules := []TracingSamplingRule{
+		{StatusCodes: []string{"500-599"}},
+		{MinDuration: ptypes.Duration(time.Second)},
+		{Router: "api@file", PathPrefix: "/admin"},
+	}
+
+	testCases := []struct {
+		desc       string
+		router     string
+		path       string
+		statusCode int
+		duration   time.Duration
+		expected   int
+	}{
+		{
+			desc:       "healthy request is dropped",
+			router:     "web@file",
+			path:       "/",
+			statusCode: http.StatusOK,
+			duration:   time.Millisecond,
+		},
+		{
+			desc:       "error is kept",
+			router:     "web@file",
+			path:       "/",
+			statusCode: http.StatusBadGateway,
+			duration:   time.Millisecond,
+			expected:   2,
+		},
+		{
+			desc:       "slow request is kept",
+			router:     "web@file",
+			path:       "/",
+			statusCode: http.StatusOK,
+			duration:   2 * time.Second,
+			expected:   2,
+		},
+		{
+			desc:       "router and path prefix are kept",
+			router:     "api@file",
+			path:       "/admin/users",
+			statusCode: http.StatusOK,
+			duration:   time.Millisecond,
+			expected:   2,
+		},
+		{
+			desc:       "router without path prefix is dropped",
+			router:     "api@file",
+			path:       "/users",
+			statusCode: http.StatusOK,
+			duration:   time.Millisecond,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := tracetest.NewSpanRecorder()
+			processor, err := newTailSamplingProcessor(recorder, &TracingSampling{Rules: rules, BufferSize: 10})
+			require.NoError(t, err)
+
+			// No trace is sampled by the sample rate, so the kept traces are only the ones matching a rule.
+			tracerProvider := sdktrace.NewTracerProvider(
+				sdktrace.WithSampler(recordingSampler{Sampler: sdktrace.ParentBased(sdktrace.TraceIDRatioBased(0))}),
+				sdktrace.WithSpanProcessor(processor),
+			)
+			tracer := tracerProvider.Tracer("test")
+
+			start := time.Now()
+			ctx, root := tracer.Start(t.Context(), "GET", trace.WithTimestamp(start))
+			root.SetAttributes(semconv.URLPath(test.path))
+
+			_, router := tracer.Start(ctx, "Router")
+			router.SetAttributes(attribute.String("traefik.router.name", test.router))
+			router.End()
+
+			assert.Empty(t, recorder.Ended())
+			assert.False(t, root.SpanContext().IsSampled())
+
+			root.SetAttributes(semconv.HTTPResponseStatusCode(test.statusCode))
+			root.End(trace.WithTimestamp(start.Add(test.duration)))
+
+			require.Len(t, recorder.Ended(), test.expected)
+			for _, span := range recorder.Ended() {
+				assert.True(t, span.SpanContext().IsSampled())
+			}
+		})
+	}
+}
+
+func TestTailSamplingProcessor_bufferEviction(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	processor, err := newTailSamplingProcessor(recorder, &TracingSampling{
+		Rules:      []TracingSamplingRule{{StatusCodes: []string{"500"}}},
+		BufferSize: 1,
+	})
+	require.NoError(t, err)
+
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(processor)).Tracer("test")
+
+	ctx1, root1 := tracer.Start(t.Context(), "first")
+	_, child1 := tracer.Start(ctx1, "child")
+	child1.End()
+
+	ctx2, root2 := tracer.Start(t.Context(), "second")
+	_, child2 := tracer.Start(ctx2, "child")
+	child2.End()
+
+	// The first trace has been evicted and kept, as it has been sampled.
+	require.Len(t, recorder.Ended(), 1)
+	assert.Equal(t, root1.SpanContext().TraceID(), recorder.Ended()[0].SpanContext().TraceID())
+
+	root2.End()
+	assert.Len(t, recorder.Ended(), 3)
+
+	root1.End()
+	require.NoError(t, processor.Shutdown(t.Context()))
+	assert.Len(t, recorder.Ended(), 4)
+}
+
+func TestNewTailSamplingProcessor_invalidStatusCodes(t *testing.T) {
+	_, err := newTailSamplingProcessor(tracetest.NewSpanRecorder(), &TracingSampling{
+		Rules:      []TracingSamplingRule{{StatusCodes: []string{"foo"}}},
+		BufferSize: 1,
+	})
+	require.Error(t, err)
+}
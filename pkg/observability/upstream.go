@@ -0,0 +1,123 @@
+package observability
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// UpstreamTimings holds the timings of a request forwarded to an upstream server.
+// It is filled through the httptrace.ClientTrace hooks called by the proxies.
+type UpstreamTimings struct {
+	mu sync.Mutex
+
+	start        time.Time
+	getConn      time.Time
+	connectStart time.Time
+	tlsStart     time.Time
+
+	connect         time.Duration
+	tlsHandshake    time.Duration
+	timeToFirstByte time.Duration
+	connReused      bool
+	gotConn         bool
+}
+
+// WithUpstreamTimings returns a copy of the given context recording the upstream timings of a request into the returned UpstreamTimings.
+// The returned context must be used for the request sent to the upstream server.
+func WithUpstreamTimings(ctx context.Context) (context.Context, *UpstreamTimings) {
+	t := &UpstreamTimings{start: time.Now()}
+
+	trace := &httptrace.ClientTrace{
+		GetConn: func(string) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			t.getConn = time.Now()
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			t.gotConn = true
+			t.connReused = info.Reused
+
+			// When the proxy does not report the connection establishment steps,
+			// the time spent getting a new connection is considered as the connect time.
+			if !info.Reused && t.connect == 0 && !t.getConn.IsZero() {
+				t.connect = time.Since(t.getConn)
+			}
+		},
+		ConnectStart: func(string, string) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			t.connectStart = time.Now()
+		},
+		ConnectDone: func(string, string, error) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			if !t.connectStart.IsZero() {
+				t.connect = time.Since(t.connectStart)
+			}
+		},
+		TLSHandshakeStart: func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			t.tlsStart = time.Now()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			if !t.tlsStart.IsZero() {
+				t.tlsHandshake = time.Since(t.tlsStart)
+			}
+		},
+		GotFirstResponseByte: func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			t.timeToFirstByte = time.Since(t.start)
+		},
+	}
+
+	return httptrace.WithClientTrace(ctx, trace), t
+}
+
+// Connect returns the time spent establishing a new connection to the upstream server.
+// It is zero when an existing connection has been reused.
+func (t *UpstreamTimings) Connect() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.connect
+}
+
+// TLSHandshake returns the time spent on the TLS handshake with the upstream server.
+func (t *UpstreamTimings) TLSHandshake() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.tlsHandshake
+}
+
+// TimeToFirstByte returns the time elapsed between the start of the request and the first byte of the response.
+func (t *UpstreamTimings) TimeToFirstByte() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.timeToFirstByte
+}
+
+// ConnReused returns whether a connection has been obtained, and whether it has been reused.
+func (t *UpstreamTimings) ConnReused() (reused, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.connReused, t.gotConn
+}
@@ -0,0 +1,62 @@
+package observability
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithUpstreamTimings(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	client := server.Client()
+
+	doRequest := func() *UpstreamTimings {
+		t.Helper()
+
+		ctx, timings := WithUpstreamTimings(t.Context())
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+		require.NoError(t, err)
+
+		res, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+
+		return timings
+	}
+
+	timings := doRequest()
+
+	reused, ok := timings.ConnReused()
+	require.True(t, ok)
+	assert.False(t, reused)
+	assert.Positive(t, timings.Connect())
+	assert.Positive(t, timings.TLSHandshake())
+	assert.Positive(t, timings.TimeToFirstByte())
+
+	timings = doRequest()
+
+	reused, ok = timings.ConnReused()
+	require.True(t, ok)
+	assert.True(t, reused)
+	assert.Zero(t, timings.Connect())
+	assert.Zero(t, timings.TLSHandshake())
+	assert.Positive(t, timings.TimeToFirstByte())
+}
+
+func TestUpstreamTimings_noConnection(t *testing.T) {
+	_, timings := WithUpstreamTimings(t.Context())
+
+	_, ok := timings.ConnReused()
+	assert.False(t, ok)
+	assert.Zero(t, timings.Connect())
+	assert.Zero(t, timings.TLSHandshake())
+	assert.Zero(t, timings.TimeToFirstByte())
+}
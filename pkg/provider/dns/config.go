@@ -0,0 +1,147 @@
+package dns
+
+import (
+	"fmt"
+
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+// failoverErrorStatus is the status of the responses triggering the failover to the next priority,
+// when no health check is defined to detect that the servers are down.
+var failoverErrorStatus = []string{"502-504"}
+
+// buildConfiguration builds the dynamic configuration from the records resolved for the given services.
+func buildConfiguration(services map[string]Service, records map[string]resolvedRecords) *dynamic.Configuration {
+	configuration := &dynamic.Configuration{
+		HTTP: &dynamic.HTTPConfiguration{
+			Routers:           make(map[string]*dynamic.Router),
+			Middlewares:       make(map[string]*dynamic.Middleware),
+			Services:          make(map[string]*dynamic.Service),
+			ServersTransports: make(map[string]*dynamic.ServersTransport),
+		},
+		TCP: &dynamic.TCPConfiguration{
+			Routers:           make(map[string]*dynamic.TCPRouter),
+			Middlewares:       make(map[string]*dynamic.TCPMiddleware),
+			Services:          make(map[string]*dynamic.TCPService),
+			ServersTransports: make(map[string]*dynamic.TCPServersTransport),
+		},
+		UDP: &dynamic.UDPConfiguration{
+			Routers:  make(map[string]*dynamic.UDPRouter),
+			Services: make(map[string]*dynamic.UDPService),
+		},
+	}
+
+	for name, service := range services {
+		resolved, ok := records[name]
+		if !ok {
+			// Not resolved yet.
+			continue
+		}
+
+		groups := groupByPriority(resolved.records)
+
+		switch service.Protocol {
+		case ProtocolTCP:
+			lb := &dynamic.TCPServersLoadBalancer{Servers: []dynamic.TCPServer{}}
+			// TCP load balancers do not support failover, only the records with the highest priority are used.
+			if len(groups) > 0 {
+				for _, r := range groups[0] {
+					lb.Servers = append(lb.Servers, dynamic.TCPServer{Address: r.address()})
+				}
+			}
+
+			configuration.TCP.Services[name] = &dynamic.TCPService{LoadBalancer: lb}
+
+		case ProtocolUDP:
+			lb := &dynamic.UDPServersLoadBalancer{Servers: []dynamic.UDPServer{}}
+			// UDP load balancers do not support failover, only the records with the highest priority are used.
+			if len(groups) > 0 {
+				for _, r := range groups[0] {
+					lb.Servers = append(lb.Servers, dynamic.UDPServer{Address: r.address()})
+				}
+			}
+
+			configuration.UDP.Services[name] = &dynamic.UDPService{LoadBalancer: lb}
+
+		default:
+			buildHTTPServices(configuration.HTTP.Services, name, service, groups)
+		}
+	}
+
+	return configuration
+}
+
+// buildHTTPServices builds the HTTP services of the given service.
+// When the records have several priorities, a load balancer is built for each of them,
+// and they are chained with failover services, from the highest priority (lowest value) to the lowest one.
+func buildHTTPServices(httpServices map[string]*dynamic.Service, name string, service Service, groups [][]record) {
+	if len(groups) <= 1 {
+		var group []record
+		if len(groups) == 1 {
+			group = groups[0]
+		}
+
+		httpServices[name] = &dynamic.Service{LoadBalancer: buildHTTPLoadBalancer(service, group)}
+		return
+	}
+
+	serviceName := name
+	for i, group := range groups {
+		priorityName := fmt.Sprintf("%s-priority-%d", name, group[0].Priority)
+		httpServices[priorityName] = &dynamic.Service{LoadBalancer: buildHTTPLoadBalancer(service, group)}
+
+		if i == len(groups)-1 {
+			break
+		}
+
+		fallbackName := fmt.Sprintf("%s-priority-%d", name, groups[i+1][0].Priority)
+		if i < len(groups)-2 {
+			fallbackName = fmt.Sprintf("%s-failover-%d", name, groups[i+1][0].Priority)
+		}
+
+		failover := &dynamic.Failover{
+			Service:  priorityName,
+			Fallback: fallbackName,
+		}
+		if service.HealthCheck == nil {
+			failover.Errors = &dynamic.FailoverError{Status: failoverErrorStatus}
+		}
+
+		httpServices[serviceName] = &dynamic.Service{Failover: failover}
+		serviceName = fallbackName
+	}
+}
+
+func buildHTTPLoadBalancer(service Service, records []record) *dynamic.ServersLoadBalancer {
+	lb := &dynamic.ServersLoadBalancer{}
+	lb.SetDefaults()
+
+	lb.HealthCheck = service.HealthCheck.DeepCopy()
+	lb.Servers = []dynamic.Server{}
+
+	for _, r := range records {
+		server := dynamic.Server{URL: service.Scheme + "://" + r.address()}
+		if service.RecordType == RecordTypeSRV {
+			// A zero weight is given the lowest weight, as the load balancer ignores the servers with a zero weight.
+			server.Weight = new(max(int(r.Weight), 1))
+		}
+
+		lb.Servers = append(lb.Servers, server)
+	}
+
+	return lb
+}
+
+// groupByPriority groups the given records, sorted by priority, from the highest priority (lowest value) to the lowest one.
+func groupByPriority(records []record) [][]record {
+	var groups [][]record
+	for _, r := range records {
+		if len(groups) == 0 || groups[len(groups)-1][0].Priority != r.Priority {
+			groups = append(groups, nil)
+		}
+
+		groups[len(groups)-1] = append(groups[len(groups)-1], r)
+	}
+
+	return groups
+}
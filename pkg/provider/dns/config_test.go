@@ -0,0 +1,179 @@
+package dns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	ptypes "github.com/traefik/paerser/types"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func Test_buildConfiguration(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		services     map[string]Service
+		records      map[string]resolvedRecords
+		expectedHTTP map[string]*dynamic.Service
+		expectedTCP  map[string]*dynamic.TCPService
+		expectedUDP  map[string]*dynamic.UDPService
+	}{
+		{
+			desc: "not resolved yet",
+			services: map[string]Service{
+				"api": {Name: "_api._tcp.example.com", RecordType: RecordTypeSRV, Protocol: ProtocolHTTP, Scheme: "http"},
+			},
+			expectedHTTP: map[string]*dynamic.Service{},
+			expectedTCP:  map[string]*dynamic.TCPService{},
+			expectedUDP:  map[string]*dynamic.UDPService{},
+		},
+		{
+			desc: "SRV records with a single priority",
+			services: map[string]Service{
+				"api": {Name: "_api._tcp.example.com", RecordType: RecordTypeSRV, Protocol: ProtocolHTTP, Scheme: "https"},
+			},
+			records: map[string]resolvedRecords{
+				"api": {records: []record{
+					{Host: "api1.example.com", Port: 8443, Priority: 10, Weight: 60},
+					{Host: "api2.example.com", Port: 8443, Priority: 10, Weight: 40},
+				}},
+			},
+			expectedHTTP: map[string]*dynamic.Service{
+				"api": {LoadBalancer: expectedLoadBalancer(nil,
+					dynamic.Server{URL: "https://api1.example.com:8443", Weight: new(60)},
+					dynamic.Server{URL: "https://api2.example.com:8443", Weight: new(40)},
+				)},
+			},
+			expectedTCP: map[string]*dynamic.TCPService{},
+			expectedUDP: map[string]*dynamic.UDPService{},
+		},
+		{
+			desc: "SRV records with several priorities",
+			services: map[string]Service{
+				"api": {Name: "_api._tcp.example.com", RecordType: RecordTypeSRV, Protocol: ProtocolHTTP, Scheme: "http"},
+			},
+			records: map[string]resolvedRecords{
+				"api": {records: []record{
+					{Host: "api1.example.com", Port: 80, Priority: 10, Weight: 1},
+					{Host: "api2.example.com", Port: 80, Priority: 20, Weight: 1},
+					{Host: "api3.example.com", Port: 80, Priority: 30, Weight: 1},
+				}},
+			},
+			expectedHTTP: map[string]*dynamic.Service{
+				"api": {Failover: &dynamic.Failover{
+					Service:  "api-priority-10",
+					Fallback: "api-failover-20",
+					Errors:   &dynamic.FailoverError{Status: []string{"502-504"}},
+				}},
+				"api-failover-20": {Failover: &dynamic.Failover{
+					Service:  "api-priority-20",
+					Fallback: "api-priority-30",
+					Errors:   &dynamic.FailoverError{Status: []string{"502-504"}},
+				}},
+				"api-priority-10": {LoadBalancer: expectedLoadBalancer(nil, dynamic.Server{URL: "http://api1.example.com:80", Weight: new(1)})},
+				"api-priority-20": {LoadBalancer: expectedLoadBalancer(nil, dynamic.Server{URL: "http://api2.example.com:80", Weight: new(1)})},
+				"api-priority-30": {LoadBalancer: expectedLoadBalancer(nil, dynamic.Server{URL: "http://api3.example.com:80", Weight: new(1)})},
+			},
+			expectedTCP: map[string]*dynamic.TCPService{},
+			expectedUDP: map[string]*dynamic.UDPService{},
+		},
+		{
+			desc: "SRV records with several priorities and a health check",
+			services: map[string]Service{
+				"api": {
+					Name:        "_api._tcp.example.com",
+					RecordType:  RecordTypeSRV,
+					Protocol:    ProtocolHTTP,
+					Scheme:      "http",
+					HealthCheck: &dynamic.ServerHealthCheck{Path: "/health", Interval: ptypes.Duration(time.Second)},
+				},
+			},
+			records: map[string]resolvedRecords{
+				"api": {records: []record{
+					{Host: "api1.example.com", Port: 80, Priority: 10, Weight: 1},
+					{Host: "api2.example.com", Port: 80, Priority: 20, Weight: 1},
+				}},
+			},
+			expectedHTTP: map[string]*dynamic.Service{
+				"api": {Failover: &dynamic.Failover{
+					Service:  "api-priority-10",
+					Fallback: "api-priority-20",
+				}},
+				"api-priority-10": {LoadBalancer: expectedLoadBalancer(
+					&dynamic.ServerHealthCheck{Path: "/health", Interval: ptypes.Duration(time.Second)},
+					dynamic.Server{URL: "http://api1.example.com:80", Weight: new(1)},
+				)},
+				"api-priority-20": {LoadBalancer: expectedLoadBalancer(
+					&dynamic.ServerHealthCheck{Path: "/health", Interval: ptypes.Duration(time.Second)},
+					dynamic.Server{URL: "http://api2.example.com:80", Weight: new(1)},
+				)},
+			},
+			expectedTCP: map[string]*dynamic.TCPService{},
+			expectedUDP: map[string]*dynamic.UDPService{},
+		},
+		{
+			desc: "A records without any answer",
+			services: map[string]Service{
+				"api": {Name: "api.example.com", RecordType: RecordTypeA, Port: 80, Protocol: ProtocolHTTP, Scheme: "http"},
+			},
+			records: map[string]resolvedRecords{
+				"api": {},
+			},
+			expectedHTTP: map[string]*dynamic.Service{
+				"api": {LoadBalancer: expectedLoadBalancer(nil)},
+			},
+			expectedTCP: map[string]*dynamic.TCPService{},
+			expectedUDP: map[string]*dynamic.UDPService{},
+		},
+		{
+			desc: "TCP and UDP services only use the highest priority",
+			services: map[string]Service{
+				"db":  {Name: "_db._tcp.example.com", RecordType: RecordTypeSRV, Protocol: ProtocolTCP},
+				"dns": {Name: "dns.example.com", RecordType: RecordTypeA, Port: 53, Protocol: ProtocolUDP},
+			},
+			records: map[string]resolvedRecords{
+				"db": {records: []record{
+					{Host: "db1.example.com", Port: 5432, Priority: 10},
+					{Host: "db2.example.com", Port: 5432, Priority: 20},
+				}},
+				"dns": {records: []record{
+					{Host: "10.0.0.1", Port: 53},
+					{Host: "2001:db8::1", Port: 53},
+				}},
+			},
+			expectedHTTP: map[string]*dynamic.Service{},
+			expectedTCP: map[string]*dynamic.TCPService{
+				"db": {LoadBalancer: &dynamic.TCPServersLoadBalancer{Servers: []dynamic.TCPServer{{Address: "db1.example.com:5432"}}}},
+			},
+			expectedUDP: map[string]*dynamic.UDPService{
+				"dns": {LoadBalancer: &dynamic.UDPServersLoadBalancer{Servers: []dynamic.UDPServer{
+					{Address: "10.0.0.1:53"},
+					{Address: "[2001:db8::1]:53"},
+				}}},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			configuration := buildConfiguration(test.services, test.records)
+
+			assert.Equal(t, test.expectedHTTP, configuration.HTTP.Services)
+			assert.Equal(t, test.expectedTCP, configuration.TCP.Services)
+			assert.Equal(t, test.expectedUDP, configuration.UDP.Services)
+			assert.Empty(t, configuration.HTTP.Routers)
+		})
+	}
+}
+
+func expectedLoadBalancer(healthCheck *dynamic.ServerHealthCheck, servers ...dynamic.Server) *dynamic.ServersLoadBalancer {
+	lb := &dynamic.ServersLoadBalancer{}
+	lb.SetDefaults()
+
+	lb.HealthCheck = healthCheck
+	lb.Servers = append([]dynamic.Server{}, servers...)
+
+	return lb
+}
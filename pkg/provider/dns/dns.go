@@ -0,0 +1,207 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	mdns "github.com/miekg/dns"
+	"github.com/rs/zerolog/log"
+	ptypes "github.com/traefik/paerser/types"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/observability/logs"
+	"github.com/traefik/traefik/v3/pkg/provider"
+	"github.com/traefik/traefik/v3/pkg/safe"
+	"github.com/traefik/traefik/v3/pkg/types"
+)
+
+var _ provider.Provider = (*Provider)(nil)
+
+// ProviderName is the DNS provider name.
+const ProviderName = "dns"
+
+// Record types.
+const (
+	RecordTypeSRV = "SRV"
+	RecordTypeA   = "A"
+)
+
+// Service protocols.
+const (
+	ProtocolHTTP = "http"
+	ProtocolTCP  = "tcp"
+	ProtocolUDP  = "udp"
+)
+
+// minRefreshInterval is the lower bound of the delay between two resolutions when honoring the records TTL.
+const minRefreshInterval = time.Second
+
+// Provider is a provider.Provider implementation that discovers services from DNS records.
+type Provider struct {
+	RefreshInterval ptypes.Duration    `description:"Interval between two resolutions of the DNS records." json:"refreshInterval,omitempty" toml:"refreshInterval,omitempty" yaml:"refreshInterval,omitempty" export:"true"`
+	HonorTTL        bool               `description:"Resolve the DNS records again when their TTL expires, instead of on the refresh interval." json:"honorTTL,omitempty" toml:"honorTTL,omitempty" yaml:"honorTTL,omitempty" export:"true"`
+	Services        map[string]Service `description:"Services to discover from DNS records." json:"services,omitempty" toml:"services,omitempty" yaml:"services,omitempty" export:"true"`
+
+	// HostResolver is the global host resolver configuration, set from the static configuration.
+	HostResolver *types.HostResolverConfig `json:"-" toml:"-" yaml:"-" label:"-" file:"-"`
+
+	resolver          *resolver
+	records           map[string]resolvedRecords
+	lastConfiguration *dynamic.Configuration
+}
+
+// Service defines a service discovered from DNS records.
+type Service struct {
+	Name        string                     `description:"DNS name to resolve." json:"name,omitempty" toml:"name,omitempty" yaml:"name,omitempty" export:"true"`
+	RecordType  string                     `description:"Type of the DNS records to resolve: SRV, or A for the A and AAAA records." json:"recordType,omitempty" toml:"recordType,omitempty" yaml:"recordType,omitempty" export:"true"`
+	Port        int                        `description:"Port of the servers, required with A records." json:"port,omitempty" toml:"port,omitempty" yaml:"port,omitempty" export:"true"`
+	Protocol    string                     `description:"Protocol of the generated service: http, tcp or udp." json:"protocol,omitempty" toml:"protocol,omitempty" yaml:"protocol,omitempty" export:"true"`
+	Scheme      string                     `description:"Scheme of the HTTP servers." json:"scheme,omitempty" toml:"scheme,omitempty" yaml:"scheme,omitempty" export:"true"`
+	HealthCheck *dynamic.ServerHealthCheck `description:"Health check of the HTTP servers." json:"healthCheck,omitempty" toml:"healthCheck,omitempty" yaml:"healthCheck,omitempty" label:"allowEmpty" file:"allowEmpty" export:"true"`
+}
+
+// SetDefaults sets the default values.
+func (p *Provider) SetDefaults() {
+	p.RefreshInterval = ptypes.Duration(30 * time.Second)
+}
+
+// Init the provider.
+func (p *Provider) Init() error {
+	if p.RefreshInterval <= 0 {
+		return errors.New("refresh interval must be greater than 0")
+	}
+
+	// Without services, the provider is enabled but does not provide any configuration.
+	if len(p.Services) == 0 {
+		return nil
+	}
+
+	for name, service := range p.Services {
+		if err := service.normalize(); err != nil {
+			return fmt.Errorf("invalid service %q: %w", name, err)
+		}
+		p.Services[name] = service
+	}
+
+	hostResolver := p.HostResolver
+	if hostResolver == nil {
+		hostResolver = &types.HostResolverConfig{}
+		hostResolver.SetDefaults()
+	}
+
+	clientConfig, err := mdns.ClientConfigFromFile(hostResolver.ResolvConfig)
+	if err != nil {
+		return fmt.Errorf("reading resolver configuration file %s: %w", hostResolver.ResolvConfig, err)
+	}
+
+	p.resolver = newResolver(clientConfig, hostResolver.ResolvDepth)
+	p.records = make(map[string]resolvedRecords)
+
+	return nil
+}
+
+// Provide allows the provider to provide configurations to traefik using the given configuration channel.
+func (p *Provider) Provide(configurationChan chan<- dynamic.Message, pool *safe.Pool) error {
+	if len(p.Services) == 0 {
+		log.Warn().Str(logs.ProviderName, ProviderName).Msg("No services to discover from DNS records")
+		return nil
+	}
+
+	pool.GoCtx(func(routineCtx context.Context) {
+		logger := log.Ctx(routineCtx).With().Str(logs.ProviderName, ProviderName).Logger()
+		ctxLog := logger.WithContext(routineCtx)
+
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-routineCtx.Done():
+				return
+
+			case <-timer.C:
+				timer.Reset(p.refresh(ctxLog, configurationChan))
+			}
+		}
+	})
+
+	return nil
+}
+
+// refresh resolves the DNS records of the services, sends the configuration if it changed,
+// and returns the delay before the next resolution.
+func (p *Provider) refresh(ctx context.Context, configurationChan chan<- dynamic.Message) time.Duration {
+	logger := log.Ctx(ctx)
+
+	var minTTL time.Duration
+	var failed bool
+	for name, service := range p.Services {
+		records, err := p.resolver.resolve(ctx, service)
+		if err != nil {
+			// The records previously resolved are kept, to ride out transient resolution failures.
+			logger.Error().Err(err).Str(logs.ServiceName, name).Msgf("Unable to resolve %s records of %s", service.RecordType, service.Name)
+			failed = true
+			continue
+		}
+
+		p.records[name] = records
+
+		if records.ttl > 0 && (minTTL == 0 || records.ttl < minTTL) {
+			minTTL = records.ttl
+		}
+	}
+
+	configuration := buildConfiguration(p.Services, p.records)
+	if !reflect.DeepEqual(configuration, p.lastConfiguration) {
+		p.lastConfiguration = configuration
+
+		configurationChan <- dynamic.Message{
+			ProviderName:  ProviderName,
+			Configuration: configuration.DeepCopy(),
+		}
+	}
+
+	if !p.HonorTTL || failed || minTTL == 0 {
+		return time.Duration(p.RefreshInterval)
+	}
+
+	return max(minTTL, minRefreshInterval)
+}
+
+// normalize validates the service definition and sets its default values.
+func (s *Service) normalize() error {
+	if s.Name == "" {
+		return errors.New("name is required")
+	}
+
+	s.RecordType = strings.ToUpper(s.RecordType)
+	switch s.RecordType {
+	case "":
+		s.RecordType = RecordTypeSRV
+	case RecordTypeSRV:
+	case RecordTypeA:
+		if s.Port <= 0 || s.Port > 65535 {
+			return errors.New("a valid port is required with A records")
+		}
+	default:
+		return fmt.Errorf("unsupported record type %q", s.RecordType)
+	}
+
+	s.Protocol = strings.ToLower(s.Protocol)
+	switch s.Protocol {
+	case "":
+		s.Protocol = ProtocolHTTP
+	case ProtocolHTTP, ProtocolTCP, ProtocolUDP:
+	default:
+		return fmt.Errorf("unsupported protocol %q", s.Protocol)
+	}
+
+	if s.Protocol == ProtocolHTTP && s.Scheme == "" {
+		s.Scheme = "http"
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package dns
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mdns "github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ptypes "github.com/traefik/paerser/types"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/safe"
+	"github.com/traefik/traefik/v3/pkg/types"
+)
+
+func TestProvider_refresh(t *testing.T) {
+	zone := map[string][]string{
+		"_api._tcp.example.com.": {
+			"_api._tcp.example.com. 60 IN SRV 10 20 8080 api1.example.com.",
+			"_api._tcp.example.com. 60 IN SRV 10 0 8080 api2.example.com.",
+		},
+		"legacy.example.com.": {
+			"legacy.example.com. 300 IN CNAME backend.example.com.",
+		},
+		"backend.example.com.": {
+			"backend.example.com. 120 IN A 10.0.0.1",
+			"backend.example.com. 120 IN AAAA 2001:db8::1",
+		},
+	}
+
+	p := newTestProvider(t, zone, map[string]Service{
+		"api": {Name: "_api._tcp.example.com"},
+		"db":  {Name: "legacy.example.com", RecordType: "a", Port: 5432, Protocol: "tcp"},
+	})
+	p.HonorTTL = true
+
+	configurationChan := make(chan dynamic.Message, 1)
+
+	next := p.refresh(t.Context(), configurationChan)
+	assert.Equal(t, 60*time.Second, next)
+
+	message := <-configurationChan
+	assert.Equal(t, ProviderName, message.ProviderName)
+
+	require.Contains(t, message.Configuration.HTTP.Services, "api")
+	assert.Equal(t, []dynamic.Server{
+		{URL: "http://api1.example.com:8080", Weight: new(20)},
+		{URL: "http://api2.example.com:8080", Weight: new(1)},
+	}, message.Configuration.HTTP.Services["api"].LoadBalancer.Servers)
+
+	require.Contains(t, message.Configuration.TCP.Services, "db")
+	assert.Equal(t, []dynamic.TCPServer{
+		{Address: "10.0.0.1:5432"},
+		{Address: "[2001:db8::1]:5432"},
+	}, message.Configuration.TCP.Services["db"].LoadBalancer.Servers)
+
+	// Same records, no new configuration.
+	p.refresh(t.Context(), configurationChan)
+	assert.Empty(t, configurationChan)
+}
+
+func TestProvider_refresh_keepsRecordsOnFailure(t *testing.T) {
+	zone := map[string][]string{
+		"_api._tcp.example.com.": {
+			"_api._tcp.example.com. 60 IN SRV 10 1 8080 api1.example.com.",
+		},
+	}
+
+	p := newTestProvider(t, zone, map[string]Service{
+		"api": {Name: "_api._tcp.example.com"},
+	})
+	p.HonorTTL = true
+
+	configurationChan := make(chan dynamic.Message, 1)
+
+	p.refresh(t.Context(), configurationChan)
+	<-configurationChan
+
+	// Point the resolver to a closed port to make the resolution fail.
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	_, port, err := net.SplitHostPort(listener.LocalAddr().String())
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+
+	p.resolver.config.Port = port
+	p.resolver.udpClient.Timeout = 100 * time.Millisecond
+
+	next := p.refresh(t.Context(), configurationChan)
+	assert.Equal(t, time.Duration(p.RefreshInterval), next)
+	assert.Empty(t, configurationChan)
+	assert.Len(t, p.records["api"].records, 1)
+}
+
+func TestProvider_Init(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		services    map[string]Service
+		expectedErr string
+	}{
+		{
+			desc:        "missing name",
+			services:    map[string]Service{"api": {}},
+			expectedErr: `invalid service "api": name is required`,
+		},
+		{
+			desc:        "A records without port",
+			services:    map[string]Service{"api": {Name: "api.example.com", RecordType: "A"}},
+			expectedErr: `invalid service "api": a valid port is required with A records`,
+		},
+		{
+			desc:        "unsupported record type",
+			services:    map[string]Service{"api": {Name: "api.example.com", RecordType: "TXT"}},
+			expectedErr: `invalid service "api": unsupported record type "TXT"`,
+		},
+		{
+			desc:        "unsupported protocol",
+			services:    map[string]Service{"api": {Name: "api.example.com", Protocol: "sctp"}},
+			expectedErr: `invalid service "api": unsupported protocol "sctp"`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			p := &Provider{RefreshInterval: ptypes.Duration(time.Second), Services: test.services}
+
+			err := p.Init()
+			assert.EqualError(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestProvider_noService(t *testing.T) {
+	p := &Provider{}
+	p.SetDefaults()
+
+	require.NoError(t, p.Init())
+
+	configurationChan := make(chan dynamic.Message, 1)
+	require.NoError(t, p.Provide(configurationChan, safe.NewPool(t.Context())))
+
+	assert.Empty(t, configurationChan)
+}
+
+// newTestProvider returns an initialized provider resolving the given zone records from a local DNS server.
+func newTestProvider(t *testing.T, zone map[string][]string, services map[string]Service) *Provider {
+	t.Helper()
+
+	mux := mdns.NewServeMux()
+	mux.HandleFunc(".", func(rw mdns.ResponseWriter, req *mdns.Msg) {
+		resp := &mdns.Msg{}
+		resp.SetReply(req)
+
+		question := req.Question[0]
+		records, ok := zone[question.Name]
+		if !ok {
+			resp.SetRcode(req, mdns.RcodeNameError)
+		}
+
+		for _, record := range records {
+			rr, err := mdns.NewRR(record)
+			require.NoError(t, err)
+
+			if rr.Header().Rrtype == question.Qtype || rr.Header().Rrtype == mdns.TypeCNAME {
+				resp.Answer = append(resp.Answer, rr)
+			}
+		}
+
+		_ = rw.WriteMsg(resp)
+	})
+
+	packetConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &mdns.Server{PacketConn: packetConn, Handler: mux}
+	go func() { _ = server.ActivateAndServe() }()
+	t.Cleanup(func() { _ = server.Shutdown() })
+
+	_, port, err := net.SplitHostPort(packetConn.LocalAddr().String())
+	require.NoError(t, err)
+
+	resolvConfig := filepath.Join(t.TempDir(), "resolv.conf")
+	require.NoError(t, os.WriteFile(resolvConfig, []byte("nameserver 127.0.0.1\n"), 0o644))
+
+	p := &Provider{
+		Services:     services,
+		HostResolver: &types.HostResolverConfig{ResolvConfig: resolvConfig, ResolvDepth: 5},
+	}
+	p.SetDefaults()
+	require.NoError(t, p.Init())
+
+	// The resolver configuration file does not allow to set the port of the name servers.
+	p.resolver.config.Port = port
+
+	return p
+}
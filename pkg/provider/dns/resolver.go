@@ -0,0 +1,207 @@
+package dns
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	mdns "github.com/miekg/dns"
+)
+
+// record is a server address resolved from DNS.
+type record struct {
+	Host     string
+	Port     int
+	Priority uint16
+	Weight   uint16
+}
+
+// resolvedRecords holds the records resolved for a service, and their lowest TTL.
+type resolvedRecords struct {
+	records []record
+	ttl     time.Duration
+}
+
+// resolver queries the DNS servers of the host resolver configuration.
+type resolver struct {
+	config    *mdns.ClientConfig
+	udpClient *mdns.Client
+	tcpClient *mdns.Client
+	depth     int
+}
+
+func newResolver(config *mdns.ClientConfig, depth int) *resolver {
+	timeout := time.Duration(config.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+
+	return &resolver{
+		config:    config,
+		udpClient: &mdns.Client{Net: "udp", Timeout: timeout},
+		tcpClient: &mdns.Client{Net: "tcp", Timeout: timeout},
+		depth:     max(depth, 1),
+	}
+}
+
+// resolve returns the records of the given service.
+func (r *resolver) resolve(ctx context.Context, service Service) (resolvedRecords, error) {
+	if service.RecordType == RecordTypeSRV {
+		return r.resolveSRV(ctx, service.Name)
+	}
+
+	return r.resolveA(ctx, service.Name, service.Port)
+}
+
+func (r *resolver) resolveSRV(ctx context.Context, name string) (resolvedRecords, error) {
+	answers, ttl, err := r.lookup(ctx, name, mdns.TypeSRV)
+	if err != nil {
+		return resolvedRecords{}, err
+	}
+
+	result := resolvedRecords{ttl: ttl}
+	for _, answer := range answers {
+		srv, ok := answer.(*mdns.SRV)
+		if !ok || srv.Target == "." {
+			continue
+		}
+
+		result.records = append(result.records, record{
+			Host:     strings.TrimSuffix(srv.Target, "."),
+			Port:     int(srv.Port),
+			Priority: srv.Priority,
+			Weight:   srv.Weight,
+		})
+	}
+
+	sortRecords(result.records)
+
+	return result, nil
+}
+
+func (r *resolver) resolveA(ctx context.Context, name string, port int) (resolvedRecords, error) {
+	var result resolvedRecords
+	for _, qtype := range []uint16{mdns.TypeA, mdns.TypeAAAA} {
+		answers, ttl, err := r.lookup(ctx, name, qtype)
+		if err != nil {
+			return resolvedRecords{}, err
+		}
+
+		if ttl > 0 && (result.ttl == 0 || ttl < result.ttl) {
+			result.ttl = ttl
+		}
+
+		for _, answer := range answers {
+			var ip net.IP
+			switch rr := answer.(type) {
+			case *mdns.A:
+				ip = rr.A
+			case *mdns.AAAA:
+				ip = rr.AAAA
+			default:
+				continue
+			}
+
+			result.records = append(result.records, record{Host: ip.String(), Port: port})
+		}
+	}
+
+	sortRecords(result.records)
+
+	return result, nil
+}
+
+// lookup returns the answers of the given type for the given name, following the CNAME records up to the resolver depth,
+// and the lowest TTL of the answers.
+func (r *resolver) lookup(ctx context.Context, name string, qtype uint16) ([]mdns.RR, time.Duration, error) {
+	name = mdns.Fqdn(name)
+
+	var ttl uint32
+	for range r.depth {
+		answers, err := r.exchange(ctx, name, qtype)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var result []mdns.RR
+		var cname string
+		for _, answer := range answers {
+			header := answer.Header()
+			if ttl == 0 || header.Ttl < ttl {
+				ttl = header.Ttl
+			}
+
+			switch {
+			case header.Rrtype == qtype:
+				result = append(result, answer)
+			case header.Rrtype == mdns.TypeCNAME && strings.EqualFold(header.Name, name):
+				cname = answer.(*mdns.CNAME).Target
+			}
+		}
+
+		if len(result) > 0 || cname == "" {
+			return result, time.Duration(ttl) * time.Second, nil
+		}
+
+		name = cname
+	}
+
+	return nil, 0, fmt.Errorf("maximum CNAME depth of %d reached", r.depth)
+}
+
+// exchange sends the question to the configured servers, and returns the answers of the first server responding.
+func (r *resolver) exchange(ctx context.Context, name string, qtype uint16) ([]mdns.RR, error) {
+	msg := &mdns.Msg{}
+	msg.SetQuestion(name, qtype)
+	msg.SetEdns0(4096, false)
+
+	var errs []error
+	for _, server := range r.config.Servers {
+		address := net.JoinHostPort(server, r.config.Port)
+
+		resp, _, err := r.udpClient.ExchangeContext(ctx, msg, address)
+		if err == nil && resp.Truncated {
+			resp, _, err = r.tcpClient.ExchangeContext(ctx, msg, address)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("server %s: %w", address, err))
+			continue
+		}
+
+		switch resp.Rcode {
+		case mdns.RcodeSuccess:
+			return resp.Answer, nil
+		case mdns.RcodeNameError:
+			return nil, nil
+		default:
+			errs = append(errs, fmt.Errorf("server %s: %s", address, mdns.RcodeToString[resp.Rcode]))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil, errors.New("no DNS server configured")
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// sortRecords sorts the records, so that the generated configuration does not depend on the order of the answers.
+func sortRecords(records []record) {
+	slices.SortFunc(records, func(a, b record) int {
+		return cmp.Or(
+			cmp.Compare(a.Priority, b.Priority),
+			strings.Compare(a.Host, b.Host),
+			cmp.Compare(a.Port, b.Port),
+		)
+	})
+}
+
+func (r record) address() string {
+	return net.JoinHostPort(r.Host, strconv.Itoa(r.Port))
+}
@@ -214,7 +214,7 @@ func (p *Provider) addWatcher(pool *safe.Pool, items []string, configurationChan
 
 // applyConfiguration builds the configuration and sends it to the given configurationChan.
 func (p *Provider) applyConfiguration(configurationChan chan<- dynamic.Message) error {
-	configuration, err := p.buildConfiguration()
+	configuration, err := p.BuildConfiguration()
 	if err != nil {
 		return err
 	}
@@ -223,9 +223,9 @@ func (p *Provider) applyConfiguration(configurationChan chan<- dynamic.Message)
 	return nil
 }
 
-// buildConfiguration loads configuration either from file or a directory
+// BuildConfiguration loads configuration either from file or a directory
 // specified by 'Filename'/'Directory' and returns a 'Configuration' object.
-func (p *Provider) buildConfiguration() (*dynamic.Configuration, error) {
+func (p *Provider) BuildConfiguration() (*dynamic.Configuration, error) {
 	ctx := log.With().Str(logs.ProviderName, ProviderName).Logger().WithContext(context.Background())
 
 	if len(p.Directory) > 0 {
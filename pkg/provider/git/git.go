@@ -0,0 +1,452 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/rs/zerolog/log"
+	ptypes "github.com/traefik/paerser/types"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/job"
+	"github.com/traefik/traefik/v3/pkg/observability/logs"
+	"github.com/traefik/traefik/v3/pkg/provider"
+	"github.com/traefik/traefik/v3/pkg/provider/file"
+	"github.com/traefik/traefik/v3/pkg/safe"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ provider.Provider = (*Provider)(nil)
+
+// ProviderName is the git provider name.
+const ProviderName = "git"
+
+// fetchedRef is the local reference the fetched revision is stored under.
+const fetchedRef = plumbing.ReferenceName("refs/traefik/fetched")
+
+// Provider is a provider.Provider implementation that loads the dynamic configuration from a git repository.
+type Provider struct {
+	Repository                string          `description:"Git repository URL or local path to load the configuration from." json:"repository,omitempty" toml:"repository,omitempty" yaml:"repository,omitempty"`
+	Ref                       string          `description:"Branch or tag to check out, defaults to the repository default branch." json:"ref,omitempty" toml:"ref,omitempty" yaml:"ref,omitempty" export:"true"`
+	Commit                    string          `description:"Full SHA of the commit to pin the configuration to." json:"commit,omitempty" toml:"commit,omitempty" yaml:"commit,omitempty" export:"true"`
+	Directory                 string          `description:"Sub-directory of the repository holding the dynamic configuration files." json:"directory,omitempty" toml:"directory,omitempty" yaml:"directory,omitempty" export:"true"`
+	CheckoutDirectory         string          `description:"Local directory where the repository is checked out, defaults to a temporary directory." json:"checkoutDirectory,omitempty" toml:"checkoutDirectory,omitempty" yaml:"checkoutDirectory,omitempty" export:"true"`
+	PollInterval              ptypes.Duration `description:"Polling interval for the repository." json:"pollInterval,omitempty" toml:"pollInterval,omitempty" yaml:"pollInterval,omitempty" export:"true"`
+	PollTimeout               ptypes.Duration `description:"Polling timeout for the repository." json:"pollTimeout,omitempty" toml:"pollTimeout,omitempty" yaml:"pollTimeout,omitempty" export:"true"`
+	Username                  string          `description:"Username for HTTPS authentication." json:"username,omitempty" toml:"username,omitempty" yaml:"username,omitempty" loggable:"false"`
+	Password                  string          `description:"Password or token for HTTPS authentication." json:"password,omitempty" toml:"password,omitempty" yaml:"password,omitempty" loggable:"false"`
+	SSHKey                    string          `description:"Path to the private key used for SSH authentication." json:"sshKey,omitempty" toml:"sshKey,omitempty" yaml:"sshKey,omitempty" loggable:"false"`
+	SSHKnownHosts             string          `description:"Path to the known hosts file used to verify the SSH server key." json:"sshKnownHosts,omitempty" toml:"sshKnownHosts,omitempty" yaml:"sshKnownHosts,omitempty" export:"true"`
+	DebugLogGeneratedTemplate bool            `description:"Enable debug logging of generated configuration template." json:"debugLogGeneratedTemplate,omitempty" toml:"debugLogGeneratedTemplate,omitempty" yaml:"debugLogGeneratedTemplate,omitempty" export:"true"`
+
+	gitDir   string
+	workTree string
+	auth     transport.AuthMethod
+	depth    int
+
+	revisionMu sync.RWMutex
+	revision   *Revision
+}
+
+// Revision describes the repository revision the provided configuration comes from.
+type Revision struct {
+	Ref       string    `json:"ref,omitempty"`
+	Commit    string    `json:"commit"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// SetDefaults sets the default values.
+func (p *Provider) SetDefaults() {
+	p.PollInterval = ptypes.Duration(30 * time.Second)
+	p.PollTimeout = ptypes.Duration(30 * time.Second)
+}
+
+// Init the provider.
+func (p *Provider) Init() error {
+	if p.Repository == "" {
+		return errors.New("non-empty repository is required")
+	}
+
+	if p.Ref != "" && p.Commit != "" {
+		return errors.New("ref and commit options are mutually exclusive")
+	}
+
+	if p.Commit != "" && !plumbing.IsHash(p.Commit) {
+		return errors.New("commit must be a full SHA")
+	}
+
+	if p.PollInterval <= 0 {
+		return errors.New("poll interval must be greater than 0")
+	}
+
+	endpoint, err := transport.NewEndpoint(p.Repository)
+	if err != nil {
+		return fmt.Errorf("parsing repository: %w", err)
+	}
+
+	p.auth, err = p.authMethod(endpoint)
+	if err != nil {
+		return err
+	}
+
+	// The local and file repositories are served by go-git itself, which does not support shallow fetches.
+	if endpoint.Protocol != "file" {
+		p.depth = 1
+	}
+
+	checkoutDir := p.CheckoutDirectory
+	if checkoutDir == "" {
+		checkoutDir, err = os.MkdirTemp("", "traefik-git-")
+		if err != nil {
+			return fmt.Errorf("creating checkout directory: %w", err)
+		}
+	}
+
+	// The git directory is kept apart from the work tree,
+	// so that its content is never collected as dynamic configuration.
+	p.gitDir = filepath.Join(checkoutDir, "git")
+	p.workTree = filepath.Join(checkoutDir, "worktree")
+
+	if err := os.MkdirAll(p.workTree, 0o700); err != nil {
+		return fmt.Errorf("creating work tree directory: %w", err)
+	}
+
+	return nil
+}
+
+// authMethod returns the authentication method for the given repository endpoint.
+// The credentials are only handed to the transport of the matching protocol, which sends them to the repository host only.
+func (p *Provider) authMethod(endpoint *transport.Endpoint) (transport.AuthMethod, error) {
+	switch endpoint.Protocol {
+	case "http", "https":
+		if p.SSHKey != "" || p.SSHKnownHosts != "" {
+			return nil, errors.New("sshKey and sshKnownHosts options require an SSH repository")
+		}
+
+		if p.Username == "" && p.Password == "" {
+			return nil, nil
+		}
+
+		return &githttp.BasicAuth{Username: p.Username, Password: p.Password}, nil
+
+	case "ssh":
+		if p.Username != "" || p.Password != "" {
+			return nil, errors.New("username and password options require an HTTP(S) repository")
+		}
+
+		if p.SSHKey == "" && p.SSHKnownHosts == "" {
+			// Defaults to the SSH agent, and to the known hosts files of the user.
+			return nil, nil
+		}
+
+		user := endpoint.User
+		if user == "" {
+			user = gitssh.DefaultUsername
+		}
+
+		// A nil callback defaults to the known hosts files of the user.
+		var hostKeyCallback ssh.HostKeyCallback
+		if p.SSHKnownHosts != "" {
+			var err error
+			hostKeyCallback, err = gitssh.NewKnownHostsCallback(p.SSHKnownHosts)
+			if err != nil {
+				return nil, fmt.Errorf("reading SSH known hosts: %w", err)
+			}
+		}
+
+		if p.SSHKey == "" {
+			auth, err := gitssh.NewSSHAgentAuth(user)
+			if err != nil {
+				return nil, fmt.Errorf("connecting to the SSH agent: %w", err)
+			}
+			auth.HostKeyCallback = hostKeyCallback
+
+			return auth, nil
+		}
+
+		auth, err := gitssh.NewPublicKeysFromFile(user, p.SSHKey, "")
+		if err != nil {
+			return nil, fmt.Errorf("reading SSH key: %w", err)
+		}
+		auth.HostKeyCallback = hostKeyCallback
+
+		return auth, nil
+
+	default:
+		if p.Username != "" || p.Password != "" || p.SSHKey != "" || p.SSHKnownHosts != "" {
+			return nil, fmt.Errorf("credentials are not supported with %s repositories", endpoint.Protocol)
+		}
+
+		return nil, nil
+	}
+}
+
+// Provide allows the provider to provide configurations to traefik using the given configuration channel.
+func (p *Provider) Provide(configurationChan chan<- dynamic.Message, pool *safe.Pool) error {
+	pool.GoCtx(func(routineCtx context.Context) {
+		logger := log.Ctx(routineCtx).With().Str(logs.ProviderName, ProviderName).Logger()
+		ctxLog := logger.WithContext(routineCtx)
+
+		operation := func() error {
+			if err := p.updateConfiguration(ctxLog, configurationChan); err != nil {
+				return err
+			}
+
+			ticker := time.NewTicker(time.Duration(p.PollInterval))
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ticker.C:
+					if err := p.updateConfiguration(ctxLog, configurationChan); err != nil {
+						return err
+					}
+
+				case <-routineCtx.Done():
+					return nil
+				}
+			}
+		}
+
+		notify := func(err error, time time.Duration) {
+			logger.Error().Err(err).Msgf("Provider error, retrying in %s", time)
+		}
+		err := backoff.RetryNotify(safe.OperationWithRecover(operation), backoff.WithContext(job.NewBackOff(backoff.NewExponentialBackOff()), ctxLog), notify)
+		if err != nil {
+			logger.Error().Err(err).Msg("Cannot retrieve data")
+		}
+	})
+
+	return nil
+}
+
+// Revision returns the repository revision of the last provided configuration, or nil if none has been provided yet.
+func (p *Provider) Revision() *Revision {
+	p.revisionMu.RLock()
+	defer p.revisionMu.RUnlock()
+
+	if p.revision == nil {
+		return nil
+	}
+
+	revision := *p.revision
+	return &revision
+}
+
+func (p *Provider) updateConfiguration(ctx context.Context, configurationChan chan<- dynamic.Message) error {
+	// A pinned commit never changes once it has been loaded.
+	if current := p.Revision(); current != nil && p.Commit != "" {
+		return nil
+	}
+
+	commit, err := p.checkout(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check out repository: %w", err)
+	}
+
+	if current := p.Revision(); current != nil && current.Commit == commit {
+		return nil
+	}
+
+	fileProvider := &file.Provider{
+		Directory:                 filepath.Join(p.workTree, p.Directory),
+		DebugLogGeneratedTemplate: p.DebugLogGeneratedTemplate,
+	}
+
+	configuration, err := fileProvider.BuildConfiguration()
+	if err != nil {
+		return fmt.Errorf("cannot build configuration from commit %s: %w", commit, err)
+	}
+
+	log.Ctx(ctx).Info().Str("commit", commit).Msg("Loaded configuration from repository")
+
+	p.revisionMu.Lock()
+	p.revision = &Revision{
+		Ref:       p.Ref,
+		Commit:    commit,
+		UpdatedAt: time.Now(),
+	}
+	p.revisionMu.Unlock()
+
+	configurationChan <- dynamic.Message{
+		ProviderName:  ProviderName,
+		Configuration: configuration,
+	}
+
+	return nil
+}
+
+// checkout fetches the configured revision of the repository, checks it out in the work tree,
+// and returns the SHA of the checked out commit.
+func (p *Provider) checkout(ctx context.Context) (string, error) {
+	if p.PollTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(p.PollTimeout))
+		defer cancel()
+	}
+
+	repository, err := p.openRepository()
+	if err != nil {
+		return "", err
+	}
+
+	var commit *object.Commit
+	if p.Commit != "" {
+		commit, err = p.fetchCommit(ctx, repository, plumbing.NewHash(p.Commit))
+	} else {
+		commit, err = p.fetchRef(ctx, repository)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	worktree, err := repository.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("opening work tree: %w", err)
+	}
+
+	if err := worktree.Checkout(&git.CheckoutOptions{Hash: commit.Hash, Force: true}); err != nil {
+		return "", fmt.Errorf("checking out commit %s: %w", commit.Hash, err)
+	}
+
+	return commit.Hash.String(), nil
+}
+
+// openRepository opens the local repository, and initializes it on the first call.
+func (p *Provider) openRepository() (*git.Repository, error) {
+	storage := filesystem.NewStorage(osfs.New(p.gitDir), cache.NewObjectLRUDefault())
+	worktree := osfs.New(p.workTree)
+
+	repository, err := git.Open(storage, worktree)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		repository, err = git.Init(storage, worktree)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening local repository: %w", err)
+	}
+
+	return repository, nil
+}
+
+// fetchRef fetches the configured ref, or the default branch, and returns the commit it points to.
+func (p *Provider) fetchRef(ctx context.Context, repository *git.Repository) (*object.Commit, error) {
+	remote := git.NewRemote(repository.Storer, &config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{p.Repository}})
+
+	refs, err := remote.ListContext(ctx, &git.ListOptions{Auth: p.auth})
+	if err != nil {
+		return nil, fmt.Errorf("listing remote refs: %w", err)
+	}
+
+	remoteRef, err := findRef(refs, p.Ref)
+	if err != nil {
+		return nil, err
+	}
+
+	refSpec := config.RefSpec(fmt.Sprintf("+%s:%s", remoteRef, fetchedRef))
+	if err := p.fetch(ctx, remote, refSpec); err != nil {
+		return nil, err
+	}
+
+	ref, err := repository.Reference(fetchedRef, true)
+	if err != nil {
+		return nil, fmt.Errorf("resolving %s: %w", remoteRef, err)
+	}
+
+	// Annotated tags are peeled to the commit they point to.
+	if tag, err := repository.TagObject(ref.Hash()); err == nil {
+		return tag.Commit()
+	}
+
+	return repository.CommitObject(ref.Hash())
+}
+
+// fetchCommit fetches the given commit, unless it is already known, and returns it.
+func (p *Provider) fetchCommit(ctx context.Context, repository *git.Repository, hash plumbing.Hash) (*object.Commit, error) {
+	if commit, err := repository.CommitObject(hash); err == nil {
+		return commit, nil
+	}
+
+	remote := git.NewRemote(repository.Storer, &config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{p.Repository}})
+
+	err := p.fetch(ctx, remote, config.RefSpec(fmt.Sprintf("+%s:%s", hash, fetchedRef)))
+	if errors.Is(err, git.ErrExactSHA1NotSupported) {
+		// The server does not serve arbitrary commits, the whole history of its branches and tags is fetched instead.
+		err = p.fetch(ctx, remote, "+refs/heads/*:refs/remotes/origin/*", "+refs/tags/*:refs/tags/*")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := repository.CommitObject(hash)
+	if err != nil {
+		return nil, fmt.Errorf("commit %s not found in the repository: %w", hash, err)
+	}
+
+	return commit, nil
+}
+
+// fetch fetches the given ref specs from the given remote.
+func (p *Provider) fetch(ctx context.Context, remote *git.Remote, refSpecs ...config.RefSpec) error {
+	err := remote.FetchContext(ctx, &git.FetchOptions{
+		RefSpecs: refSpecs,
+		Depth:    p.depth,
+		Auth:     p.auth,
+		Tags:     git.NoTags,
+		Force:    true,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return fmt.Errorf("fetching repository: %w", err)
+	}
+
+	return nil
+}
+
+// findRef returns the name of the remote reference matching the given branch or tag name,
+// or the reference of the default branch when the name is empty.
+func findRef(refs []*plumbing.Reference, name string) (plumbing.ReferenceName, error) {
+	if name == "" {
+		for _, ref := range refs {
+			if ref.Name() != plumbing.HEAD {
+				continue
+			}
+
+			if ref.Type() == plumbing.SymbolicReference {
+				return ref.Target(), nil
+			}
+			return ref.Name(), nil
+		}
+
+		return "", errors.New("remote repository has no default branch")
+	}
+
+	candidates := []plumbing.ReferenceName{
+		plumbing.ReferenceName(name),
+		plumbing.NewBranchReferenceName(name),
+		plumbing.NewTagReferenceName(name),
+	}
+
+	for _, candidate := range candidates {
+		for _, ref := range refs {
+			if ref.Name() == candidate {
+				return candidate, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("ref %q not found in the remote repository", name)
+}
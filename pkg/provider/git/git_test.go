@@ -0,0 +1,335 @@
+package git
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+const routerConfig = `
+http:
+  routers:
+    %s:
+      rule: Host("example.com")
+      service: whoami
+  services:
+    whoami:
+      loadBalancer:
+        servers:
+          - url: http://127.0.0.1:8080
+`
+
+func TestProvider_updateConfiguration(t *testing.T) {
+	repository := newRepository(t)
+
+	writeFile(t, repository, "README.md", "not a configuration file")
+	writeFile(t, repository, "traefik/dynamic.yml", strings.ReplaceAll(routerConfig, "%s", "first"))
+	firstCommit := commit(t, repository)
+
+	p := &Provider{Repository: repositoryPath(t, repository), Directory: "traefik", CheckoutDirectory: t.TempDir()}
+	p.SetDefaults()
+	require.NoError(t, p.Init())
+
+	configurationChan := make(chan dynamic.Message, 1)
+
+	require.NoError(t, p.updateConfiguration(t.Context(), configurationChan))
+
+	message := <-configurationChan
+	assert.Equal(t, ProviderName, message.ProviderName)
+	assert.Contains(t, message.Configuration.HTTP.Routers, "first")
+	assert.Contains(t, message.Configuration.HTTP.Services, "whoami")
+
+	revision := p.Revision()
+	require.NotNil(t, revision)
+	assert.Equal(t, firstCommit, revision.Commit)
+
+	// No new commit, no new configuration.
+	require.NoError(t, p.updateConfiguration(t.Context(), configurationChan))
+	assert.Empty(t, configurationChan)
+
+	writeFile(t, repository, "traefik/dynamic.yml", strings.ReplaceAll(routerConfig, "%s", "second"))
+	secondCommit := commit(t, repository)
+
+	require.NoError(t, p.updateConfiguration(t.Context(), configurationChan))
+
+	message = <-configurationChan
+	assert.NotContains(t, message.Configuration.HTTP.Routers, "first")
+	assert.Contains(t, message.Configuration.HTTP.Routers, "second")
+	assert.Equal(t, secondCommit, p.Revision().Commit)
+}
+
+func TestProvider_updateConfiguration_pinned(t *testing.T) {
+	repository := newRepository(t)
+
+	writeFile(t, repository, "dynamic.yml", strings.ReplaceAll(routerConfig, "%s", "first"))
+	firstCommit := commit(t, repository)
+
+	writeFile(t, repository, "dynamic.yml", strings.ReplaceAll(routerConfig, "%s", "second"))
+	secondCommit := commit(t, repository)
+
+	testCases := []struct {
+		desc           string
+		ref            string
+		commit         string
+		expectedRouter string
+		expectedCommit string
+	}{
+		{
+			desc:           "pinned commit",
+			commit:         firstCommit,
+			expectedRouter: "first",
+			expectedCommit: firstCommit,
+		},
+		{
+			desc:           "pinned tag",
+			ref:            "v1",
+			expectedRouter: "first",
+			expectedCommit: firstCommit,
+		},
+		{
+			desc:           "pinned annotated tag",
+			ref:            "v1-annotated",
+			expectedRouter: "first",
+			expectedCommit: firstCommit,
+		},
+		{
+			desc:           "pinned branch",
+			ref:            "main",
+			expectedRouter: "second",
+			expectedCommit: secondCommit,
+		},
+	}
+
+	_, err := repository.CreateTag("v1", plumbing.NewHash(firstCommit), nil)
+	require.NoError(t, err)
+
+	_, err = repository.CreateTag("v1-annotated", plumbing.NewHash(firstCommit), &git.CreateTagOptions{
+		Tagger:  &object.Signature{Name: "traefik", Email: "traefik@example.com", When: time.Now()},
+		Message: "v1",
+	})
+	require.NoError(t, err)
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			p := &Provider{Repository: repositoryPath(t, repository), Ref: test.ref, Commit: test.commit, CheckoutDirectory: t.TempDir()}
+			p.SetDefaults()
+			require.NoError(t, p.Init())
+
+			configurationChan := make(chan dynamic.Message, 1)
+
+			require.NoError(t, p.updateConfiguration(t.Context(), configurationChan))
+
+			message := <-configurationChan
+			assert.Len(t, message.Configuration.HTTP.Routers, 1)
+			assert.Contains(t, message.Configuration.HTTP.Routers, test.expectedRouter)
+
+			revision := p.Revision()
+			require.NotNil(t, revision)
+			assert.Equal(t, test.expectedCommit, revision.Commit)
+			assert.Equal(t, test.ref, revision.Ref)
+		})
+	}
+}
+
+func TestProvider_Init(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		provider    *Provider
+		expectedErr string
+	}{
+		{
+			desc:        "missing repository",
+			provider:    &Provider{PollInterval: 1},
+			expectedErr: "non-empty repository is required",
+		},
+		{
+			desc:        "ref and commit",
+			provider:    &Provider{Repository: "https://example.com/config.git", Ref: "main", Commit: "abc", PollInterval: 1},
+			expectedErr: "ref and commit options are mutually exclusive",
+		},
+		{
+			desc:        "invalid poll interval",
+			provider:    &Provider{Repository: "https://example.com/config.git"},
+			expectedErr: "poll interval must be greater than 0",
+		},
+		{
+			desc:        "short commit",
+			provider:    &Provider{Repository: "https://example.com/config.git", Commit: "abc", PollInterval: 1},
+			expectedErr: "commit must be a full SHA",
+		},
+		{
+			desc:        "password with SSH repository",
+			provider:    &Provider{Repository: "git@example.com:config.git", Password: "token", PollInterval: 1},
+			expectedErr: "username and password options require an HTTP(S) repository",
+		},
+		{
+			desc:        "SSH key with HTTPS repository",
+			provider:    &Provider{Repository: "https://example.com/config.git", SSHKey: "/keys/id_ed25519", PollInterval: 1},
+			expectedErr: "sshKey and sshKnownHosts options require an SSH repository",
+		},
+		{
+			desc:        "password with local repository",
+			provider:    &Provider{Repository: "/srv/config", Password: "token", PollInterval: 1},
+			expectedErr: "credentials are not supported with file repositories",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.provider.Init()
+			assert.EqualError(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestProvider_authMethod(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+	writeSSHKey(t, keyFile)
+
+	knownHostsFile := filepath.Join(t.TempDir(), "known_hosts")
+	require.NoError(t, os.WriteFile(knownHostsFile, nil, 0o600))
+
+	testCases := []struct {
+		desc       string
+		provider   *Provider
+		repository string
+		assert     func(t *testing.T, auth transport.AuthMethod)
+	}{
+		{
+			desc:       "HTTPS without credentials",
+			provider:   &Provider{},
+			repository: "https://example.com/config.git",
+			assert: func(t *testing.T, auth transport.AuthMethod) {
+				t.Helper()
+
+				assert.Nil(t, auth)
+			},
+		},
+		{
+			desc:       "HTTPS with credentials",
+			provider:   &Provider{Username: "user", Password: "token"},
+			repository: "https://example.com/config.git",
+			assert: func(t *testing.T, auth transport.AuthMethod) {
+				t.Helper()
+
+				assert.Equal(t, &githttp.BasicAuth{Username: "user", Password: "token"}, auth)
+			},
+		},
+		{
+			desc:       "SSH with key and known hosts",
+			provider:   &Provider{SSHKey: keyFile, SSHKnownHosts: knownHostsFile},
+			repository: "deploy@example.com:config.git",
+			assert: func(t *testing.T, auth transport.AuthMethod) {
+				t.Helper()
+
+				keys, ok := auth.(*gitssh.PublicKeys)
+				require.True(t, ok)
+				assert.Equal(t, "deploy", keys.User)
+				assert.NotNil(t, keys.HostKeyCallback)
+			},
+		},
+		{
+			desc:       "SSH with key and default user",
+			provider:   &Provider{SSHKey: keyFile},
+			repository: "ssh://example.com/config.git",
+			assert: func(t *testing.T, auth transport.AuthMethod) {
+				t.Helper()
+
+				keys, ok := auth.(*gitssh.PublicKeys)
+				require.True(t, ok)
+				assert.Equal(t, gitssh.DefaultUsername, keys.User)
+				assert.Nil(t, keys.HostKeyCallback)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint, err := transport.NewEndpoint(test.repository)
+			require.NoError(t, err)
+
+			auth, err := test.provider.authMethod(endpoint)
+			require.NoError(t, err)
+
+			test.assert(t, auth)
+		})
+	}
+}
+
+func newRepository(t *testing.T) *git.Repository {
+	t.Helper()
+
+	repository, err := git.PlainInitWithOptions(t.TempDir(), &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+	})
+	require.NoError(t, err)
+
+	return repository
+}
+
+func repositoryPath(t *testing.T, repository *git.Repository) string {
+	t.Helper()
+
+	worktree, err := repository.Worktree()
+	require.NoError(t, err)
+
+	return worktree.Filesystem.Root()
+}
+
+func writeFile(t *testing.T, repository *git.Repository, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(repositoryPath(t, repository), name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func commit(t *testing.T, repository *git.Repository) string {
+	t.Helper()
+
+	worktree, err := repository.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, worktree.AddWithOptions(&git.AddOptions{All: true}))
+
+	hash, err := worktree.Commit("update", &git.CommitOptions{
+		Author: &object.Signature{Name: "traefik", Email: "traefik@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	return hash.String()
+}
+
+func writeSSHKey(t *testing.T, path string) {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+}
@@ -2,11 +2,15 @@ package http
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"hash/fnv"
 	"io"
+	"io/fs"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -37,12 +41,23 @@ type Provider struct {
 	PollTimeout  ptypes.Duration   `description:"Polling timeout for endpoint." json:"pollTimeout,omitempty" toml:"pollTimeout,omitempty" yaml:"pollTimeout,omitempty" export:"true"`
 	Headers      map[string]string `description:"Define custom headers to be sent to the endpoint." json:"headers,omitempty" toml:"headers,omitempty" yaml:"headers,omitempty" export:"true"`
 	TLS          *types.ClientTLS  `description:"Enable TLS support." json:"tls,omitempty" toml:"tls,omitempty" yaml:"tls,omitempty" export:"true"`
+	Signature    *Signature        `description:"Verify the signature of the configuration payloads." json:"signature,omitempty" toml:"signature,omitempty" yaml:"signature,omitempty" label:"allowEmpty" file:"allowEmpty" export:"true"`
+	Storage      string            `description:"File storing the last verified configuration, loaded at startup." json:"storage,omitempty" toml:"storage,omitempty" yaml:"storage,omitempty" export:"true"`
 
 	httpClient            *http.Client
+	verifier              *signatureVerifier
 	lastConfigurationHash uint64
+	lastETag              string
 	MaxResponseBodySize   int64 `description:"Defines the maximum size of the response body in bytes." json:"maxResponseBodySize,omitempty" toml:"maxResponseBodySize,omitempty" yaml:"maxResponseBodySize,omitempty" export:"true"`
 }
 
+// payload is a configuration payload, along with its signature and entity tag.
+type payload struct {
+	Data      []byte `json:"data"`
+	Signature string `json:"signature,omitempty"`
+	ETag      string `json:"etag,omitempty"`
+}
+
 // SetDefaults sets the default values.
 func (p *Provider) SetDefaults() {
 	p.PollInterval = ptypes.Duration(5 * time.Second)
@@ -75,6 +90,15 @@ func (p *Provider) Init() error {
 		}
 	}
 
+	if p.Signature != nil {
+		verifier, err := newSignatureVerifier(p.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature configuration: %w", err)
+		}
+
+		p.verifier = verifier
+	}
+
 	return nil
 }
 
@@ -84,6 +108,13 @@ func (p *Provider) Provide(configurationChan chan<- dynamic.Message, pool *safe.
 		logger := log.Ctx(routineCtx).With().Str(logs.ProviderName, ProviderName).Logger()
 		ctxLog := logger.WithContext(routineCtx)
 
+		if p.Storage != "" {
+			// The stored configuration is provided until the endpoint can be reached.
+			if err := p.loadStoredConfiguration(configurationChan); err != nil {
+				logger.Warn().Err(err).Msgf("Cannot load the configuration stored in %s", p.Storage)
+			}
+		}
+
 		operation := func() error {
 			if err := p.updateConfiguration(configurationChan); err != nil {
 				return err
@@ -118,29 +149,92 @@ func (p *Provider) Provide(configurationChan chan<- dynamic.Message, pool *safe.
 }
 
 func (p *Provider) updateConfiguration(configurationChan chan<- dynamic.Message) error {
-	configData, err := p.fetchConfigurationData()
+	configPayload, err := p.fetchConfigurationData()
 	if err != nil {
 		return fmt.Errorf("cannot fetch configuration data: %w", err)
 	}
 
-	fnvHasher := fnv.New64()
+	if configPayload == nil {
+		// Not modified since the last fetch.
+		return nil
+	}
 
-	if _, err = fnvHasher.Write(configData); err != nil {
-		return fmt.Errorf("cannot hash configuration data: %w", err)
+	hash, err := hashConfigurationData(configPayload.Data)
+	if err != nil {
+		return err
 	}
 
-	hash := fnvHasher.Sum64()
 	if hash == p.lastConfigurationHash {
+		p.lastETag = configPayload.ETag
 		return nil
 	}
 
+	configuration, err := p.verifyAndDecode(configPayload)
+	if err != nil {
+		return err
+	}
+
 	p.lastConfigurationHash = hash
+	p.lastETag = configPayload.ETag
 
-	configuration, err := decodeConfiguration(configData)
+	configurationChan <- dynamic.Message{
+		ProviderName:  "http",
+		Configuration: configuration,
+	}
+
+	if p.Storage != "" {
+		if err := p.storeConfiguration(configPayload); err != nil {
+			log.Warn().Err(err).Str(logs.ProviderName, ProviderName).Msgf("Cannot store the configuration in %s", p.Storage)
+		}
+	}
+
+	return nil
+}
+
+// verifyAndDecode verifies the signature of the given payload, when enabled, and decodes its configuration.
+func (p *Provider) verifyAndDecode(configPayload *payload) (*dynamic.Configuration, error) {
+	if p.verifier != nil {
+		if err := p.verifier.verify(configPayload.Data, configPayload.Signature); err != nil {
+			return nil, fmt.Errorf("cannot verify configuration data: %w", err)
+		}
+	}
+
+	configuration, err := decodeConfiguration(configPayload.Data)
 	if err != nil {
-		return fmt.Errorf("cannot decode configuration data: %w", err)
+		return nil, fmt.Errorf("cannot decode configuration data: %w", err)
+	}
+
+	return configuration, nil
+}
+
+// loadStoredConfiguration sends the configuration stored by a previous run, once its signature is verified again.
+func (p *Provider) loadStoredConfiguration(configurationChan chan<- dynamic.Message) error {
+	data, err := os.ReadFile(p.Storage)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var configPayload payload
+	if err := json.Unmarshal(data, &configPayload); err != nil {
+		return fmt.Errorf("cannot unmarshal stored configuration: %w", err)
 	}
 
+	configuration, err := p.verifyAndDecode(&configPayload)
+	if err != nil {
+		return err
+	}
+
+	hash, err := hashConfigurationData(configPayload.Data)
+	if err != nil {
+		return err
+	}
+
+	p.lastConfigurationHash = hash
+	p.lastETag = configPayload.ETag
+
 	configurationChan <- dynamic.Message{
 		ProviderName:  "http",
 		Configuration: configuration,
@@ -149,8 +243,45 @@ func (p *Provider) updateConfiguration(configurationChan chan<- dynamic.Message)
 	return nil
 }
 
-// fetchConfigurationData fetches the configuration data from the configured endpoint.
-func (p *Provider) fetchConfigurationData() ([]byte, error) {
+// storeConfiguration writes the given verified payload to the storage file.
+// The file is replaced atomically, so that an interrupted write does not corrupt the previous configuration.
+func (p *Provider) storeConfiguration(configPayload *payload) error {
+	data, err := json.Marshal(configPayload)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(p.Storage), filepath.Base(p.Storage)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	if _, err = tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+
+	if err = tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), p.Storage)
+}
+
+func hashConfigurationData(data []byte) (uint64, error) {
+	fnvHasher := fnv.New64()
+
+	if _, err := fnvHasher.Write(data); err != nil {
+		return 0, fmt.Errorf("cannot hash configuration data: %w", err)
+	}
+
+	return fnvHasher.Sum64(), nil
+}
+
+// fetchConfigurationData fetches the configuration payload from the configured endpoint.
+// It returns a nil payload when the configuration has not been modified since the last fetch.
+func (p *Provider) fetchConfigurationData() (*payload, error) {
 	req, err := http.NewRequest(http.MethodGet, p.Endpoint, http.NoBody)
 	if err != nil {
 		return nil, fmt.Errorf("create fetch request: %w", err)
@@ -164,6 +295,10 @@ func (p *Provider) fetchConfigurationData() ([]byte, error) {
 		}
 	}
 
+	if p.lastETag != "" {
+		req.Header.Set("If-None-Match", p.lastETag)
+	}
+
 	res, err := p.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("do fetch request: %w", err)
@@ -171,15 +306,37 @@ func (p *Provider) fetchConfigurationData() ([]byte, error) {
 
 	defer res.Body.Close()
 
+	if res.StatusCode == http.StatusNotModified && p.lastETag != "" {
+		return nil, nil
+	}
+
 	if res.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("received non-ok response code: %d", res.StatusCode)
 	}
 
+	data, err := p.readResponseBody(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	configPayload := &payload{
+		Data: data,
+		ETag: res.Header.Get("ETag"),
+	}
+
+	if p.Signature != nil {
+		configPayload.Signature = res.Header.Get(p.Signature.Header)
+	}
+
+	return configPayload, nil
+}
+
+func (p *Provider) readResponseBody(body io.Reader) ([]byte, error) {
 	if p.MaxResponseBodySize < 0 {
-		return io.ReadAll(res.Body)
+		return io.ReadAll(body)
 	}
 
-	data, err := io.ReadAll(io.LimitReader(res.Body, p.MaxResponseBodySize+1))
+	data, err := io.ReadAll(io.LimitReader(body, p.MaxResponseBodySize+1))
 	if err != nil {
 		return nil, fmt.Errorf("reading response body: %w", err)
 	}
@@ -1,9 +1,13 @@
 package http
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -14,6 +18,7 @@ import (
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
 	"github.com/traefik/traefik/v3/pkg/safe"
 	"github.com/traefik/traefik/v3/pkg/tls"
+	"github.com/traefik/traefik/v3/pkg/types"
 )
 
 func TestProvider_Init(t *testing.T) {
@@ -153,10 +158,15 @@ func TestProvider_fetchConfigurationData(t *testing.T) {
 			err := provider.Init()
 			require.NoError(t, err)
 
-			configData, err := provider.fetchConfigurationData()
+			configPayload, err := provider.fetchConfigurationData()
 			test.expErr(t, err)
 
 			assert.True(t, handlerCalled)
+
+			var configData []byte
+			if configPayload != nil {
+				configData = configPayload.Data
+			}
 			assert.Equal(t, test.expData, configData)
 		})
 	}
@@ -302,3 +312,158 @@ func TestProvider_ProvideConfigurationOnlyOnceIfUnchanged(t *testing.T) {
 
 	assert.Len(t, configurationChan, 1)
 }
+
+func TestProvider_updateConfiguration_notModified(t *testing.T) {
+	var requests, notModified int
+	handler := func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+
+		if req.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			rw.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		rw.Header().Set("ETag", `"v1"`)
+		rw.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(rw, "{}")
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	var provider Provider
+	provider.SetDefaults()
+
+	provider.Endpoint = server.URL
+
+	err := provider.Init()
+	require.NoError(t, err)
+
+	configurationChan := make(chan dynamic.Message, 10)
+
+	require.NoError(t, provider.updateConfiguration(configurationChan))
+	require.NoError(t, provider.updateConfiguration(configurationChan))
+
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, notModified)
+	assert.Len(t, configurationChan, 1)
+}
+
+func TestProvider_updateConfiguration_signature(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	data := []byte(`{"http":{"routers":{"foo":{"service":"bar"}}}}`)
+
+	testCases := []struct {
+		desc      string
+		signature string
+		expErr    bool
+	}{
+		{
+			desc:      "valid signature",
+			signature: base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, data)),
+		},
+		{
+			desc:      "invalid signature",
+			signature: base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("{}"))),
+			expErr:    true,
+		},
+		{
+			desc:   "missing signature",
+			expErr: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			handler := func(rw http.ResponseWriter, req *http.Request) {
+				if test.signature != "" {
+					rw.Header().Set("X-Signature", test.signature)
+				}
+				rw.WriteHeader(http.StatusOK)
+				_, _ = rw.Write(data)
+			}
+
+			server := httptest.NewServer(http.HandlerFunc(handler))
+			defer server.Close()
+
+			var provider Provider
+			provider.SetDefaults()
+
+			provider.Endpoint = server.URL
+			provider.Storage = filepath.Join(t.TempDir(), "http.json")
+			provider.Signature = &Signature{}
+			provider.Signature.SetDefaults()
+			provider.Signature.PublicKeys = []types.FileOrContent{encodePublicKey(t, publicKey)}
+
+			err := provider.Init()
+			require.NoError(t, err)
+
+			configurationChan := make(chan dynamic.Message, 1)
+
+			err = provider.updateConfiguration(configurationChan)
+			if test.expErr {
+				require.Error(t, err)
+				assert.Empty(t, configurationChan)
+				assert.NoFileExists(t, provider.Storage)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, configurationChan, 1)
+			assert.Contains(t, (<-configurationChan).Configuration.HTTP.Routers, "foo")
+			assert.FileExists(t, provider.Storage)
+		})
+	}
+}
+
+func TestProvider_Provide_storedConfiguration(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	data := []byte(`{"http":{"routers":{"foo":{"service":"bar"}}}}`)
+
+	storage := filepath.Join(t.TempDir(), "http.json")
+
+	// Store the configuration from a reachable endpoint.
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("X-Signature", base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, data)))
+		rw.WriteHeader(http.StatusOK)
+		_, _ = rw.Write(data)
+	}))
+
+	newProvider := func() *Provider {
+		provider := &Provider{}
+		provider.SetDefaults()
+
+		provider.Endpoint = server.URL
+		provider.PollTimeout = ptypes.Duration(100 * time.Millisecond)
+		provider.Storage = storage
+		provider.Signature = &Signature{}
+		provider.Signature.SetDefaults()
+		provider.Signature.PublicKeys = []types.FileOrContent{encodePublicKey(t, publicKey)}
+
+		require.NoError(t, provider.Init())
+
+		return provider
+	}
+
+	require.NoError(t, newProvider().updateConfiguration(make(chan dynamic.Message, 1)))
+
+	// The endpoint is now down, the stored configuration is provided.
+	server.Close()
+
+	configurationChan := make(chan dynamic.Message, 1)
+
+	err = newProvider().Provide(configurationChan, safe.NewPool(t.Context()))
+	require.NoError(t, err)
+
+	select {
+	case configuration := <-configurationChan:
+		assert.Contains(t, configuration.Configuration.HTTP.Routers, "foo")
+	case <-time.After(time.Second):
+		t.Errorf("timeout while waiting for config")
+	}
+}
@@ -0,0 +1,139 @@
+package http
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/traefik/traefik/v3/pkg/types"
+)
+
+// Signature algorithms.
+const (
+	SignatureAlgorithmEd25519 = "ed25519"
+	SignatureAlgorithmJWS     = "jws"
+)
+
+const defaultSignatureHeader = "X-Signature"
+
+// jwsAlgorithms are the accepted algorithms of the JWS signatures.
+// Symmetric algorithms are not accepted, as the verification keys are public.
+var jwsAlgorithms = []jose.SignatureAlgorithm{
+	jose.EdDSA,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+}
+
+// Signature holds the configuration of the verification of the configuration payload signatures.
+type Signature struct {
+	Algorithm  string                `description:"Signature algorithm: ed25519 for a base64 encoded Ed25519 signature, or jws for a JWS with a detached payload." json:"algorithm,omitempty" toml:"algorithm,omitempty" yaml:"algorithm,omitempty" export:"true"`
+	Header     string                `description:"Response header holding the signature of the payload." json:"header,omitempty" toml:"header,omitempty" yaml:"header,omitempty" export:"true"`
+	PublicKeys []types.FileOrContent `description:"PEM encoded public keys allowed to sign the payload." json:"publicKeys,omitempty" toml:"publicKeys,omitempty" yaml:"publicKeys,omitempty"`
+}
+
+// SetDefaults sets the default values.
+func (s *Signature) SetDefaults() {
+	s.Algorithm = SignatureAlgorithmEd25519
+	s.Header = defaultSignatureHeader
+}
+
+// signatureVerifier verifies the signature of the configuration payloads.
+type signatureVerifier struct {
+	algorithm string
+	keys      []crypto.PublicKey
+}
+
+func newSignatureVerifier(config *Signature) (*signatureVerifier, error) {
+	algorithm := strings.ToLower(config.Algorithm)
+	if algorithm != SignatureAlgorithmEd25519 && algorithm != SignatureAlgorithmJWS {
+		return nil, fmt.Errorf("unsupported signature algorithm %q", config.Algorithm)
+	}
+
+	if len(config.PublicKeys) == 0 {
+		return nil, errors.New("at least one public key is required")
+	}
+
+	verifier := &signatureVerifier{algorithm: algorithm}
+	for i, publicKey := range config.PublicKeys {
+		key, err := parsePublicKey(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("parsing public key %d: %w", i, err)
+		}
+
+		switch key.(type) {
+		case ed25519.PublicKey:
+		case *ecdsa.PublicKey, *rsa.PublicKey:
+			if algorithm == SignatureAlgorithmEd25519 {
+				return nil, fmt.Errorf("public key %d is not an Ed25519 key", i)
+			}
+		default:
+			return nil, fmt.Errorf("public key %d has an unsupported type %T", i, key)
+		}
+
+		verifier.keys = append(verifier.keys, key)
+	}
+
+	return verifier, nil
+}
+
+// verify checks that the given signature of the data has been issued by one of the public keys.
+func (v *signatureVerifier) verify(data []byte, signature string) error {
+	if signature == "" {
+		return errors.New("missing signature")
+	}
+
+	if v.algorithm == SignatureAlgorithmJWS {
+		return v.verifyJWS(data, signature)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return fmt.Errorf("decoding signature: %w", err)
+	}
+
+	for _, key := range v.keys {
+		if ed25519.Verify(key.(ed25519.PublicKey), data, sig) {
+			return nil
+		}
+	}
+
+	return errors.New("signature does not match any public key")
+}
+
+func (v *signatureVerifier) verifyJWS(data []byte, signature string) error {
+	jws, err := jose.ParseDetached(signature, data, jwsAlgorithms)
+	if err != nil {
+		return fmt.Errorf("parsing JWS: %w", err)
+	}
+
+	for _, key := range v.keys {
+		if err := jws.DetachedVerify(data, key); err == nil {
+			return nil
+		}
+	}
+
+	return errors.New("signature does not match any public key")
+}
+
+func parsePublicKey(publicKey types.FileOrContent) (crypto.PublicKey, error) {
+	content, err := publicKey.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
@@ -0,0 +1,212 @@
+package http
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/types"
+)
+
+func TestSignatureVerifier_verify(t *testing.T) {
+	data := []byte(`{"http":{}}`)
+
+	edPublicKey, edPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	otherPublicKey, otherPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	ecPrivateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc       string
+		algorithm  string
+		publicKeys []crypto.PublicKey
+		signature  string
+		expErr     assert.ErrorAssertionFunc
+	}{
+		{
+			desc:       "valid Ed25519 signature",
+			algorithm:  SignatureAlgorithmEd25519,
+			publicKeys: []crypto.PublicKey{edPublicKey},
+			signature:  base64.StdEncoding.EncodeToString(ed25519.Sign(edPrivateKey, data)),
+			expErr:     assert.NoError,
+		},
+		{
+			desc:       "Ed25519 signature of a rotated key",
+			algorithm:  SignatureAlgorithmEd25519,
+			publicKeys: []crypto.PublicKey{edPublicKey, otherPublicKey},
+			signature:  base64.StdEncoding.EncodeToString(ed25519.Sign(otherPrivateKey, data)),
+			expErr:     assert.NoError,
+		},
+		{
+			desc:       "Ed25519 signature of an unknown key",
+			algorithm:  SignatureAlgorithmEd25519,
+			publicKeys: []crypto.PublicKey{edPublicKey},
+			signature:  base64.StdEncoding.EncodeToString(ed25519.Sign(otherPrivateKey, data)),
+			expErr:     assert.Error,
+		},
+		{
+			desc:       "Ed25519 signature of other data",
+			algorithm:  SignatureAlgorithmEd25519,
+			publicKeys: []crypto.PublicKey{edPublicKey},
+			signature:  base64.StdEncoding.EncodeToString(ed25519.Sign(edPrivateKey, []byte("{}"))),
+			expErr:     assert.Error,
+		},
+		{
+			desc:       "invalid Ed25519 signature encoding",
+			algorithm:  SignatureAlgorithmEd25519,
+			publicKeys: []crypto.PublicKey{edPublicKey},
+			signature:  "not base64!",
+			expErr:     assert.Error,
+		},
+		{
+			desc:       "missing signature",
+			algorithm:  SignatureAlgorithmEd25519,
+			publicKeys: []crypto.PublicKey{edPublicKey},
+			expErr:     assert.Error,
+		},
+		{
+			desc:       "valid EdDSA JWS",
+			algorithm:  SignatureAlgorithmJWS,
+			publicKeys: []crypto.PublicKey{edPublicKey},
+			signature:  signDetachedJWS(t, jose.EdDSA, edPrivateKey, data),
+			expErr:     assert.NoError,
+		},
+		{
+			desc:       "valid ES256 JWS",
+			algorithm:  SignatureAlgorithmJWS,
+			publicKeys: []crypto.PublicKey{edPublicKey, &ecPrivateKey.PublicKey},
+			signature:  signDetachedJWS(t, jose.ES256, ecPrivateKey, data),
+			expErr:     assert.NoError,
+		},
+		{
+			desc:       "JWS of other data",
+			algorithm:  SignatureAlgorithmJWS,
+			publicKeys: []crypto.PublicKey{&ecPrivateKey.PublicKey},
+			signature:  signDetachedJWS(t, jose.ES256, ecPrivateKey, []byte("{}")),
+			expErr:     assert.Error,
+		},
+		{
+			desc:       "JWS of an unknown key",
+			algorithm:  SignatureAlgorithmJWS,
+			publicKeys: []crypto.PublicKey{edPublicKey},
+			signature:  signDetachedJWS(t, jose.ES256, ecPrivateKey, data),
+			expErr:     assert.Error,
+		},
+		{
+			desc:       "JWS with a symmetric algorithm",
+			algorithm:  SignatureAlgorithmJWS,
+			publicKeys: []crypto.PublicKey{edPublicKey},
+			signature:  signDetachedJWS(t, jose.HS256, []byte("secretsecretsecretsecretsecretse"), data),
+			expErr:     assert.Error,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			config := &Signature{Algorithm: test.algorithm}
+			for _, key := range test.publicKeys {
+				config.PublicKeys = append(config.PublicKeys, encodePublicKey(t, key))
+			}
+
+			verifier, err := newSignatureVerifier(config)
+			require.NoError(t, err)
+
+			test.expErr(t, verifier.verify(data, test.signature))
+		})
+	}
+}
+
+func TestNewSignatureVerifier(t *testing.T) {
+	edPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	ecPrivateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc       string
+		config     *Signature
+		expErr     string
+		expKeysLen int
+	}{
+		{
+			desc:   "unsupported algorithm",
+			config: &Signature{Algorithm: "hmac", PublicKeys: []types.FileOrContent{encodePublicKey(t, edPublicKey)}},
+			expErr: `unsupported signature algorithm "hmac"`,
+		},
+		{
+			desc:   "no public key",
+			config: &Signature{Algorithm: SignatureAlgorithmEd25519},
+			expErr: "at least one public key is required",
+		},
+		{
+			desc:   "invalid public key",
+			config: &Signature{Algorithm: SignatureAlgorithmEd25519, PublicKeys: []types.FileOrContent{"foo"}},
+			expErr: "parsing public key 0: no PEM block found",
+		},
+		{
+			desc:   "ECDSA public key with Ed25519 signatures",
+			config: &Signature{Algorithm: SignatureAlgorithmEd25519, PublicKeys: []types.FileOrContent{encodePublicKey(t, &ecPrivateKey.PublicKey)}},
+			expErr: "public key 0 is not an Ed25519 key",
+		},
+		{
+			desc:       "JWS with several public keys",
+			config:     &Signature{Algorithm: "JWS", PublicKeys: []types.FileOrContent{encodePublicKey(t, edPublicKey), encodePublicKey(t, &ecPrivateKey.PublicKey)}},
+			expKeysLen: 2,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			verifier, err := newSignatureVerifier(test.config)
+			if test.expErr != "" {
+				assert.EqualError(t, err, test.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, verifier.keys, test.expKeysLen)
+		})
+	}
+}
+
+func encodePublicKey(t *testing.T, key crypto.PublicKey) types.FileOrContent {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+
+	return types.FileOrContent(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func signDetachedJWS(t *testing.T, algorithm jose.SignatureAlgorithm, key any, data []byte) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: algorithm, Key: key}, nil)
+	require.NoError(t, err)
+
+	jws, err := signer.Sign(data)
+	require.NoError(t, err)
+
+	signature, err := jws.DetachedCompactSerialize()
+	require.NoError(t, err)
+
+	return signature
+}
@@ -45,10 +45,15 @@ type MiddlewareSpecApplyConfiguration struct {
 	// Deprecated: please use IPAllowList instead.
 	IPWhiteList       *dynamic.IPWhiteList              `json:"ipWhiteList,omitempty"`
 	IPAllowList       *dynamic.IPAllowList              `json:"ipAllowList,omitempty"`
+	IPDenyList        *IPDenyListApplyConfiguration     `json:"ipDenyList,omitempty"`
+	GeoIPFilter       *dynamic.GeoIPFilter              `json:"geoIPFilter,omitempty"`
+	CSRF              *CSRFApplyConfiguration           `json:"csrf,omitempty"`
+	HMACSignature     *HMACSignatureApplyConfiguration  `json:"hmacSignature,omitempty"`
 	Headers           *dynamic.Headers                  `json:"headers,omitempty"`
 	EncodedCharacters *dynamic.EncodedCharacters        `json:"encodedCharacters,omitempty"`
 	Errors            *ErrorPageApplyConfiguration      `json:"errors,omitempty"`
 	RateLimit         *RateLimitApplyConfiguration      `json:"rateLimit,omitempty"`
+	Challenge         *ChallengeApplyConfiguration      `json:"challenge,omitempty"`
 	RedirectRegex     *dynamic.RedirectRegex            `json:"redirectRegex,omitempty"`
 	RedirectScheme    *dynamic.RedirectScheme           `json:"redirectScheme,omitempty"`
 	BasicAuth         *BasicAuthApplyConfiguration      `json:"basicAuth,omitempty"`
@@ -56,6 +61,7 @@ type MiddlewareSpecApplyConfiguration struct {
 	ForwardAuth       *ForwardAuthApplyConfiguration    `json:"forwardAuth,omitempty"`
 	InFlightReq       *dynamic.InFlightReq              `json:"inFlightReq,omitempty"`
 	Buffering         *BufferingApplyConfiguration      `json:"buffering,omitempty"`
+	RequestLimits     *dynamic.RequestLimits            `json:"requestLimits,omitempty"`
 	CircuitBreaker    *CircuitBreakerApplyConfiguration `json:"circuitBreaker,omitempty"`
 	Compress          *CompressApplyConfiguration       `json:"compress,omitempty"`
 	PassTLSClientCert *dynamic.PassTLSClientCert        `json:"passTLSClientCert,omitempty"`
@@ -137,6 +143,38 @@ func (b *MiddlewareSpecApplyConfiguration) WithIPAllowList(value dynamic.IPAllow
 	return b
 }
 
+// WithIPDenyList sets the IPDenyList field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPDenyList field is set to the value of the last call.
+func (b *MiddlewareSpecApplyConfiguration) WithIPDenyList(value *IPDenyListApplyConfiguration) *MiddlewareSpecApplyConfiguration {
+	b.IPDenyList = value
+	return b
+}
+
+// WithGeoIPFilter sets the GeoIPFilter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GeoIPFilter field is set to the value of the last call.
+func (b *MiddlewareSpecApplyConfiguration) WithGeoIPFilter(value dynamic.GeoIPFilter) *MiddlewareSpecApplyConfiguration {
+	b.GeoIPFilter = &value
+	return b
+}
+
+// WithCSRF sets the CSRF field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CSRF field is set to the value of the last call.
+func (b *MiddlewareSpecApplyConfiguration) WithCSRF(value *CSRFApplyConfiguration) *MiddlewareSpecApplyConfiguration {
+	b.CSRF = value
+	return b
+}
+
+// WithHMACSignature sets the HMACSignature field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HMACSignature field is set to the value of the last call.
+func (b *MiddlewareSpecApplyConfiguration) WithHMACSignature(value *HMACSignatureApplyConfiguration) *MiddlewareSpecApplyConfiguration {
+	b.HMACSignature = value
+	return b
+}
+
 // WithHeaders sets the Headers field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Headers field is set to the value of the last call.
@@ -169,6 +207,14 @@ func (b *MiddlewareSpecApplyConfiguration) WithRateLimit(value *RateLimitApplyCo
 	return b
 }
 
+// WithChallenge sets the Challenge field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Challenge field is set to the value of the last call.
+func (b *MiddlewareSpecApplyConfiguration) WithChallenge(value *ChallengeApplyConfiguration) *MiddlewareSpecApplyConfiguration {
+	b.Challenge = value
+	return b
+}
+
 // WithRedirectRegex sets the RedirectRegex field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RedirectRegex field is set to the value of the last call.
@@ -225,6 +271,14 @@ func (b *MiddlewareSpecApplyConfiguration) WithBuffering(value *BufferingApplyCo
 	return b
 }
 
+// WithRequestLimits sets the RequestLimits field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestLimits field is set to the value of the last call.
+func (b *MiddlewareSpecApplyConfiguration) WithRequestLimits(value dynamic.RequestLimits) *MiddlewareSpecApplyConfiguration {
+	b.RequestLimits = &value
+	return b
+}
+
 // WithCircuitBreaker sets the CircuitBreaker field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the CircuitBreaker field is set to the value of the last call.
@@ -48,6 +48,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &traefikiov1alpha1.CertificateApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Chain"):
 		return &traefikiov1alpha1.ChainApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Challenge"):
+		return &traefikiov1alpha1.ChallengeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ChallengeThreshold"):
+		return &traefikiov1alpha1.ChallengeThresholdApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CircuitBreaker"):
 		return &traefikiov1alpha1.CircuitBreakerApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ClientAuth"):
@@ -58,6 +62,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &traefikiov1alpha1.ClientTLSWithCAOptionalApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Compress"):
 		return &traefikiov1alpha1.CompressApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CSRF"):
+		return &traefikiov1alpha1.CSRFApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CSRFToken"):
+		return &traefikiov1alpha1.CSRFTokenApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DigestAuth"):
 		return &traefikiov1alpha1.DigestAuthApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ErrorPage"):
@@ -72,6 +80,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &traefikiov1alpha1.ForwardingTimeoutsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HighestRandomWeight"):
 		return &traefikiov1alpha1.HighestRandomWeightApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HMACSignature"):
+		return &traefikiov1alpha1.HMACSignatureApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IngressRoute"):
 		return &traefikiov1alpha1.IngressRouteApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IngressRouteRef"):
@@ -86,6 +96,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &traefikiov1alpha1.IngressRouteUDPApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IngressRouteUDPSpec"):
 		return &traefikiov1alpha1.IngressRouteUDPSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPDenyList"):
+		return &traefikiov1alpha1.IPDenyListApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LoadBalancerSpec"):
 		return &traefikiov1alpha1.LoadBalancerSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Middleware"):
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"os"
 	"slices"
@@ -306,6 +307,30 @@ func (p *Provider) loadConfigurationFromCRD(ctx context.Context, client Client)
 			continue
 		}
 
+		ipDenyList, err := createIPDenyListMiddleware(middleware.Spec.IPDenyList)
+		if err != nil {
+			logger.Error().Err(err).Msg("Error while reading IP denylist middleware")
+			continue
+		}
+
+		csrf, err := createCSRFMiddleware(client, middleware.Namespace, middleware.Spec.CSRF)
+		if err != nil {
+			logger.Error().Err(err).Msg("Error while reading CSRF middleware")
+			continue
+		}
+
+		hmacSignature, err := createHMACSignatureMiddleware(client, middleware.Namespace, middleware.Spec.HMACSignature)
+		if err != nil {
+			logger.Error().Err(err).Msg("Error while reading HMAC signature middleware")
+			continue
+		}
+
+		challenge, err := createChallengeMiddleware(client, middleware.Namespace, middleware.Spec.Challenge)
+		if err != nil {
+			logger.Error().Err(err).Msg("Error while reading challenge middleware")
+			continue
+		}
+
 		conf.HTTP.Middlewares[id] = &dynamic.Middleware{
 			AddPrefix:         middleware.Spec.AddPrefix,
 			StripPrefix:       middleware.Spec.StripPrefix,
@@ -315,10 +340,15 @@ func (p *Provider) loadConfigurationFromCRD(ctx context.Context, client Client)
 			Chain:             chain,
 			IPWhiteList:       middleware.Spec.IPWhiteList,
 			IPAllowList:       middleware.Spec.IPAllowList,
+			IPDenyList:        ipDenyList,
+			GeoIPFilter:       middleware.Spec.GeoIPFilter,
+			CSRF:              csrf,
+			HMACSignature:     hmacSignature,
 			Headers:           middleware.Spec.Headers,
 			EncodedCharacters: middleware.Spec.EncodedCharacters,
 			Errors:            errorPage,
 			RateLimit:         rateLimit,
+			Challenge:         challenge,
 			RedirectRegex:     middleware.Spec.RedirectRegex,
 			RedirectScheme:    middleware.Spec.RedirectScheme,
 			BasicAuth:         basicAuth,
@@ -326,6 +356,7 @@ func (p *Provider) loadConfigurationFromCRD(ctx context.Context, client Client)
 			ForwardAuth:       forwardAuth,
 			InFlightReq:       middleware.Spec.InFlightReq,
 			Buffering:         createBufferingMiddleware(middleware.Spec.Buffering),
+			RequestLimits:     middleware.Spec.RequestLimits,
 			CircuitBreaker:    circuitBreaker,
 			Compress:          createCompressMiddleware(middleware.Spec.Compress),
 			PassTLSClientCert: middleware.Spec.PassTLSClientCert,
@@ -981,6 +1012,192 @@ func createRateLimitMiddleware(client Client, namespace string, rateLimit *traef
 	return rl, nil
 }
 
+func createIPDenyListMiddleware(ipDenyList *traefikv1alpha1.IPDenyList) (*dynamic.IPDenyList, error) {
+	if ipDenyList == nil {
+		return nil, nil
+	}
+
+	denyList := &dynamic.IPDenyList{
+		SourceRange:      ipDenyList.SourceRange,
+		Files:            ipDenyList.Files,
+		URLs:             ipDenyList.URLs,
+		IPStrategy:       ipDenyList.IPStrategy,
+		RejectStatusCode: ipDenyList.RejectStatusCode,
+	}
+
+	if ipDenyList.RefreshInterval != nil {
+		if err := denyList.RefreshInterval.Set(ipDenyList.RefreshInterval.String()); err != nil {
+			return nil, err
+		}
+	}
+
+	return denyList, nil
+}
+
+func createCSRFMiddleware(client Client, namespace string, csrf *traefikv1alpha1.CSRF) (*dynamic.CSRF, error) {
+	if csrf == nil {
+		return nil, nil
+	}
+
+	c := &dynamic.CSRF{
+		AllowedOriginList:      csrf.AllowedOriginList,
+		AllowedOriginListRegex: csrf.AllowedOriginListRegex,
+		AllowSameSite:          csrf.AllowSameSite,
+		ExemptPaths:            csrf.ExemptPaths,
+		RejectStatusCode:       csrf.RejectStatusCode,
+	}
+
+	if csrf.Token != nil {
+		secret, err := loadSecretEntry(client, namespace, csrf.Token.Secret, "secret")
+		if err != nil {
+			return nil, fmt.Errorf("loading token secret: %w", err)
+		}
+
+		c.Token = &dynamic.CSRFToken{
+			Secret:            secret,
+			SessionCookieName: csrf.Token.SessionCookieName,
+			CookieName:        csrf.Token.CookieName,
+			CookieDomain:      csrf.Token.CookieDomain,
+			CookiePath:        csrf.Token.CookiePath,
+			CookieSecure:      csrf.Token.CookieSecure,
+			CookieSameSite:    csrf.Token.CookieSameSite,
+			HeaderName:        csrf.Token.HeaderName,
+			FormFieldName:     csrf.Token.FormFieldName,
+		}
+	}
+
+	return c, nil
+}
+
+func createHMACSignatureMiddleware(client Client, namespace string, hmacSignature *traefikv1alpha1.HMACSignature) (*dynamic.HMACSignature, error) {
+	if hmacSignature == nil {
+		return nil, nil
+	}
+
+	secret, err := loadSecret(client, namespace, hmacSignature.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("loading keys secret: %w", err)
+	}
+
+	h := &dynamic.HMACSignature{
+		Algorithm:        hmacSignature.Algorithm,
+		SignedComponents: hmacSignature.SignedComponents,
+		SignedHeaders:    hmacSignature.SignedHeaders,
+		SignatureHeader:  hmacSignature.SignatureHeader,
+		SignatureParam:   hmacSignature.SignatureParam,
+		KeyIDHeader:      hmacSignature.KeyIDHeader,
+		KeyIDParam:       hmacSignature.KeyIDParam,
+		ExpiresHeader:    hmacSignature.ExpiresHeader,
+		ExpiresParam:     hmacSignature.ExpiresParam,
+		MaxBodyBytes:     hmacSignature.MaxBodyBytes,
+		RejectStatusCode: hmacSignature.RejectStatusCode,
+	}
+	h.SetDefaults()
+
+	for _, id := range slices.Sorted(maps.Keys(secret.Data)) {
+		h.Keys = append(h.Keys, dynamic.HMACKey{ID: id, Secret: string(secret.Data[id])})
+	}
+
+	if hmacSignature.ClockSkew != nil {
+		if err := h.ClockSkew.Set(hmacSignature.ClockSkew.String()); err != nil {
+			return nil, err
+		}
+	}
+
+	if hmacSignature.MaxLifetime != nil {
+		if err := h.MaxLifetime.Set(hmacSignature.MaxLifetime.String()); err != nil {
+			return nil, err
+		}
+	}
+
+	return h, nil
+}
+
+func createChallengeMiddleware(client Client, namespace string, challenge *traefikv1alpha1.Challenge) (*dynamic.Challenge, error) {
+	if challenge == nil {
+		return nil, nil
+	}
+
+	secret, err := loadSecretEntry(client, namespace, challenge.Secret, "secret")
+	if err != nil {
+		return nil, fmt.Errorf("loading challenge secret: %w", err)
+	}
+
+	c := &dynamic.Challenge{}
+	c.SetDefaults()
+
+	c.Secret = secret
+	c.CookieName = challenge.CookieName
+	c.CookieDomain = challenge.CookieDomain
+	c.CookieSecure = challenge.CookieSecure
+	c.SourceCriterion = challenge.SourceCriterion
+	c.Difficulty = challenge.Difficulty
+
+	if challenge.ClearanceTTL != nil {
+		if err := c.ClearanceTTL.Set(challenge.ClearanceTTL.String()); err != nil {
+			return nil, err
+		}
+	}
+
+	if challenge.Threshold != nil {
+		c.Threshold = &dynamic.ChallengeThreshold{}
+		c.Threshold.SetDefaults()
+
+		if challenge.Threshold.Average != nil {
+			c.Threshold.Average = *challenge.Threshold.Average
+		}
+
+		if challenge.Threshold.Burst != nil {
+			c.Threshold.Burst = *challenge.Threshold.Burst
+		}
+
+		if challenge.Threshold.Period != nil {
+			if err := c.Threshold.Period.Set(challenge.Threshold.Period.String()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// loadSecret returns the referenced Kubernetes Secret.
+func loadSecret(k8sClient Client, namespace, secretName string) (*corev1.Secret, error) {
+	if secretName == "" {
+		return nil, errors.New("secret must be set")
+	}
+
+	secret, exists, err := k8sClient.GetSecret(namespace, secretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch secret '%s/%s': %w", namespace, secretName, err)
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("secret '%s/%s' not found", namespace, secretName)
+	}
+
+	if secret == nil {
+		return nil, fmt.Errorf("data for secret '%s/%s' must not be nil", namespace, secretName)
+	}
+
+	return secret, nil
+}
+
+// loadSecretEntry returns the value of the given entry of the referenced Kubernetes Secret.
+func loadSecretEntry(k8sClient Client, namespace, secretName, key string) (string, error) {
+	secret, err := loadSecret(k8sClient, namespace, secretName)
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("secret '%s/%s' must contain the %s key", namespace, secretName, key)
+	}
+
+	return string(value), nil
+}
+
 func loadRedisCredentials(namespace, secretName string, k8sClient Client) (string, string, error) {
 	secret, exists, err := k8sClient.GetSecret(namespace, secretName)
 	if err != nil {
@@ -2114,6 +2114,84 @@ func TestLoadIngressRoutes(t *testing.T) {
 				TLS: &dynamic.TLSConfiguration{},
 			},
 		},
+		{
+			desc:  "Security middlewares",
+			paths: []string{"with_security_middlewares.yml"},
+			expected: &dynamic.Configuration{
+				UDP: &dynamic.UDPConfiguration{
+					Routers:  map[string]*dynamic.UDPRouter{},
+					Services: map[string]*dynamic.UDPService{},
+				},
+				TCP: &dynamic.TCPConfiguration{
+					Routers:           map[string]*dynamic.TCPRouter{},
+					Middlewares:       map[string]*dynamic.TCPMiddleware{},
+					Services:          map[string]*dynamic.TCPService{},
+					ServersTransports: map[string]*dynamic.TCPServersTransport{},
+				},
+				HTTP: &dynamic.HTTPConfiguration{
+					Routers: map[string]*dynamic.Router{},
+					Middlewares: map[string]*dynamic.Middleware{
+						"default-ipdenylist": {
+							IPDenyList: &dynamic.IPDenyList{
+								SourceRange:      []string{"192.168.1.0/24"},
+								URLs:             []string{"https://example.com/denylist.txt"},
+								RefreshInterval:  ptypes.Duration(10 * time.Minute),
+								RejectStatusCode: 404,
+							},
+						},
+						"default-geoipfilter": {
+							GeoIPFilter: &dynamic.GeoIPFilter{
+								AllowedCountries: []string{"FR"},
+								DeniedASNs:       []uint64{64512},
+							},
+						},
+						"default-csrf": {
+							CSRF: &dynamic.CSRF{
+								AllowedOriginList: []string{"https://app.example.com"},
+								Token: &dynamic.CSRFToken{
+									Secret:            "csrf",
+									SessionCookieName: "session",
+									CookieSecure:      true,
+								},
+							},
+						},
+						"default-hmacsignature": {
+							HMACSignature: &dynamic.HMACSignature{
+								Keys: []dynamic.HMACKey{
+									{ID: "key1", Secret: "first"},
+									{ID: "key2", Secret: "second"},
+								},
+								Algorithm:   "sha512",
+								ClockSkew:   ptypes.Duration(30 * time.Second),
+								MaxLifetime: ptypes.Duration(5 * time.Minute),
+							},
+						},
+						"default-challenge": {
+							Challenge: &dynamic.Challenge{
+								Secret:       "challenge",
+								Difficulty:   new(0),
+								ClearanceTTL: ptypes.Duration(30 * time.Minute),
+								Threshold: &dynamic.ChallengeThreshold{
+									Average: 10,
+									Burst:   1,
+									Period:  ptypes.Duration(time.Second),
+								},
+							},
+						},
+						"default-requestlimits": {
+							RequestLimits: &dynamic.RequestLimits{
+								MaxHeaderCount: 50,
+								MaxJSONDepth:   16,
+								BodyStatusCode: 400,
+							},
+						},
+					},
+					Services:          map[string]*dynamic.Service{},
+					ServersTransports: map[string]*dynamic.ServersTransport{},
+				},
+				TLS: &dynamic.TLSConfiguration{},
+			},
+		},
 		{
 			desc:                "Middlewares in ingress route config are normalized",
 			allowCrossNamespace: true,
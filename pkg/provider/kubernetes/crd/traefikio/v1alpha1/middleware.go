@@ -35,10 +35,15 @@ type MiddlewareSpec struct {
 	// Deprecated: please use IPAllowList instead.
 	IPWhiteList       *dynamic.IPWhiteList       `json:"ipWhiteList,omitempty"`
 	IPAllowList       *dynamic.IPAllowList       `json:"ipAllowList,omitempty"`
+	IPDenyList        *IPDenyList                `json:"ipDenyList,omitempty"`
+	GeoIPFilter       *dynamic.GeoIPFilter       `json:"geoIPFilter,omitempty"`
+	CSRF              *CSRF                      `json:"csrf,omitempty"`
+	HMACSignature     *HMACSignature             `json:"hmacSignature,omitempty"`
 	Headers           *dynamic.Headers           `json:"headers,omitempty"`
 	EncodedCharacters *dynamic.EncodedCharacters `json:"encodedCharacters,omitempty"`
 	Errors            *ErrorPage                 `json:"errors,omitempty"`
 	RateLimit         *RateLimit                 `json:"rateLimit,omitempty"`
+	Challenge         *Challenge                 `json:"challenge,omitempty"`
 	RedirectRegex     *dynamic.RedirectRegex     `json:"redirectRegex,omitempty"`
 	RedirectScheme    *dynamic.RedirectScheme    `json:"redirectScheme,omitempty"`
 	BasicAuth         *BasicAuth                 `json:"basicAuth,omitempty"`
@@ -46,6 +51,7 @@ type MiddlewareSpec struct {
 	ForwardAuth       *ForwardAuth               `json:"forwardAuth,omitempty"`
 	InFlightReq       *dynamic.InFlightReq       `json:"inFlightReq,omitempty"`
 	Buffering         *Buffering                 `json:"buffering,omitempty"`
+	RequestLimits     *dynamic.RequestLimits     `json:"requestLimits,omitempty"`
 	CircuitBreaker    *CircuitBreaker            `json:"circuitBreaker,omitempty"`
 	Compress          *Compress                  `json:"compress,omitempty"`
 	PassTLSClientCert *dynamic.PassTLSClientCert `json:"passTLSClientCert,omitempty"`
@@ -166,6 +172,196 @@ type BasicAuth struct {
 
 // +k8s:deepcopy-gen=true
 
+// IPDenyList holds the IP denylist middleware configuration.
+// This middleware rejects requests based on the IP of the client,
+// loading the denied IPs from static ranges, files and URLs.
+// More info: https://doc.traefik.io/traefik/v3.7/reference/routing-configuration/http/middlewares/ipdenylist/
+type IPDenyList struct {
+	// SourceRange defines the set of denied IPs (or ranges of denied IPs by using CIDR notation).
+	SourceRange []string `json:"sourceRange,omitempty"`
+	// Files defines the paths of local files listing denied IPs (or ranges of denied IPs by using CIDR notation), one per line.
+	Files []string `json:"files,omitempty"`
+	// URLs defines the HTTP(S) URLs of lists of denied IPs (or ranges of denied IPs by using CIDR notation), one per line.
+	URLs []string `json:"urls,omitempty"`
+	// RefreshInterval defines how often the files and URLs are reloaded.
+	// If not set, the default is 1h.
+	// +kubebuilder:validation:Pattern="^([0-9]+(ns|us|µs|ms|s|m|h)?)+$"
+	// +kubebuilder:validation:XIntOrString
+	RefreshInterval *intstr.IntOrString `json:"refreshInterval,omitempty"`
+	// IPStrategy holds the IP strategy configuration used by Traefik to determine the client IP.
+	IPStrategy *dynamic.IPStrategy `json:"ipStrategy,omitempty"`
+	// RejectStatusCode defines the HTTP status code used for refused requests.
+	// If not set, the default is 403 (Forbidden).
+	RejectStatusCode int `json:"rejectStatusCode,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// CSRF holds the CSRF middleware configuration.
+// This middleware refuses the state-changing requests sent from other sites.
+// More info: https://doc.traefik.io/traefik/v3.7/reference/routing-configuration/http/middlewares/csrf/
+type CSRF struct {
+	// AllowedOriginList defines the origins (scheme://host[:port]) allowed to send state-changing requests,
+	// in addition to the origin of the request itself.
+	AllowedOriginList []string `json:"allowedOriginList,omitempty"`
+	// AllowedOriginListRegex defines the regular expressions of the origins allowed to send state-changing requests.
+	AllowedOriginListRegex []string `json:"allowedOriginListRegex,omitempty"`
+	// AllowSameSite defines whether the requests sent from the same site, but from another origin, are allowed.
+	AllowSameSite bool `json:"allowSameSite,omitempty"`
+	// ExemptPaths defines the request paths which are not protected.
+	// A path ending with "*" matches all the paths starting with the given prefix.
+	ExemptPaths []string `json:"exemptPaths,omitempty"`
+	// Token defines the signed double-submit cookie token check.
+	// If not set, the token is not checked.
+	Token *CSRFToken `json:"token,omitempty"`
+	// RejectStatusCode defines the HTTP status code used for refused requests.
+	// If not set, the default is 403 (Forbidden).
+	RejectStatusCode int `json:"rejectStatusCode,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// CSRFToken holds the signed double-submit cookie token configuration.
+type CSRFToken struct {
+	// Secret is the name of the referenced Kubernetes Secret containing the key used to sign the tokens, in its secret entry.
+	Secret string `json:"secret,omitempty"`
+	// SessionCookieName defines the name of the cookie holding the session identifier the tokens are bound to.
+	SessionCookieName string `json:"sessionCookieName,omitempty"`
+	// CookieName defines the name of the cookie holding the token.
+	// If not set, the default is _csrf.
+	CookieName string `json:"cookieName,omitempty"`
+	// CookieDomain defines the host to which the cookie will be sent.
+	CookieDomain string `json:"cookieDomain,omitempty"`
+	// CookiePath defines the path that must exist in the requested URL for the browser to send the cookie.
+	// If not set, the default is /.
+	CookiePath string `json:"cookiePath,omitempty"`
+	// CookieSecure defines whether the cookie can only be transmitted over an encrypted connection (i.e. HTTPS).
+	CookieSecure bool `json:"cookieSecure,omitempty"`
+	// CookieSameSite defines the same site policy of the cookie.
+	// If not set, the default is lax.
+	// +kubebuilder:validation:Enum=none;lax;strict;None;Lax;Strict
+	CookieSameSite string `json:"cookieSameSite,omitempty"`
+	// HeaderName defines the name of the request header holding the token.
+	// If not set, the default is X-CSRF-Token.
+	HeaderName string `json:"headerName,omitempty"`
+	// FormFieldName defines the name of the form field holding the token, used when the header is not set.
+	// If not set, the token is only read from the header.
+	FormFieldName string `json:"formFieldName,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// HMACSignature holds the HMAC signature middleware configuration.
+// This middleware verifies the HMAC signature of the requests.
+// More info: https://doc.traefik.io/traefik/v3.7/reference/routing-configuration/http/middlewares/hmacsignature/
+type HMACSignature struct {
+	// Secret is the name of the referenced Kubernetes Secret containing the keys used to verify the signatures.
+	// Each entry of the Secret is a key, with the key ID as name and the shared secret as value.
+	Secret string `json:"secret,omitempty"`
+	// Algorithm defines the hash algorithm of the HMAC, among sha256, sha384 and sha512.
+	// If not set, the default is sha256.
+	// +kubebuilder:validation:Enum=sha256;sha384;sha512
+	Algorithm string `json:"algorithm,omitempty"`
+	// SignedComponents defines the components of the request included in the signed string, among method, path, query and body.
+	// If not set, the default is method, path and query.
+	SignedComponents []string `json:"signedComponents,omitempty"`
+	// SignedHeaders defines the request headers included in the signed string.
+	SignedHeaders []string `json:"signedHeaders,omitempty"`
+	// SignatureHeader defines the name of the request header holding the signature.
+	// If not set, the default is X-Signature.
+	SignatureHeader string `json:"signatureHeader,omitempty"`
+	// SignatureParam defines the name of the query parameter holding the signature, used when the header is not set.
+	// If not set, the default is signature.
+	SignatureParam string `json:"signatureParam,omitempty"`
+	// KeyIDHeader defines the name of the request header holding the ID of the key used to sign the request.
+	// If not set, the default is X-Signature-Key-Id.
+	KeyIDHeader string `json:"keyIdHeader,omitempty"`
+	// KeyIDParam defines the name of the query parameter holding the ID of the key used to sign the request, used when the header is not set.
+	// If not set, the default is keyId.
+	KeyIDParam string `json:"keyIdParam,omitempty"`
+	// ExpiresHeader defines the name of the request header holding the expiration time of the signature, as a Unix timestamp.
+	// If not set, the default is X-Signature-Expires.
+	ExpiresHeader string `json:"expiresHeader,omitempty"`
+	// ExpiresParam defines the name of the query parameter holding the expiration time of the signature, used when the header is not set.
+	// If not set, the default is expires.
+	ExpiresParam string `json:"expiresParam,omitempty"`
+	// ClockSkew defines the tolerated difference between the clocks of the signer and of Traefik.
+	// Default: 30s.
+	// +kubebuilder:validation:Pattern="^([0-9]+(ns|us|µs|ms|s|m|h)?)+$"
+	// +kubebuilder:validation:XIntOrString
+	ClockSkew *intstr.IntOrString `json:"clockSkew,omitempty"`
+	// MaxLifetime defines the maximum duration between now and the expiration time of a signature.
+	// Default: 0 (no maximum).
+	// +kubebuilder:validation:Pattern="^([0-9]+(ns|us|µs|ms|s|m|h)?)+$"
+	// +kubebuilder:validation:XIntOrString
+	MaxLifetime *intstr.IntOrString `json:"maxLifetime,omitempty"`
+	// MaxBodyBytes defines the maximum size of the signed request body (in bytes).
+	// Default: 10485760 (10Mi).
+	// +kubebuilder:validation:Minimum=0
+	MaxBodyBytes int64 `json:"maxBodyBytes,omitempty"`
+	// RejectStatusCode defines the HTTP status code used for refused requests.
+	// If not set, the default is 401 (Unauthorized).
+	RejectStatusCode int `json:"rejectStatusCode,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// Challenge holds the challenge middleware configuration.
+// This middleware serves a proof-of-work challenge page to the clients without a valid clearance cookie,
+// and issues a signed clearance cookie to the clients solving it.
+// More info: https://doc.traefik.io/traefik/v3.7/reference/routing-configuration/http/middlewares/challenge/
+type Challenge struct {
+	// Secret is the name of the referenced Kubernetes Secret containing the key used to sign the challenges and the clearance cookies,
+	// in its secret entry.
+	Secret string `json:"secret,omitempty"`
+	// Difficulty defines the number of leading zero bits required in the SHA-256 hash of a challenge solution.
+	// Each additional bit doubles the average work of the clients. 0 serves a JavaScript challenge without proof of work.
+	// Default: 16.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32
+	Difficulty *int `json:"difficulty,omitempty"`
+	// ClearanceTTL defines the duration for which a clearance cookie is valid.
+	// Default: 1h.
+	// +kubebuilder:validation:Pattern="^([0-9]+(ns|us|µs|ms|s|m|h)?)+$"
+	// +kubebuilder:validation:XIntOrString
+	ClearanceTTL *intstr.IntOrString `json:"clearanceTTL,omitempty"`
+	// CookieName defines the name of the clearance cookie.
+	// If not set, the default is _traefik_clearance.
+	CookieName string `json:"cookieName,omitempty"`
+	// CookieDomain defines the host to which the clearance cookie will be sent.
+	CookieDomain string `json:"cookieDomain,omitempty"`
+	// CookieSecure defines whether the clearance cookie can only be transmitted over an encrypted connection (i.e. HTTPS).
+	CookieSecure bool `json:"cookieSecure,omitempty"`
+	// SourceCriterion defines what criterion is used to group requests as originating from a common source.
+	// The clearance cookies are only valid for the source they were issued to.
+	// If several strategies are defined at the same time, an error will be raised.
+	// If none are set, the default is to use the request's remote address field (as an ipStrategy).
+	SourceCriterion *dynamic.SourceCriterion `json:"sourceCriterion,omitempty"`
+	// Threshold defines the rate above which the requests of a source are challenged.
+	// If not set, the requests without a valid clearance cookie are always challenged.
+	Threshold *ChallengeThreshold `json:"threshold,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// ChallengeThreshold holds the rate above which the requests of a source are challenged.
+type ChallengeThreshold struct {
+	// Average is the maximum rate, by default in requests/s, of the requests of a source served without a challenge.
+	// The rate is actually defined by dividing Average by Period.
+	// +kubebuilder:validation:Minimum=0
+	Average *int64 `json:"average,omitempty"`
+	// Period, in combination with Average, defines the actual maximum rate, such as:
+	// r = Average / Period. It defaults to a second.
+	// +kubebuilder:validation:XIntOrString
+	Period *intstr.IntOrString `json:"period,omitempty"`
+	// Burst is the maximum number of requests of a source served without a challenge in the same arbitrarily small period of time.
+	// It defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	Burst *int64 `json:"burst,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
 // DigestAuth holds the digest auth middleware configuration.
 // This middleware restricts access to your services to known users.
 // More info: https://doc.traefik.io/traefik/v3.7/reference/routing-configuration/http/middlewares/digestauth/
@@ -70,6 +70,58 @@ func (in *Buffering) DeepCopy() *Buffering {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSRF) DeepCopyInto(out *CSRF) {
+	*out = *in
+	if in.AllowedOriginList != nil {
+		in, out := &in.AllowedOriginList, &out.AllowedOriginList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedOriginListRegex != nil {
+		in, out := &in.AllowedOriginListRegex, &out.AllowedOriginListRegex
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExemptPaths != nil {
+		in, out := &in.ExemptPaths, &out.ExemptPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(CSRFToken)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRF.
+func (in *CSRF) DeepCopy() *CSRF {
+	if in == nil {
+		return nil
+	}
+	out := new(CSRF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSRFToken) DeepCopyInto(out *CSRFToken) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRFToken.
+func (in *CSRFToken) DeepCopy() *CSRFToken {
+	if in == nil {
+		return nil
+	}
+	out := new(CSRFToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Certificate) DeepCopyInto(out *Certificate) {
 	*out = *in
@@ -107,6 +159,73 @@ func (in *Chain) DeepCopy() *Chain {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Challenge) DeepCopyInto(out *Challenge) {
+	*out = *in
+	if in.Difficulty != nil {
+		in, out := &in.Difficulty, &out.Difficulty
+		*out = new(int)
+		**out = **in
+	}
+	if in.ClearanceTTL != nil {
+		in, out := &in.ClearanceTTL, &out.ClearanceTTL
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.SourceCriterion != nil {
+		in, out := &in.SourceCriterion, &out.SourceCriterion
+		*out = new(dynamic.SourceCriterion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(ChallengeThreshold)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Challenge.
+func (in *Challenge) DeepCopy() *Challenge {
+	if in == nil {
+		return nil
+	}
+	out := new(Challenge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChallengeThreshold) DeepCopyInto(out *ChallengeThreshold) {
+	*out = *in
+	if in.Average != nil {
+		in, out := &in.Average, &out.Average
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChallengeThreshold.
+func (in *ChallengeThreshold) DeepCopy() *ChallengeThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(ChallengeThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
 	*out = *in
@@ -425,6 +544,42 @@ func (in *ForwardingTimeouts) DeepCopy() *ForwardingTimeouts {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACSignature) DeepCopyInto(out *HMACSignature) {
+	*out = *in
+	if in.SignedComponents != nil {
+		in, out := &in.SignedComponents, &out.SignedComponents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SignedHeaders != nil {
+		in, out := &in.SignedHeaders, &out.SignedHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClockSkew != nil {
+		in, out := &in.ClockSkew, &out.ClockSkew
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxLifetime != nil {
+		in, out := &in.MaxLifetime, &out.MaxLifetime
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACSignature.
+func (in *HMACSignature) DeepCopy() *HMACSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HighestRandomWeight) DeepCopyInto(out *HighestRandomWeight) {
 	*out = *in
@@ -448,6 +603,47 @@ func (in *HighestRandomWeight) DeepCopy() *HighestRandomWeight {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPDenyList) DeepCopyInto(out *IPDenyList) {
+	*out = *in
+	if in.SourceRange != nil {
+		in, out := &in.SourceRange, &out.SourceRange
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.URLs != nil {
+		in, out := &in.URLs, &out.URLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.IPStrategy != nil {
+		in, out := &in.IPStrategy, &out.IPStrategy
+		*out = new(dynamic.IPStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPDenyList.
+func (in *IPDenyList) DeepCopy() *IPDenyList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPDenyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressRoute) DeepCopyInto(out *IngressRoute) {
 	*out = *in
@@ -934,6 +1130,26 @@ func (in *MiddlewareSpec) DeepCopyInto(out *MiddlewareSpec) {
 		*out = new(dynamic.IPAllowList)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IPDenyList != nil {
+		in, out := &in.IPDenyList, &out.IPDenyList
+		*out = new(IPDenyList)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GeoIPFilter != nil {
+		in, out := &in.GeoIPFilter, &out.GeoIPFilter
+		*out = new(dynamic.GeoIPFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CSRF != nil {
+		in, out := &in.CSRF, &out.CSRF
+		*out = new(CSRF)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HMACSignature != nil {
+		in, out := &in.HMACSignature, &out.HMACSignature
+		*out = new(HMACSignature)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Headers != nil {
 		in, out := &in.Headers, &out.Headers
 		*out = new(dynamic.Headers)
@@ -954,6 +1170,11 @@ func (in *MiddlewareSpec) DeepCopyInto(out *MiddlewareSpec) {
 		*out = new(RateLimit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Challenge != nil {
+		in, out := &in.Challenge, &out.Challenge
+		*out = new(Challenge)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RedirectRegex != nil {
 		in, out := &in.RedirectRegex, &out.RedirectRegex
 		*out = new(dynamic.RedirectRegex)
@@ -989,6 +1210,11 @@ func (in *MiddlewareSpec) DeepCopyInto(out *MiddlewareSpec) {
 		*out = new(Buffering)
 		**out = **in
 	}
+	if in.RequestLimits != nil {
+		in, out := &in.RequestLimits, &out.RequestLimits
+		*out = new(dynamic.RequestLimits)
+		**out = **in
+	}
 	if in.CircuitBreaker != nil {
 		in, out := &in.CircuitBreaker, &out.CircuitBreaker
 		*out = new(CircuitBreaker)
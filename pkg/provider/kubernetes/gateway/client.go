@@ -26,6 +26,7 @@ import (
 	gatev1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatev1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gatev1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gatexv1alpha1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
 	gateclientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
 	gateinformers "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
 )
@@ -211,6 +212,21 @@ func (c *clientWrapper) WatchAll(namespaces []string, stopCh <-chan struct{}) (<
 			if err != nil {
 				return nil, err
 			}
+
+			_, err = factoryGateway.Gateway().V1alpha2().UDPRoutes().Informer().AddEventHandler(eventHandler)
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = factoryGateway.Gateway().V1().ListenerSets().Informer().AddEventHandler(eventHandler)
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = factoryGateway.Experimental().V1alpha1().XBackendTrafficPolicies().Informer().AddEventHandler(eventHandler)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		factorySecret := kinformers.NewSharedInformerFactoryWithOptions(c.csKube, resyncPeriod, kinformers.WithNamespace(ns), kinformers.WithTweakListOptions(notOwnedByHelm), kinformers.WithTransform(k8s.StripManagedFields))
@@ -327,6 +343,20 @@ func (c *clientWrapper) ListTCPRoutes() ([]*gatev1alpha2.TCPRoute, error) {
 	return tcpRoutes, nil
 }
 
+func (c *clientWrapper) ListUDPRoutes() ([]*gatev1alpha2.UDPRoute, error) {
+	var udpRoutes []*gatev1alpha2.UDPRoute
+	for _, namespace := range c.watchedNamespaces {
+		routes, err := c.factoriesGateway[c.lookupNamespace(namespace)].Gateway().V1alpha2().UDPRoutes().Lister().UDPRoutes(namespace).List(labels.Everything())
+		if err != nil {
+			return nil, fmt.Errorf("listing UDP routes in namespace %s", namespace)
+		}
+
+		udpRoutes = append(udpRoutes, routes...)
+	}
+
+	return udpRoutes, nil
+}
+
 func (c *clientWrapper) ListTLSRoutes() ([]*gatev1.TLSRoute, error) {
 	var tlsRoutes []*gatev1.TLSRoute
 	for _, namespace := range c.watchedNamespaces {
@@ -341,6 +371,20 @@ func (c *clientWrapper) ListTLSRoutes() ([]*gatev1.TLSRoute, error) {
 	return tlsRoutes, nil
 }
 
+func (c *clientWrapper) ListListenerSets() ([]*gatev1.ListenerSet, error) {
+	var listenerSets []*gatev1.ListenerSet
+	for _, namespace := range c.watchedNamespaces {
+		sets, err := c.factoriesGateway[c.lookupNamespace(namespace)].Gateway().V1().ListenerSets().Lister().ListenerSets(namespace).List(labels.Everything())
+		if err != nil {
+			return nil, fmt.Errorf("listing ListenerSets in namespace %s", namespace)
+		}
+
+		listenerSets = append(listenerSets, sets...)
+	}
+
+	return listenerSets, nil
+}
+
 func (c *clientWrapper) ListReferenceGrants(namespace string) ([]*gatev1beta1.ReferenceGrant, error) {
 	if !c.isWatchedNamespace(namespace) {
 		return nil, fmt.Errorf("failed to get ReferenceGrants: namespace %s is not within watched namespaces", namespace)
@@ -412,6 +456,33 @@ func (c *clientWrapper) ListBackendTLSPoliciesForService(namespace, serviceName
 	return servicePolicies, nil
 }
 
+// ListBackendTrafficPoliciesForService returns the XBackendTrafficPolicy for the given service name in the given namespace.
+func (c *clientWrapper) ListBackendTrafficPoliciesForService(namespace, serviceName string) ([]*gatexv1alpha1.XBackendTrafficPolicy, error) {
+	if !c.isWatchedNamespace(namespace) {
+		return nil, fmt.Errorf("failed to get XBackendTrafficPolicies for service %s/%s: namespace is not within watched namespaces", namespace, serviceName)
+	}
+
+	policies, err := c.factoriesGateway[c.lookupNamespace(namespace)].Experimental().V1alpha1().XBackendTrafficPolicies().Lister().XBackendTrafficPolicies(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list XBackendTrafficPolicies in namespace %s", namespace)
+	}
+
+	var servicePolicies []*gatexv1alpha1.XBackendTrafficPolicy
+	for _, policy := range policies {
+		for _, ref := range policy.Spec.TargetRefs {
+			// The policy does not target the service.
+			if (ref.Group != "" && ref.Group != groupCore) || ref.Kind != kindService || string(ref.Name) != serviceName {
+				continue
+			}
+
+			servicePolicies = append(servicePolicies, policy)
+			break
+		}
+	}
+
+	return servicePolicies, nil
+}
+
 // GetService returns the named service from the given namespace.
 func (c *clientWrapper) GetService(namespace, name string) (*corev1.Service, error) {
 	if !c.isWatchedNamespace(namespace) {
@@ -502,6 +573,41 @@ func (c *clientWrapper) UpdateGatewayStatus(ctx context.Context, gateway ktypes.
 	return nil
 }
 
+func (c *clientWrapper) UpdateListenerSetStatus(ctx context.Context, listenerSet ktypes.NamespacedName, status gatev1.ListenerSetStatus) error {
+	if !c.isWatchedNamespace(listenerSet.Namespace) {
+		return fmt.Errorf("cannot update ListenerSet status %s/%s: namespace is not within watched namespaces", listenerSet.Namespace, listenerSet.Name)
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		currentListenerSet, err := c.factoriesGateway[c.lookupNamespace(listenerSet.Namespace)].Gateway().V1().ListenerSets().Lister().ListenerSets(listenerSet.Namespace).Get(listenerSet.Name)
+		if err != nil {
+			// We have to return err itself here (not wrapped inside another error)
+			// so that RetryOnConflict can identify it correctly.
+			return err
+		}
+
+		if listenerSetStatusEqual(currentListenerSet.Status, status) {
+			return nil
+		}
+
+		currentListenerSet = currentListenerSet.DeepCopy()
+		currentListenerSet.Status = status
+
+		if _, err = c.csGateway.GatewayV1().ListenerSets(listenerSet.Namespace).UpdateStatus(ctx, currentListenerSet, metav1.UpdateOptions{}); err != nil {
+			// We have to return err itself here (not wrapped inside another error)
+			// so that RetryOnConflict can identify it correctly.
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update ListenerSet %s/%s status: %w", listenerSet.Namespace, listenerSet.Name, err)
+	}
+
+	return nil
+}
+
 func (c *clientWrapper) UpdateHTTPRouteStatus(ctx context.Context, route ktypes.NamespacedName, gateways []gatewayWithListeners, status gatev1.HTTPRouteStatus) error {
 	if !c.isWatchedNamespace(route.Namespace) {
 		return fmt.Errorf("updating HTTPRoute status %s/%s: namespace is not within watched namespaces", route.Namespace, route.Name)
@@ -628,6 +734,48 @@ func (c *clientWrapper) UpdateTCPRouteStatus(ctx context.Context, route ktypes.N
 	return nil
 }
 
+func (c *clientWrapper) UpdateUDPRouteStatus(ctx context.Context, route ktypes.NamespacedName, gateways []gatewayWithListeners, status gatev1alpha2.UDPRouteStatus) error {
+	if !c.isWatchedNamespace(route.Namespace) {
+		return fmt.Errorf("updating UDPRoute status %s/%s: namespace is not within watched namespaces", route.Namespace, route.Name)
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		currentRoute, err := c.factoriesGateway[c.lookupNamespace(route.Namespace)].Gateway().V1alpha2().UDPRoutes().Lister().UDPRoutes(route.Namespace).Get(route.Name)
+		if err != nil {
+			// We have to return err itself here (not wrapped inside another error)
+			// so that RetryOnConflict can identify it correctly.
+			return err
+		}
+
+		parentStatuses := mergeRouteParentStatuses(route.Namespace, currentRoute.Status.Parents, status.Parents, gateways)
+
+		// do not update status when nothing has changed.
+		if routeParentStatusesEqual(currentRoute.Status.Parents, parentStatuses) {
+			return nil
+		}
+
+		currentRoute = currentRoute.DeepCopy()
+		currentRoute.Status = gatev1alpha2.UDPRouteStatus{
+			RouteStatus: gatev1.RouteStatus{
+				Parents: parentStatuses,
+			},
+		}
+
+		if _, err = c.csGateway.GatewayV1alpha2().UDPRoutes(route.Namespace).UpdateStatus(ctx, currentRoute, metav1.UpdateOptions{}); err != nil {
+			// We have to return err itself here (not wrapped inside another error)
+			// so that RetryOnConflict can identify it correctly.
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update UDPRoute %s/%s status: %w", route.Namespace, route.Name, err)
+	}
+
+	return nil
+}
+
 func (c *clientWrapper) UpdateTLSRouteStatus(ctx context.Context, route ktypes.NamespacedName, gateways []gatewayWithListeners, status gatev1.TLSRouteStatus) error {
 	if !c.isWatchedNamespace(route.Namespace) {
 		return fmt.Errorf("updating TLSRoute status %s/%s: namespace is not within watched namespaces", route.Namespace, route.Name)
@@ -723,6 +871,58 @@ func (c *clientWrapper) UpdateBackendTLSPolicyStatus(ctx context.Context, policy
 	return nil
 }
 
+func (c *clientWrapper) UpdateBackendTrafficPolicyStatus(ctx context.Context, policy ktypes.NamespacedName, status gatev1.PolicyStatus) error {
+	if !c.isWatchedNamespace(policy.Namespace) {
+		return fmt.Errorf("updating XBackendTrafficPolicy status %s/%s: namespace is not within watched namespaces", policy.Namespace, policy.Name)
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		currentPolicy, err := c.factoriesGateway[c.lookupNamespace(policy.Namespace)].Experimental().V1alpha1().XBackendTrafficPolicies().Lister().XBackendTrafficPolicies(policy.Namespace).Get(policy.Name)
+		if err != nil {
+			// We have to return err itself here (not wrapped inside another error)
+			// so that RetryOnConflict can identify it correctly.
+			return err
+		}
+
+		ancestorStatuses := make([]gatev1.PolicyAncestorStatus, len(status.Ancestors))
+		copy(ancestorStatuses, status.Ancestors)
+
+		for _, ancestorStatus := range currentPolicy.Status.Ancestors {
+			// Keep statuses added by other gateway controllers.
+			if ancestorStatus.ControllerName != controllerName {
+				ancestorStatuses = append(ancestorStatuses, ancestorStatus)
+			}
+		}
+
+		if len(ancestorStatuses) > 16 {
+			return fmt.Errorf("failed to update XBackendTrafficPolicy %s/%s status: PolicyAncestor statuses count exceeds 16", policy.Namespace, policy.Name)
+		}
+
+		// Do not update status when nothing has changed.
+		if policyAncestorStatusesEqual(currentPolicy.Status.Ancestors, ancestorStatuses) {
+			return nil
+		}
+
+		currentPolicy = currentPolicy.DeepCopy()
+		currentPolicy.Status = gatev1.PolicyStatus{
+			Ancestors: ancestorStatuses,
+		}
+
+		if _, err = c.csGateway.ExperimentalV1alpha1().XBackendTrafficPolicies(policy.Namespace).UpdateStatus(ctx, currentPolicy, metav1.UpdateOptions{}); err != nil {
+			// We have to return err itself here (not wrapped inside another error)
+			// so that RetryOnConflict can identify it correctly.
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update XBackendTrafficPolicy %s/%s status: %w", policy.Namespace, policy.Name, err)
+	}
+
+	return nil
+}
+
 // lookupNamespace returns the lookup namespace listenerKey for the given namespace.
 // When listening on all namespaces, it returns the client-go identifier ("")
 // for all-namespaces. Otherwise, it returns the given namespace.
@@ -763,8 +963,9 @@ func mergeRouteParentStatuses(routeNamespace string, currentParents, desiredPare
 		// We consider a status managed by the current instance when the parentRef targets one of the managed gateways.
 		// SectionName or Port is not used.
 		parentNamespace := string(ptr.Deref(currentParent.ParentRef.Namespace, gatev1.Namespace(routeNamespace)))
+		parentKind := string(ptr.Deref(currentParent.ParentRef.Kind, kindGateway))
 		isManaged := slices.ContainsFunc(gateways, func(gw gatewayWithListeners) bool {
-			return gw.Namespace == parentNamespace && gw.Name == string(currentParent.ParentRef.Name)
+			return gw.kind() == parentKind && gw.Namespace == parentNamespace && gw.Name == string(currentParent.ParentRef.Name)
 		})
 
 		if !isManaged {
@@ -777,10 +978,19 @@ func mergeRouteParentStatuses(routeNamespace string, currentParents, desiredPare
 
 func gatewayStatusEqual(statusA, statusB gatev1.GatewayStatus) bool {
 	return reflect.DeepEqual(statusA.Addresses, statusB.Addresses) &&
+		reflect.DeepEqual(statusA.AttachedListenerSets, statusB.AttachedListenerSets) &&
 		listenersStatusEqual(statusA.Listeners, statusB.Listeners) &&
 		conditionsEqual(statusA.Conditions, statusB.Conditions)
 }
 
+func listenerSetStatusEqual(statusA, statusB gatev1.ListenerSetStatus) bool {
+	return slices.EqualFunc(statusA.Listeners, statusB.Listeners, func(lA gatev1.ListenerEntryStatus, lB gatev1.ListenerEntryStatus) bool {
+		return lA.Name == lB.Name &&
+			lA.AttachedRoutes == lB.AttachedRoutes &&
+			conditionsEqual(lA.Conditions, lB.Conditions)
+	}) && conditionsEqual(statusA.Conditions, statusB.Conditions)
+}
+
 func policyAncestorStatusesEqual(policyAncestorStatusesA, policyAncestorStatusesB []gatev1.PolicyAncestorStatus) bool {
 	if len(policyAncestorStatusesA) != len(policyAncestorStatusesB) {
 		return false
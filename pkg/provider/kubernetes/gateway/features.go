@@ -62,6 +62,10 @@ func extendedHTTPRouteFeatures() sets.Set[features.Feature] {
 		features.HTTPRouteNamedRouteRule,
 		features.HTTPRouteParentRefPortFeature,
 		features.HTTPRouteCORS,
+		features.HTTPRouteRequestMirrorFeature,
+		features.HTTPRouteRequestPercentageMirrorFeature,
+		features.HTTPRouteRequestTimeoutFeature,
+		features.HTTPRouteBackendTimeoutFeature,
 	)
 }
 
@@ -11,8 +11,10 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
+	ptypes "github.com/traefik/paerser/types"
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
 	"github.com/traefik/traefik/v3/pkg/provider"
 	"github.com/traefik/traefik/v3/pkg/tls"
@@ -22,6 +24,7 @@ import (
 	ktypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	gatev1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatexv1alpha1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
 )
 
 func (p *Provider) loadHTTPRoutes(ctx context.Context, gateways []gatewayWithListeners, conf *dynamic.Configuration, statusReport *statusReport) {
@@ -96,6 +99,15 @@ func (p *Provider) loadHTTPRoutes(ctx context.Context, gateways []gatewayWithLis
 			if resolvedRefCondition != nil {
 				parentStatusConditions = append(parentStatusConditions, *resolvedRefCondition)
 			}
+			if invalidRulesCondition := invalidHTTPRouteRulesCondition(route); invalidRulesCondition != nil {
+				switch {
+				case invalidRulesCondition.Type != string(gatev1.RouteConditionAccepted):
+					parentStatusConditions = append(parentStatusConditions, *invalidRulesCondition)
+				case acceptedCondition.Status == metav1.ConditionTrue:
+					// All the rules have been dropped, the route is not accepted.
+					parentStatusConditions[0] = *invalidRulesCondition
+				}
+			}
 
 			statusReport.RecordHTTPRouteStatus(ktypes.NamespacedName{Namespace: route.Namespace, Name: route.Name}, gatev1.RouteParentStatus{
 				ParentRef:      match.parentRef,
@@ -125,6 +137,11 @@ func (p *Provider) loadHTTPRoute(ctx context.Context, gatewayName, gatewayNamesp
 	}
 
 	for ri, routeRule := range route.Spec.Rules {
+		// Invalid rules are dropped, and reported by the route PartiallyInvalid condition.
+		if err := validateHTTPRouteRule(routeRule); err != nil {
+			continue
+		}
+
 		// Adding the gateway desc and the entryPoint desc prevents overlapping of routers build from the same routes.
 		routeKey := provider.Normalize(fmt.Sprintf("%s-%s-%s-gw-%s-%s-ep-%s-%d", strings.ToLower(kindHTTPRoute), route.Namespace, route.Name, gatewayNamespace, gatewayName, listener.EPName, ri))
 
@@ -145,6 +162,18 @@ func (p *Provider) loadHTTPRoute(ctx context.Context, gatewayName, gatewayNamesp
 			routerName := makeRouterName(rule, routeKey)
 			// TODO loadMiddlewares errors could change the condition.
 			router.Middlewares, err = p.loadMiddlewares(conf, route.Namespace, routerName, routeRule.Filters, match.Path)
+			if err == nil && routeRule.Timeouts != nil {
+				err = validateTimeouts(routeRule.Timeouts)
+			}
+			if err == nil && routeRule.Timeouts != nil {
+				// The request timeout is the first middleware, to cover the whole request handling.
+				var timeoutName string
+				timeoutName, err = createRequestTimeout(conf, routerName+"-requesttimeout", routeRule.Timeouts.Request)
+				if timeoutName != "" {
+					router.Middlewares = append([]string{timeoutName}, router.Middlewares...)
+				}
+			}
+
 			switch {
 			case err != nil:
 				log.Ctx(ctx).Error().Err(err).Msg("Unable to load HTTPRoute filters")
@@ -183,6 +212,15 @@ func (p *Provider) loadHTTPRoute(ctx context.Context, gatewayName, gatewayNamesp
 				if serviceCondition != nil {
 					condition = *serviceCondition
 				}
+
+				var mirrorCondition *metav1.Condition
+				router.Service, mirrorCondition = p.loadMirroringService(gatewayName, listener, conf, routerName, router.Service, routeRule.Filters, route, match.Path, statusReport)
+				if mirrorCondition != nil {
+					log.Ctx(ctx).Error().
+						Msgf("Unable to load HTTPRoute RequestMirror backend: %s", mirrorCondition.Message)
+
+					condition = *mirrorCondition
+				}
 			}
 
 			p.applyRouterTransform(ctx, &router, route)
@@ -226,7 +264,68 @@ func (p *Provider) loadWRRService(ctx context.Context, gatewayName string, liste
 		})
 	}
 
-	conf.HTTP.Services[name] = &dynamic.Service{Weighted: &wrr}
+	service := &dynamic.Service{Weighted: &wrr}
+
+	if routeRule.SessionPersistence != nil {
+		// The session persistence has already been validated with the route rule.
+		if sticky, err := createSticky(routeRule.SessionPersistence, len(routeRule.BackendRefs)); err == nil {
+			applySticky(conf, service, sticky)
+		}
+	}
+
+	if routeRule.Timeouts != nil {
+		// The backend request timeout only covers the requests forwarded to the backends, not the mirrored ones.
+		timeoutName, err := createRequestTimeout(conf, routeKey+"-backendrequesttimeout", routeRule.Timeouts.BackendRequest)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Unable to load HTTPRoute backend request timeout")
+		}
+		if timeoutName != "" {
+			service.Middlewares = []string{timeoutName}
+		}
+	}
+
+	conf.HTTP.Services[name] = service
+	return name, condition
+}
+
+// loadMirroringService wraps the given service into a Mirroring service when RequestMirror filters are defined,
+// and returns the name of the service to use in place of the given one.
+func (p *Provider) loadMirroringService(gatewayName string, listener gatewayListener, conf *dynamic.Configuration, parentName, serviceName string, filters []gatev1.HTTPRouteFilter, route *gatev1.HTTPRoute, pathMatch *gatev1.HTTPPathMatch, statusReport *statusReport) (string, *metav1.Condition) {
+	var mirrors []dynamic.MirrorService
+	var condition *metav1.Condition
+	for i, filter := range filters {
+		if filter.Type != gatev1.HTTPRouteFilterRequestMirror || filter.RequestMirror == nil {
+			continue
+		}
+
+		mirrorKey := fmt.Sprintf("%s-%s-%d", parentName, strings.ToLower(string(filter.Type)), i)
+		backendRef := gatev1.HTTPBackendRef{BackendRef: gatev1.BackendRef{BackendObjectReference: filter.RequestMirror.BackendRef}}
+
+		mirrorName, errCondition := p.loadService(gatewayName, listener, conf, mirrorKey, route, 0, backendRef, pathMatch, statusReport)
+		if errCondition != nil {
+			// An invalid mirror must not prevent the traffic from reaching the main service.
+			condition = errCondition
+			continue
+		}
+
+		mirrors = append(mirrors, dynamic.MirrorService{
+			Name:    mirrorName,
+			Percent: mirrorPercent(filter.RequestMirror),
+		})
+	}
+
+	if len(mirrors) == 0 {
+		return serviceName, condition
+	}
+
+	name := parentName + "-mirror"
+	conf.HTTP.Services[name] = &dynamic.Service{
+		Mirroring: &dynamic.Mirroring{
+			Service: serviceName,
+			Mirrors: mirrors,
+		},
+	}
+
 	return name, condition
 }
 
@@ -301,7 +400,7 @@ func (p *Provider) loadService(gatewayName string, listener gatewayListener, con
 			conf.HTTP.Services[name] = service
 		}
 
-		return name, nil
+		return p.loadMirroringService(gatewayName, listener, conf, serviceName, name, backendRef.Filters, route, pathMatch, statusReport)
 	}
 
 	port := ptr.Deref(backendRef.Port, gatev1.PortNumber(0))
@@ -328,7 +427,7 @@ func (p *Provider) loadService(gatewayName string, listener gatewayListener, con
 
 	conf.HTTP.Services[serviceName] = &dynamic.Service{LoadBalancer: lb, Middlewares: middlewares}
 
-	return serviceName, nil
+	return p.loadMirroringService(gatewayName, listener, conf, serviceName, serviceName, backendRef.Filters, route, pathMatch, statusReport)
 }
 
 func (p *Provider) loadHTTPBackendRef(namespace string, backendRef gatev1.HTTPBackendRef) (string, *dynamic.Service, error) {
@@ -413,6 +512,10 @@ func (p *Provider) loadMiddlewares(conf *dynamic.Configuration, namespace, paren
 				createCORS(filter.CORS),
 			})
 
+		case gatev1.HTTPRouteFilterRequestMirror:
+			// RequestMirror filters are not middlewares, they are handled with Mirroring services.
+			continue
+
 		default:
 			// As per the spec: https://gateway-api.sigs.k8s.io/api-types/httproute/#filters-optional
 			// In all cases where incompatible or unsupported filters are
@@ -570,7 +673,24 @@ func (p *Provider) loadHTTPServers(gatewayName, namespace string, route *gatev1.
 		}
 	}
 
-	lb := &dynamic.ServersLoadBalancer{}
+	sticky, err := p.loadBackendSticky(gatewayName, namespace, string(backendRef.Name), listener, statusReport)
+	if err != nil {
+		log.Error().Err(err).
+			Str("namespace", namespace).
+			Str("service", string(backendRef.Name)).
+			Msg("Unable to list XBackendTrafficPolicies")
+
+		return nil, nil, &metav1.Condition{
+			Type:               string(gatev1.RouteConditionResolvedRefs),
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: route.Generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             "BackendTrafficPolicyLookupFailed", // No route reason of the spec covers a failure to look up the policies.
+			Message:            fmt.Sprintf("Cannot list XBackendTrafficPolicies for Service %s/%s: %s", namespace, string(backendRef.Name), err),
+		}
+	}
+
+	lb := &dynamic.ServersLoadBalancer{Sticky: sticky}
 	lb.SetDefaults()
 
 	// If a ServersTransport is set, it means a BackendTLSPolicy matched the service port, and we can safely assume the protocol is HTTPS.
@@ -598,6 +718,73 @@ func (p *Provider) loadHTTPServers(gatewayName, namespace string, route *gatev1.
 	return lb, serversTransport, nil
 }
 
+// loadBackendSticky returns the sticky configuration defined by the XBackendTrafficPolicies targeting the given service,
+// and records their status. The session persistence of a route rule takes precedence over this one.
+func (p *Provider) loadBackendSticky(gatewayName, namespace, serviceName string, listener gatewayListener, statusReport *statusReport) (*dynamic.Sticky, error) {
+	if !p.ExperimentalChannel {
+		return nil, nil
+	}
+
+	policies, err := p.client.ListBackendTrafficPoliciesForService(namespace, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort XBackendTrafficPolicies by creation timestamp, then by name, the oldest policy wins in case of conflict.
+	slices.SortStableFunc(policies, func(a, b *gatexv1alpha1.XBackendTrafficPolicy) int {
+		cmpTime := a.CreationTimestamp.Time.Compare(b.CreationTimestamp.Time)
+		if cmpTime == 0 {
+			return strings.Compare(a.Name, b.Name)
+		}
+		return cmpTime
+	})
+
+	var sticky *dynamic.Sticky
+	for _, policy := range policies {
+		// Only the session persistence of the policy is supported.
+		if policy.Spec.SessionPersistence == nil {
+			continue
+		}
+
+		acceptedCondition := metav1.Condition{
+			Type:               string(gatev1.PolicyConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: policy.Generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(gatev1.PolicyReasonAccepted),
+		}
+
+		// Multiple XBackendTrafficPolicies can target the same service, meaning that there is a conflict.
+		if sticky != nil {
+			acceptedCondition.Status = metav1.ConditionFalse
+			acceptedCondition.Reason = string(gatev1.PolicyReasonConflicted)
+		} else {
+			// The policy applies to the endpoints of a single backend.
+			policySticky, err := createSticky(policy.Spec.SessionPersistence, 1)
+			if err != nil {
+				acceptedCondition.Status = metav1.ConditionFalse
+				acceptedCondition.Reason = string(gatev1.PolicyReasonInvalid)
+				acceptedCondition.Message = fmt.Sprintf("Invalid sessionPersistence: %s", err)
+			}
+			sticky = policySticky
+		}
+
+		statusReport.RecordBackendTrafficPolicyStatus(ktypes.NamespacedName{Namespace: policy.Namespace, Name: policy.Name}, gatev1.PolicyAncestorStatus{
+			AncestorRef: gatev1.ParentReference{
+				Group:       new(gatev1.Group(groupGateway)),
+				Kind:        new(gatev1.Kind(kindGateway)),
+				Namespace:   new(gatev1.Namespace(namespace)),
+				Name:        gatev1.ObjectName(gatewayName),
+				SectionName: new(gatev1.SectionName(listener.Name)),
+			},
+			ControllerName: controllerName,
+			Conditions:     []metav1.Condition{acceptedCondition},
+		})
+	}
+
+	return sticky, nil
+}
+
 func (p *Provider) loadServersTransport(namespace string, policy *gatev1.BackendTLSPolicy) (*dynamic.ServersTransport, metav1.Condition) {
 	st := &dynamic.ServersTransport{
 		ServerName: string(policy.Spec.Validation.Hostname),
@@ -1014,6 +1201,229 @@ func createCORS(filter *gatev1.HTTPCORSFilter) *dynamic.Middleware {
 	}
 }
 
+// createRequestTimeout adds a RequestTimeout middleware with the given name to the configuration,
+// and returns its name, or an empty name when the timeout is not set or disabled.
+func createRequestTimeout(conf *dynamic.Configuration, name string, duration *gatev1.Duration) (string, error) {
+	timeout, err := parseTimeout(duration)
+	if err != nil {
+		return "", err
+	}
+
+	// As per the spec, a zero duration disables the timeout.
+	if timeout == 0 {
+		return "", nil
+	}
+
+	conf.HTTP.Middlewares[name] = &dynamic.Middleware{
+		RequestTimeout: &dynamic.RequestTimeout{
+			Timeout: ptypes.Duration(timeout),
+		},
+	}
+
+	return name, nil
+}
+
+// validateTimeouts checks that the backend request timeout does not exceed the request timeout,
+// as the backend requests are part of the whole request handling.
+func validateTimeouts(timeouts *gatev1.HTTPRouteTimeouts) error {
+	request, err := parseTimeout(timeouts.Request)
+	if err != nil {
+		return err
+	}
+
+	backendRequest, err := parseTimeout(timeouts.BackendRequest)
+	if err != nil {
+		return err
+	}
+
+	// A zero request timeout disables the timeout, and does not bound the backend request timeout.
+	if request > 0 && backendRequest > request {
+		return fmt.Errorf("backendRequest timeout %s must not be greater than the request timeout %s", backendRequest, request)
+	}
+
+	return nil
+}
+
+// parseTimeout parses the given timeout, a nil timeout being parsed as a zero duration.
+func parseTimeout(duration *gatev1.Duration) (time.Duration, error) {
+	if duration == nil {
+		return 0, nil
+	}
+
+	timeout, err := time.ParseDuration(string(*duration))
+	if err != nil {
+		return 0, fmt.Errorf("parsing timeout %q: %w", *duration, err)
+	}
+
+	return timeout, nil
+}
+
+// createSticky translates the given session persistence, applied to the given number of backends, into a sticky configuration.
+func createSticky(sessionPersistence *gatev1.SessionPersistence, backendCount int) (*dynamic.Sticky, error) {
+	absoluteTimeout, err := parseSessionTimeout(sessionPersistence.AbsoluteTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("parsing absoluteTimeout: %w", err)
+	}
+
+	idleTimeout, err := parseSessionTimeout(sessionPersistence.IdleTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("parsing idleTimeout: %w", err)
+	}
+
+	sessionName := ptr.Deref(sessionPersistence.SessionName, "")
+
+	switch sessionType := ptr.Deref(sessionPersistence.Type, gatev1.CookieBasedSessionPersistence); sessionType {
+	case gatev1.CookieBasedSessionPersistence:
+		// Refreshing the cookie on activity would extend its lifetime beyond the absolute timeout.
+		if absoluteTimeout > 0 && idleTimeout > 0 {
+			return nil, errors.New("absoluteTimeout and idleTimeout cannot be combined")
+		}
+
+		lifetimeType := gatev1.SessionCookieLifetimeType
+		if sessionPersistence.CookieConfig != nil {
+			lifetimeType = ptr.Deref(sessionPersistence.CookieConfig.LifetimeType, gatev1.SessionCookieLifetimeType)
+		}
+
+		// The absolute timeout of a session cookie would have to be tracked by the Gateway.
+		if absoluteTimeout > 0 && lifetimeType != gatev1.PermanentCookieLifetimeType {
+			return nil, fmt.Errorf("absoluteTimeout is only supported with the %s cookie lifetimeType", gatev1.PermanentCookieLifetimeType)
+		}
+
+		cookie := &dynamic.Cookie{
+			Name:        sessionName,
+			HTTPOnly:    true,
+			MaxAge:      int(absoluteTimeout / time.Second),
+			IdleTimeout: int(idleTimeout / time.Second),
+		}
+		cookie.SetDefaults()
+
+		return &dynamic.Sticky{Cookie: cookie}, nil
+
+	case gatev1.HeaderBasedSessionPersistence:
+		if absoluteTimeout > 0 || idleTimeout > 0 {
+			return nil, errors.New("timeouts are not supported with header-based session persistence")
+		}
+
+		// A single header cannot pin both the backend and its endpoint.
+		if backendCount > 1 {
+			return nil, errors.New("header-based session persistence is not supported with multiple backendRefs")
+		}
+
+		return &dynamic.Sticky{Header: &dynamic.StickyHeader{Name: sessionName}}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported session persistence type %q", sessionType)
+	}
+}
+
+// applySticky sets the given sticky configuration on the services of the given WRR service.
+// With a single backend, the endpoint is pinned with the configured session name.
+// Otherwise, the session name pins the backend, and each backend pins its endpoint with a generated cookie name.
+func applySticky(conf *dynamic.Configuration, service *dynamic.Service, sticky *dynamic.Sticky) {
+	var backendSticky *dynamic.Sticky
+	if len(service.Weighted.Services) == 1 {
+		backendSticky = sticky
+	} else {
+		service.Weighted.Sticky = sticky
+
+		if sticky.Cookie != nil {
+			backendSticky = sticky.DeepCopy()
+			backendSticky.Cookie.Name = ""
+		}
+	}
+
+	if backendSticky == nil {
+		return
+	}
+
+	for _, wrrService := range service.Weighted.Services {
+		// Services in error, external and mirroring services are not load-balanced by the provider.
+		backend, ok := conf.HTTP.Services[wrrService.Name]
+		if !ok || backend.LoadBalancer == nil {
+			continue
+		}
+
+		backend.LoadBalancer.Sticky = backendSticky.DeepCopy()
+	}
+}
+
+// validateHTTPRouteRule returns an error when the given route rule cannot be implemented and must be dropped.
+func validateHTTPRouteRule(routeRule gatev1.HTTPRouteRule) error {
+	if routeRule.SessionPersistence == nil {
+		return nil
+	}
+
+	if _, err := createSticky(routeRule.SessionPersistence, len(routeRule.BackendRefs)); err != nil {
+		return fmt.Errorf("invalid sessionPersistence: %w", err)
+	}
+
+	return nil
+}
+
+// invalidHTTPRouteRulesCondition returns the condition to report when some rules of the given route have been dropped,
+// which is the PartiallyInvalid condition, or a not Accepted condition when all the rules have been dropped.
+// It returns nil when all the rules are valid.
+func invalidHTTPRouteRulesCondition(route *gatev1.HTTPRoute) *metav1.Condition {
+	var messages []string
+	for ri, routeRule := range route.Spec.Rules {
+		if err := validateHTTPRouteRule(routeRule); err != nil {
+			messages = append(messages, fmt.Sprintf("rule %d: %s", ri, err))
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	if len(messages) == len(route.Spec.Rules) {
+		return &metav1.Condition{
+			Type:               string(gatev1.RouteConditionAccepted),
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: route.Generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(gatev1.RouteReasonUnsupportedValue),
+			Message:            "All rules are invalid: " + strings.Join(messages, ", "),
+		}
+	}
+
+	return &metav1.Condition{
+		Type:               string(gatev1.RouteConditionPartiallyInvalid),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: route.Generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             string(gatev1.RouteReasonUnsupportedValue),
+		Message:            "Dropped rules: " + strings.Join(messages, ", "),
+	}
+}
+
+// parseSessionTimeout parses the given session persistence timeout, a nil duration returns zero.
+func parseSessionTimeout(duration *gatev1.Duration) (time.Duration, error) {
+	if duration == nil {
+		return 0, nil
+	}
+
+	return time.ParseDuration(string(*duration))
+}
+
+// mirrorPercent returns the percentage of requests to mirror.
+// Traefik mirrors a whole percentage of the requests, so fractions are rounded down.
+func mirrorPercent(filter *gatev1.HTTPRequestMirrorFilter) int {
+	switch {
+	case filter.Percent != nil:
+		return int(*filter.Percent)
+
+	case filter.Fraction != nil:
+		denominator := ptr.Deref(filter.Fraction.Denominator, 100)
+		if denominator <= 0 {
+			return 0
+		}
+
+		return int(filter.Fraction.Numerator) * 100 / int(denominator)
+	}
+
+	return 100
+}
+
 func getHTTPServiceProtocol(portSpec corev1.ServicePort) (string, error) {
 	if portSpec.Protocol != corev1.ProtocolTCP {
 		return "", errors.New("only TCP protocol is supported")
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
 	gatev1 "sigs.k8s.io/gateway-api/apis/v1"
 )
@@ -323,3 +324,124 @@ func Test_createCORS(t *testing.T) {
 		})
 	}
 }
+
+func Test_createSticky(t *testing.T) {
+	testCases := []struct {
+		desc               string
+		sessionPersistence *gatev1.SessionPersistence
+		backendRefs        int
+		expected           *dynamic.Sticky
+		expectedErr        bool
+	}{
+		{
+			desc:               "Default cookie",
+			sessionPersistence: &gatev1.SessionPersistence{},
+			backendRefs:        1,
+			expected: &dynamic.Sticky{
+				Cookie: &dynamic.Cookie{
+					HTTPOnly: true,
+					Path:     new("/"),
+				},
+			},
+		},
+		{
+			desc: "Permanent cookie with absolute timeout",
+			sessionPersistence: &gatev1.SessionPersistence{
+				SessionName:     new("session"),
+				AbsoluteTimeout: new(gatev1.Duration("1h")),
+				Type:            new(gatev1.CookieBasedSessionPersistence),
+				CookieConfig: &gatev1.CookieConfig{
+					LifetimeType: new(gatev1.PermanentCookieLifetimeType),
+				},
+			},
+			backendRefs: 1,
+			expected: &dynamic.Sticky{
+				Cookie: &dynamic.Cookie{
+					Name:     "session",
+					HTTPOnly: true,
+					MaxAge:   3600,
+					Path:     new("/"),
+				},
+			},
+		},
+		{
+			desc: "Cookie with idle timeout",
+			sessionPersistence: &gatev1.SessionPersistence{
+				SessionName: new("session"),
+				IdleTimeout: new(gatev1.Duration("30m")),
+			},
+			backendRefs: 1,
+			expected: &dynamic.Sticky{
+				Cookie: &dynamic.Cookie{
+					Name:        "session",
+					HTTPOnly:    true,
+					IdleTimeout: 1800,
+					Path:        new("/"),
+				},
+			},
+		},
+		{
+			desc: "Session cookie with absolute timeout",
+			sessionPersistence: &gatev1.SessionPersistence{
+				AbsoluteTimeout: new(gatev1.Duration("1h")),
+			},
+			backendRefs: 1,
+			expectedErr: true,
+		},
+		{
+			desc: "Cookie with absolute and idle timeouts",
+			sessionPersistence: &gatev1.SessionPersistence{
+				AbsoluteTimeout: new(gatev1.Duration("1h")),
+				IdleTimeout:     new(gatev1.Duration("30m")),
+				CookieConfig: &gatev1.CookieConfig{
+					LifetimeType: new(gatev1.PermanentCookieLifetimeType),
+				},
+			},
+			backendRefs: 1,
+			expectedErr: true,
+		},
+		{
+			desc: "Header",
+			sessionPersistence: &gatev1.SessionPersistence{
+				SessionName: new("X-Session"),
+				Type:        new(gatev1.HeaderBasedSessionPersistence),
+			},
+			backendRefs: 1,
+			expected: &dynamic.Sticky{
+				Header: &dynamic.StickyHeader{Name: "X-Session"},
+			},
+		},
+		{
+			desc: "Header with idle timeout",
+			sessionPersistence: &gatev1.SessionPersistence{
+				IdleTimeout: new(gatev1.Duration("30m")),
+				Type:        new(gatev1.HeaderBasedSessionPersistence),
+			},
+			backendRefs: 1,
+			expectedErr: true,
+		},
+		{
+			desc: "Header with multiple backendRefs",
+			sessionPersistence: &gatev1.SessionPersistence{
+				Type: new(gatev1.HeaderBasedSessionPersistence),
+			},
+			backendRefs: 2,
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			sticky, err := createSticky(test.sessionPersistence, test.backendRefs)
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, sticky)
+		})
+	}
+}
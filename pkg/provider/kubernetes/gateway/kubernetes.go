@@ -24,6 +24,7 @@ import (
 	"github.com/traefik/traefik/v3/pkg/tls"
 	"github.com/traefik/traefik/v3/pkg/types"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	ktypes "k8s.io/apimachinery/pkg/types"
@@ -42,11 +43,13 @@ const (
 	groupGateway = "gateway.networking.k8s.io"
 
 	kindGateway        = "Gateway"
+	kindListenerSet    = "ListenerSet"
 	kindTraefikService = "TraefikService"
 	kindHTTPRoute      = "HTTPRoute"
 	kindGRPCRoute      = "GRPCRoute"
 	kindTCPRoute       = "TCPRoute"
 	kindTLSRoute       = "TLSRoute"
+	kindUDPRoute       = "UDPRoute"
 	kindService        = "Service"
 	kindConfigMap      = "ConfigMap"
 	kindSecret         = "Secret"
@@ -96,6 +99,7 @@ func (p *Provider) SetDefaults() {
 type Entrypoint struct {
 	Address        string
 	HasHTTPTLSConf bool
+	UDP            bool
 }
 
 // StatusAddress holds the Gateway Status address configuration.
@@ -141,10 +145,20 @@ type gatewayListener struct {
 type gatewayWithListeners struct {
 	Name      string
 	Namespace string
+	// ListenerSet is true when the listeners belong to a ListenerSet attached to a Gateway.
+	ListenerSet bool
 
 	listeners []gatewayListener
 }
 
+// kind returns the kind of the resource owning the listeners.
+func (g gatewayWithListeners) kind() string {
+	if g.ListenerSet {
+		return kindListenerSet
+	}
+	return kindGateway
+}
+
 // RegisterFilterFuncs registers an allowed Group, Kind, and builder for the Filter ExtensionRef objects.
 func (p *Provider) RegisterFilterFuncs(group, kind string, builderFunc BuildFilterFunc) {
 	if p.groupKindFilterFuncs == nil {
@@ -386,10 +400,29 @@ func (p *Provider) loadConfigurationFromGateways(ctx context.Context) (*dynamic.
 		selectedGateways = append(selectedGateways, gatewayWithListeners{
 			Name:      gateway.Name,
 			Namespace: gateway.Namespace,
-			listeners: p.loadGatewayListeners(logger.WithContext(ctx), gateway, conf),
+			listeners: p.loadGatewayListeners(logger.WithContext(ctx), kindGateway, gateway, conf),
 		})
 	}
 
+	var listenerSets []listenerSetWithListeners
+	if p.ExperimentalChannel {
+		listenerSets = p.loadListenerSets(ctx, gateways, selectedGateways, conf)
+
+		for _, listenerSet := range listenerSets {
+			// Only the ListenerSets attached to their parent Gateway can be targeted by routes.
+			if listenerSet.condition != nil {
+				continue
+			}
+
+			selectedGateways = append(selectedGateways, gatewayWithListeners{
+				Name:        listenerSet.listenerSet.Name,
+				Namespace:   listenerSet.listenerSet.Namespace,
+				ListenerSet: true,
+				listeners:   listenerSet.listeners,
+			})
+		}
+	}
+
 	statusReport.gatewayListeners = selectedGateways
 
 	p.loadHTTPRoutes(ctx, selectedGateways, conf, statusReport)
@@ -400,6 +433,17 @@ func (p *Provider) loadConfigurationFromGateways(ctx context.Context) (*dynamic.
 
 	if p.ExperimentalChannel {
 		p.loadTCPRoutes(ctx, selectedGateways, conf, statusReport)
+		p.loadUDPRoutes(ctx, selectedGateways, conf, statusReport)
+	}
+
+	attachedListenerSets := make(map[ktypes.NamespacedName]int32)
+	for _, listenerSet := range listenerSets {
+		listenerSetStatus := makeListenerSetStatus(listenerSet)
+		if meta.IsStatusConditionTrue(listenerSetStatus.Conditions, string(gatev1.ListenerSetConditionAccepted)) {
+			attachedListenerSets[listenerSet.gateway]++
+		}
+
+		statusReport.RecordListenerSetStatus(ktypes.NamespacedName{Name: listenerSet.listenerSet.Name, Namespace: listenerSet.listenerSet.Namespace}, listenerSetStatus)
 	}
 
 	for _, gateway := range gateways {
@@ -410,12 +454,15 @@ func (p *Provider) loadConfigurationFromGateways(ctx context.Context) (*dynamic.
 
 		var listeners []gatewayListener
 		for _, selectedGateway := range selectedGateways {
-			if selectedGateway.Name == gateway.Name && selectedGateway.Namespace == gateway.Namespace {
+			if !selectedGateway.ListenerSet && selectedGateway.Name == gateway.Name && selectedGateway.Namespace == gateway.Namespace {
 				listeners = append(listeners, selectedGateway.listeners...)
 			}
 		}
 
 		gatewayStatus, errConditions := p.makeGatewayStatus(gateway, listeners, addresses)
+		if p.ExperimentalChannel && gateway.Spec.AllowedListeners != nil {
+			gatewayStatus.AttachedListenerSets = new(attachedListenerSets[ktypes.NamespacedName{Name: gateway.Name, Namespace: gateway.Namespace}])
+		}
 		if len(errConditions) > 0 {
 			messages := map[string]struct{}{}
 			for _, condition := range errConditions {
@@ -436,7 +483,9 @@ func (p *Provider) loadConfigurationFromGateways(ctx context.Context) (*dynamic.
 	return conf, statusReport, nil
 }
 
-func (p *Provider) loadGatewayListeners(ctx context.Context, gateway *gatev1.Gateway, conf *dynamic.Configuration) []gatewayListener {
+// loadGatewayListeners loads the listeners of the given Gateway.
+// The fromKind is the kind of the resource defining the listeners, used to check the certificate ReferenceGrants.
+func (p *Provider) loadGatewayListeners(ctx context.Context, fromKind string, gateway *gatev1.Gateway, conf *dynamic.Configuration) []gatewayListener {
 	tlsCerts := make(map[string]*tls.CertAndStores)
 	allocatedListeners := make(map[string]struct{})
 	gatewayListeners := make([]gatewayListener, len(gateway.Spec.Listeners))
@@ -520,14 +569,14 @@ func (p *Provider) loadGatewayListeners(ctx context.Context, gateway *gatev1.Gat
 
 		allocatedListeners[listenerKey] = struct{}{}
 
-		if (listener.Protocol == gatev1.HTTPProtocolType || listener.Protocol == gatev1.TCPProtocolType) && listener.TLS != nil {
+		if (listener.Protocol == gatev1.HTTPProtocolType || listener.Protocol == gatev1.TCPProtocolType || listener.Protocol == gatev1.UDPProtocolType) && listener.TLS != nil {
 			gatewayListeners[i].Status.Conditions = append(gatewayListeners[i].Status.Conditions, metav1.Condition{
 				Type:               string(gatev1.ListenerConditionAccepted),
 				Status:             metav1.ConditionFalse,
 				ObservedGeneration: gateway.Generation,
 				LastTransitionTime: metav1.Now(),
 				Reason:             "InvalidTLSConfiguration", // TODO check the spec if a proper reason is introduced at some point
-				Message:            "TLS configuration must no be defined when using HTTP or TCP protocol",
+				Message:            "TLS configuration must no be defined when using HTTP, TCP or UDP protocol",
 			})
 
 			continue
@@ -599,7 +648,7 @@ func (p *Provider) loadGatewayListeners(ctx context.Context, gateway *gatev1.Gat
 					}
 
 					certificateNamespace := string(ptr.Deref(certificateRef.Namespace, gatev1.Namespace(gateway.Namespace)))
-					if err := p.isReferenceGranted(kindGateway, gateway.Namespace, groupCore, kindSecret, string(certificateRef.Name), certificateNamespace); err != nil {
+					if err := p.isReferenceGranted(fromKind, gateway.Namespace, groupCore, kindSecret, string(certificateRef.Name), certificateNamespace); err != nil {
 						errCertConditions = append(errCertConditions, metav1.Condition{
 							Type:               string(gatev1.ListenerConditionResolvedRefs),
 							Status:             metav1.ConditionFalse,
@@ -793,6 +842,11 @@ func (p *Provider) entryPointName(port gatev1.PortNumber, protocol gatev1.Protoc
 	portStr := strconv.FormatInt(int64(port), 10)
 
 	for name, entryPoint := range p.EntryPoints {
+		// UDP listeners can only be bound to UDP entryPoints, and the other way around.
+		if entryPoint.UDP != (protocol == gatev1.UDPProtocolType) {
+			continue
+		}
+
 		if strings.HasSuffix(entryPoint.Address, ":"+portStr) {
 			// If the protocol is HTTP the entryPoint must have no TLS conf
 			// Not relevant for gatev1.TLSProtocolType && gatev1.TCPProtocolType
@@ -972,9 +1026,14 @@ func supportedRouteKinds(protocol gatev1.ProtocolType, experimentalChannel bool)
 	group := gatev1.Group(gatev1.GroupName)
 
 	switch protocol {
-	case gatev1.TCPProtocolType:
+	case gatev1.TCPProtocolType, gatev1.UDPProtocolType:
 		if experimentalChannel {
-			return []gatev1.RouteGroupKind{{Kind: kindTCPRoute, Group: &group}}, nil
+			kind := kindTCPRoute
+			if protocol == gatev1.UDPProtocolType {
+				kind = kindUDPRoute
+			}
+
+			return []gatev1.RouteGroupKind{{Kind: gatev1.Kind(kind), Group: &group}}, nil
 		}
 
 		return nil, []metav1.Condition{{
@@ -1111,8 +1170,8 @@ type gatewayListenersForParentRef struct {
 }
 
 // matchingGatewayListenersForParentRef returns, for each parentRef referring to a
-// Gateway managed by this controller, all the listeners of that Gateway.
-// parentRefs that do not refer to one of our Gateways are omitted.
+// Gateway or a ListenerSet managed by this controller, all the listeners of that resource.
+// parentRefs that do not refer to one of our Gateways or ListenerSets are omitted.
 func matchingGatewayListenersForParentRef(gateways []gatewayWithListeners, routeNamespace string, parentRefs []gatev1.ParentReference) []gatewayListenersForParentRef {
 	var matches []gatewayListenersForParentRef
 
@@ -1121,14 +1180,15 @@ func matchingGatewayListenersForParentRef(gateways []gatewayWithListeners, route
 			continue
 		}
 
-		if ptr.Deref(parentRef.Kind, kindGateway) != kindGateway {
-			continue
-		}
-
+		parentRefKind := string(ptr.Deref(parentRef.Kind, kindGateway))
 		parentRefNamespace := string(ptr.Deref(parentRef.Namespace, gatev1.Namespace(routeNamespace)))
 
 		var matchingGateway *gatewayWithListeners
 		for _, gateway := range gateways {
+			if parentRefKind != gateway.kind() {
+				continue
+			}
+
 			if parentRefNamespace != gateway.Namespace {
 				continue
 			}
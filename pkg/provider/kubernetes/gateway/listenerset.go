@@ -0,0 +1,329 @@
+package gateway
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gatev1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// listenerSetWithListeners holds a ListenerSet targeting a Gateway managed by this controller, and its loaded listeners.
+type listenerSetWithListeners struct {
+	listenerSet *gatev1.ListenerSet
+	gateway     ktypes.NamespacedName
+
+	listeners []gatewayListener
+
+	// condition is the Accepted condition reported when the ListenerSet cannot be attached to its parent Gateway.
+	condition *metav1.Condition
+}
+
+// loadListenerSets loads the ListenerSets targeting the given Gateways.
+// The ListenerSet listeners are merged into the parent Gateway ones,
+// and a listener conflicting with a Gateway listener, or with a listener of an older ListenerSet, is not bound.
+func (p *Provider) loadListenerSets(ctx context.Context, gateways []*gatev1.Gateway, selectedGateways []gatewayWithListeners, conf *dynamic.Configuration) []listenerSetWithListeners {
+	listenerSets, err := p.client.ListListenerSets()
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Unable to list ListenerSets")
+		return nil
+	}
+
+	// As per the specification, conflicts are resolved in favor of the oldest ListenerSet,
+	// and then in alphabetical order of their namespace and name.
+	slices.SortFunc(listenerSets, func(a, b *gatev1.ListenerSet) int {
+		return cmp.Or(
+			a.CreationTimestamp.Compare(b.CreationTimestamp.Time),
+			strings.Compare(a.Namespace, b.Namespace),
+			strings.Compare(a.Name, b.Name),
+		)
+	})
+
+	allocatedListeners := make(map[ktypes.NamespacedName]map[string]struct{})
+
+	var result []listenerSetWithListeners
+	for _, listenerSet := range listenerSets {
+		gateway := findListenerSetParent(gateways, listenerSet)
+		if gateway == nil {
+			continue
+		}
+
+		logger := log.Ctx(ctx).With().
+			Str("listener_set", listenerSet.Name).
+			Str("namespace", listenerSet.Namespace).
+			Logger()
+
+		gatewayName := ktypes.NamespacedName{Name: gateway.Name, Namespace: gateway.Namespace}
+		loaded := listenerSetWithListeners{
+			listenerSet: listenerSet,
+			gateway:     gatewayName,
+		}
+
+		allowed, err := p.isListenerSetAllowed(gateway, listenerSet.Namespace)
+		if err != nil {
+			logger.Error().Err(err).Msg("Unable to check the Gateway allowed ListenerSets")
+		}
+		if !allowed {
+			loaded.condition = &metav1.Condition{
+				Type:               string(gatev1.ListenerSetConditionAccepted),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: listenerSet.Generation,
+				LastTransitionTime: metav1.Now(),
+				Reason:             string(gatev1.ListenerSetReasonNotAllowed),
+				Message:            fmt.Sprintf("ListenerSet is not allowed by Gateway %s/%s", gateway.Namespace, gateway.Name),
+			}
+			result = append(result, loaded)
+			continue
+		}
+
+		if !isGatewayAccepted(selectedGateways, gatewayName) {
+			loaded.condition = &metav1.Condition{
+				Type:               string(gatev1.ListenerSetConditionAccepted),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: listenerSet.Generation,
+				LastTransitionTime: metav1.Now(),
+				Reason:             string(gatev1.ListenerSetReasonParentNotAccepted),
+				Message:            fmt.Sprintf("Parent Gateway %s/%s is not accepted", gateway.Namespace, gateway.Name),
+			}
+			result = append(result, loaded)
+			continue
+		}
+
+		allocated, ok := allocatedListeners[gatewayName]
+		if !ok {
+			// The Gateway listeners always take precedence over the ListenerSet ones.
+			allocated = make(map[string]struct{})
+			for _, listener := range gateway.Spec.Listeners {
+				allocated[makeListenerKey(listener)] = struct{}{}
+			}
+			allocatedListeners[gatewayName] = allocated
+		}
+
+		loaded.listeners = p.loadListenerSetListeners(logger.WithContext(ctx), listenerSet, allocated, conf)
+		result = append(result, loaded)
+	}
+
+	return result
+}
+
+// loadListenerSetListeners loads the listeners of the given ListenerSet,
+// skipping the ones conflicting with the already allocated listeners.
+func (p *Provider) loadListenerSetListeners(ctx context.Context, listenerSet *gatev1.ListenerSet, allocated map[string]struct{}, conf *dynamic.Configuration) []gatewayListener {
+	listeners := make([]gatewayListener, len(listenerSet.Spec.Listeners))
+
+	var entries []gatev1.Listener
+	var entryIndexes []int
+	for i, entry := range listenerSet.Spec.Listeners {
+		listener := gatev1.Listener{
+			Name:          entry.Name,
+			Hostname:      entry.Hostname,
+			Port:          entry.Port,
+			Protocol:      entry.Protocol,
+			TLS:           entry.TLS,
+			AllowedRoutes: entry.AllowedRoutes,
+		}
+
+		listenerKey := makeListenerKey(listener)
+		if _, ok := allocated[listenerKey]; ok {
+			listeners[i] = gatewayListener{
+				Name:     string(entry.Name),
+				Port:     entry.Port,
+				Protocol: entry.Protocol,
+				TLS:      entry.TLS,
+				Hostname: entry.Hostname,
+				Status: &gatev1.ListenerStatus{
+					Name:           entry.Name,
+					SupportedKinds: []gatev1.RouteGroupKind{},
+					Conditions: []metav1.Condition{{
+						Type:               string(gatev1.ListenerEntryConditionConflicted),
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: listenerSet.Generation,
+						LastTransitionTime: metav1.Now(),
+						Reason:             string(gatev1.ListenerEntryReasonHostnameConflict),
+						Message:            "A listener with same protocol, port and hostname already exists on the parent Gateway or on another ListenerSet",
+					}},
+				},
+			}
+			continue
+		}
+
+		allocated[listenerKey] = struct{}{}
+		entries = append(entries, listener)
+		entryIndexes = append(entryIndexes, i)
+	}
+
+	// The ListenerSet listeners are loaded the same way as the listeners of a Gateway living in the ListenerSet namespace.
+	gateway := &gatev1.Gateway{
+		ObjectMeta: listenerSet.ObjectMeta,
+		Spec:       gatev1.GatewaySpec{Listeners: entries},
+	}
+
+	for i, listener := range p.loadGatewayListeners(ctx, kindListenerSet, gateway, conf) {
+		listeners[entryIndexes[i]] = listener
+	}
+
+	return listeners
+}
+
+// isListenerSetAllowed returns whether the given Gateway allows ListenerSets from the given namespace to attach to it.
+func (p *Provider) isListenerSetAllowed(gateway *gatev1.Gateway, namespace string) (bool, error) {
+	// As per the specification, no ListenerSets are allowed by default.
+	if gateway.Spec.AllowedListeners == nil || gateway.Spec.AllowedListeners.Namespaces == nil {
+		return false, nil
+	}
+
+	listenerNamespaces := gateway.Spec.AllowedListeners.Namespaces
+	if ptr.Deref(listenerNamespaces.From, gatev1.NamespacesFromNone) == gatev1.NamespacesFromNone {
+		return false, nil
+	}
+
+	allowedNamespaces, err := p.allowedNamespaces(gateway.Namespace, &gatev1.RouteNamespaces{
+		From:     listenerNamespaces.From,
+		Selector: listenerNamespaces.Selector,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return slices.ContainsFunc(allowedNamespaces, func(allowedNamespace string) bool {
+		return allowedNamespace == metav1.NamespaceAll || allowedNamespace == namespace
+	}), nil
+}
+
+// findListenerSetParent returns the Gateway targeted by the given ListenerSet, or nil if it is not managed by this controller.
+func findListenerSetParent(gateways []*gatev1.Gateway, listenerSet *gatev1.ListenerSet) *gatev1.Gateway {
+	parentRef := listenerSet.Spec.ParentRef
+	if ptr.Deref(parentRef.Group, gatev1.GroupName) != gatev1.GroupName || ptr.Deref(parentRef.Kind, kindGateway) != kindGateway {
+		return nil
+	}
+
+	namespace := string(ptr.Deref(parentRef.Namespace, gatev1.Namespace(listenerSet.Namespace)))
+	for _, gateway := range gateways {
+		if gateway.Namespace == namespace && gateway.Name == string(parentRef.Name) {
+			return gateway
+		}
+	}
+
+	return nil
+}
+
+// isGatewayAccepted returns whether all the listeners of the given Gateway are valid,
+// which is the condition for the Gateway to be accepted.
+func isGatewayAccepted(gateways []gatewayWithListeners, gatewayName ktypes.NamespacedName) bool {
+	for _, gateway := range gateways {
+		if gateway.ListenerSet || gateway.Name != gatewayName.Name || gateway.Namespace != gatewayName.Namespace {
+			continue
+		}
+
+		return !slices.ContainsFunc(gateway.listeners, func(listener gatewayListener) bool {
+			return len(listener.Status.Conditions) > 0
+		})
+	}
+
+	return false
+}
+
+// makeListenerSetStatus builds the status of the given ListenerSet from its attachment condition and its listeners status.
+func makeListenerSetStatus(listenerSet listenerSetWithListeners) gatev1.ListenerSetStatus {
+	generation := listenerSet.listenerSet.Generation
+
+	if listenerSet.condition != nil {
+		return gatev1.ListenerSetStatus{
+			Conditions: []metav1.Condition{
+				*listenerSet.condition,
+				{
+					Type:               string(gatev1.ListenerSetConditionProgrammed),
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: generation,
+					LastTransitionTime: metav1.Now(),
+					Reason:             listenerSet.condition.Reason,
+					Message:            listenerSet.condition.Message,
+				},
+			},
+		}
+	}
+
+	var status gatev1.ListenerSetStatus
+	listenersValid := true
+	for _, listener := range listenerSet.listeners {
+		conditions := listener.Status.Conditions
+		if len(conditions) == 0 {
+			conditions = []metav1.Condition{
+				{
+					Type:               string(gatev1.ListenerEntryConditionAccepted),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: generation,
+					LastTransitionTime: metav1.Now(),
+					Reason:             string(gatev1.ListenerEntryReasonAccepted),
+					Message:            "No error found",
+				},
+				{
+					Type:               string(gatev1.ListenerEntryConditionResolvedRefs),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: generation,
+					LastTransitionTime: metav1.Now(),
+					Reason:             string(gatev1.ListenerEntryReasonResolvedRefs),
+					Message:            "No error found",
+				},
+				{
+					Type:               string(gatev1.ListenerEntryConditionProgrammed),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: generation,
+					LastTransitionTime: metav1.Now(),
+					Reason:             string(gatev1.ListenerEntryReasonProgrammed),
+					Message:            "No error found",
+				},
+			}
+		} else {
+			listenersValid = false
+		}
+
+		status.Listeners = append(status.Listeners, gatev1.ListenerEntryStatus{
+			Name:           listener.Status.Name,
+			SupportedKinds: listener.Status.SupportedKinds,
+			AttachedRoutes: listener.Status.AttachedRoutes,
+			Conditions:     conditions,
+		})
+	}
+
+	if !listenersValid {
+		status.Conditions = append(status.Conditions, metav1.Condition{
+			Type:               string(gatev1.ListenerSetConditionAccepted),
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(gatev1.ListenerSetReasonListenersNotValid),
+			Message:            "All Listeners must be valid",
+		})
+
+		return status
+	}
+
+	status.Conditions = append(status.Conditions,
+		metav1.Condition{
+			Type:               string(gatev1.ListenerSetConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(gatev1.ListenerSetReasonAccepted),
+			Message:            "ListenerSet successfully attached",
+		},
+		metav1.Condition{
+			Type:               string(gatev1.ListenerSetConditionProgrammed),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(gatev1.ListenerSetReasonProgrammed),
+			Message:            "ListenerSet successfully attached",
+		},
+	)
+
+	return status
+}
@@ -15,11 +15,15 @@ import (
 type statusReport struct {
 	gatewayClasses     map[string]gatev1.GatewayClassStatus
 	gateways           map[ktypes.NamespacedName]gatev1.GatewayStatus
+	listenerSets       map[ktypes.NamespacedName]gatev1.ListenerSetStatus
 	httpRoutes         map[ktypes.NamespacedName]gatev1.RouteStatus
 	grpcRoutes         map[ktypes.NamespacedName]gatev1.RouteStatus
 	tcpRoutes          map[ktypes.NamespacedName]gatev1.RouteStatus
 	tlsRoutes          map[ktypes.NamespacedName]gatev1.RouteStatus
+	udpRoutes          map[ktypes.NamespacedName]gatev1.RouteStatus
 	backendTLSPolicies map[ktypes.NamespacedName]gatev1.PolicyStatus
+	// backendTrafficPolicies holds the XBackendTrafficPolicy statuses.
+	backendTrafficPolicies map[ktypes.NamespacedName]gatev1.PolicyStatus
 
 	gatewayListeners []gatewayWithListeners
 }
@@ -28,11 +32,15 @@ func newStatusReport() *statusReport {
 	return &statusReport{
 		gatewayClasses:     map[string]gatev1.GatewayClassStatus{},
 		gateways:           map[ktypes.NamespacedName]gatev1.GatewayStatus{},
+		listenerSets:       map[ktypes.NamespacedName]gatev1.ListenerSetStatus{},
 		httpRoutes:         map[ktypes.NamespacedName]gatev1.RouteStatus{},
 		grpcRoutes:         map[ktypes.NamespacedName]gatev1.RouteStatus{},
 		tcpRoutes:          map[ktypes.NamespacedName]gatev1.RouteStatus{},
 		tlsRoutes:          map[ktypes.NamespacedName]gatev1.RouteStatus{},
+		udpRoutes:          map[ktypes.NamespacedName]gatev1.RouteStatus{},
 		backendTLSPolicies: map[ktypes.NamespacedName]gatev1.PolicyStatus{},
+
+		backendTrafficPolicies: map[ktypes.NamespacedName]gatev1.PolicyStatus{},
 	}
 }
 
@@ -53,6 +61,12 @@ func (r *statusReport) Flush(ctx context.Context, client *clientWrapper) {
 		}
 	}
 
+	for name, status := range r.listenerSets {
+		if err := client.UpdateListenerSetStatus(ctx, name, status); err != nil {
+			logger.Warn().Err(err).Str("listener_set", name.Name).Str("namespace", name.Namespace).Msg("Unable to update ListenerSet status")
+		}
+	}
+
 	for name, routeStatus := range r.httpRoutes {
 		status := gatev1.HTTPRouteStatus{RouteStatus: routeStatus}
 		if err := client.UpdateHTTPRouteStatus(ctx, name, r.gatewayListeners, status); err != nil {
@@ -81,11 +95,24 @@ func (r *statusReport) Flush(ctx context.Context, client *clientWrapper) {
 		}
 	}
 
+	for name, routeStatus := range r.udpRoutes {
+		status := gatev1alpha2.UDPRouteStatus{RouteStatus: routeStatus}
+		if err := client.UpdateUDPRouteStatus(ctx, name, r.gatewayListeners, status); err != nil {
+			logger.Warn().Err(err).Str("udp_route", name.Name).Str("namespace", name.Namespace).Msg("Unable to update UDPRoute status")
+		}
+	}
+
 	for name, policyStatus := range r.backendTLSPolicies {
 		if err := client.UpdateBackendTLSPolicyStatus(ctx, name, policyStatus); err != nil {
 			logger.Warn().Err(err).Str("backend_tls_policy", name.Name).Str("namespace", name.Namespace).Msg("Unable to update BackendTLSPolicy status")
 		}
 	}
+
+	for name, policyStatus := range r.backendTrafficPolicies {
+		if err := client.UpdateBackendTrafficPolicyStatus(ctx, name, policyStatus); err != nil {
+			logger.Warn().Err(err).Str("backend_traffic_policy", name.Name).Str("namespace", name.Namespace).Msg("Unable to update XBackendTrafficPolicy status")
+		}
+	}
 }
 
 func (r *statusReport) RecordGatewayClassStatus(gatewayClassName string, status gatev1.GatewayClassStatus) {
@@ -96,6 +123,10 @@ func (r *statusReport) RecordGatewayStatus(gateway ktypes.NamespacedName, status
 	r.gateways[gateway] = status
 }
 
+func (r *statusReport) RecordListenerSetStatus(listenerSet ktypes.NamespacedName, status gatev1.ListenerSetStatus) {
+	r.listenerSets[listenerSet] = status
+}
+
 func (r *statusReport) RecordHTTPRouteStatus(route ktypes.NamespacedName, status gatev1.RouteParentStatus) {
 	r.httpRoutes[route] = gatev1.RouteStatus{
 		Parents: append(r.httpRoutes[route].Parents, status),
@@ -120,11 +151,26 @@ func (r *statusReport) RecordTLSRouteStatus(route ktypes.NamespacedName, status
 	}
 }
 
+func (r *statusReport) RecordUDPRouteStatus(route ktypes.NamespacedName, status gatev1.RouteParentStatus) {
+	r.udpRoutes[route] = gatev1.RouteStatus{
+		Parents: append(r.udpRoutes[route].Parents, status),
+	}
+}
+
 func (r *statusReport) RecordBackendTLSPolicyStatus(policy ktypes.NamespacedName, status gatev1.PolicyAncestorStatus) {
+	r.backendTLSPolicies[policy] = mergePolicyAncestorStatus(r.backendTLSPolicies[policy], status)
+}
+
+func (r *statusReport) RecordBackendTrafficPolicyStatus(policy ktypes.NamespacedName, status gatev1.PolicyAncestorStatus) {
+	r.backendTrafficPolicies[policy] = mergePolicyAncestorStatus(r.backendTrafficPolicies[policy], status)
+}
+
+// mergePolicyAncestorStatus merges the given ancestor status into the given policy status.
+func mergePolicyAncestorStatus(policyStatus gatev1.PolicyStatus, status gatev1.PolicyAncestorStatus) gatev1.PolicyStatus {
 	var ancestors []gatev1.PolicyAncestorStatus
 
 	// Keep existing ancestor statuses, except if it matches the status to merge.
-	for _, existing := range r.backendTLSPolicies[policy].Ancestors {
+	for _, existing := range policyStatus.Ancestors {
 		if reflect.DeepEqual(existing.AncestorRef, status.AncestorRef) {
 			continue
 		}
@@ -132,7 +178,7 @@ func (r *statusReport) RecordBackendTLSPolicyStatus(policy ktypes.NamespacedName
 		ancestors = append(ancestors, existing)
 	}
 
-	r.backendTLSPolicies[policy] = gatev1.PolicyStatus{
+	return gatev1.PolicyStatus{
 		Ancestors: append(ancestors, status), // Add the new status to the existing ancestors statuses.
 	}
 }
@@ -968,7 +968,8 @@ func (p *Provider) validateIngress(ing *netv1.Ingress, cfg IngressConfig) error
 	if !p.AllowSnippetAnnotations &&
 		(ptr.Deref(cfg.ServerSnippet, "") != "" ||
 			ptr.Deref(cfg.ConfigurationSnippet, "") != "" ||
-			ptr.Deref(cfg.AuthSnippet, "") != "") {
+			ptr.Deref(cfg.AuthSnippet, "") != "" ||
+			ptr.Deref(cfg.ModSecuritySnippet, "") != "") {
 		return errors.New("snippet annotations are not allowed when allowSnippetAnnotations is disabled")
 	}
 
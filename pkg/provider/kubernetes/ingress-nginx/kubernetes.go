@@ -126,6 +126,8 @@ type Provider struct {
 	GlobalAllowedResponseHeaders []string `description:"List of allowed response headers inside the custom headers annotations." json:"globalAllowedResponseHeaders,omitempty" toml:"globalAllowedResponseHeaders,omitempty" yaml:"globalAllowedResponseHeaders,omitempty" export:"true"`
 	AllowSnippetAnnotations      bool     `description:"Enables to parse and add -snippet annotations/directives." json:"allowSnippetAnnotations,omitempty" toml:"allowSnippetAnnotations,omitempty" yaml:"allowSnippetAnnotations,omitempty" export:"true"`
 	StrictValidatePathType       bool     `description:"Defines whether to reject the entire ingress when any path contains regex characters and pathType is Prefix or Exact." json:"strictValidatePathType,omitempty" toml:"strictValidatePathType,omitempty" yaml:"strictValidatePathType,omitempty" export:"true"`
+	ModSecurityRuleFiles         []string `description:"SecLang rule files loaded by the WAF middleware when ModSecurity is enabled with the enable-modsecurity annotation." json:"modSecurityRuleFiles,omitempty" toml:"modSecurityRuleFiles,omitempty" yaml:"modSecurityRuleFiles,omitempty" export:"true"`
+	OWASPCoreRuleFiles           []string `description:"SecLang rule files of the OWASP Core Rule Set loaded by the WAF middleware when the enable-owasp-core-rules annotation is set." json:"owaspCoreRuleFiles,omitempty" toml:"owaspCoreRuleFiles,omitempty" yaml:"owaspCoreRuleFiles,omitempty" export:"true"`
 
 	allowedHeaders                 []string
 	defaultBackendServiceNamespace string
@@ -36,6 +36,8 @@ func TestLoadIngresses(t *testing.T) {
 		globalAuthURL                  string
 		strictValidatePathType         *bool
 		proxyRequestBuffering          bool
+		modSecurityRuleFiles           []string
+		owaspCoreRuleFiles             []string
 		paths                          []string
 		expected                       *dynamic.Configuration
 	}{
@@ -9411,6 +9413,171 @@ func TestLoadIngresses(t *testing.T) {
 				TLS: &dynamic.TLSConfiguration{},
 			},
 		},
+		{
+			desc:                    "ModSecurity with OWASP core rules",
+			allowSnippetAnnotations: true,
+			modSecurityRuleFiles:    []string{"/etc/modsecurity/modsecurity.conf"},
+			owaspCoreRuleFiles:      []string{"/etc/modsecurity/crs-setup.conf", "/etc/modsecurity/rules/*.conf"},
+			paths: []string{
+				"services.yml",
+				"ingressclasses.yml",
+				"ingresses/ingress-with-modsecurity.yml",
+			},
+			expected: &dynamic.Configuration{
+				TCP: &dynamic.TCPConfiguration{
+					Routers:  map[string]*dynamic.TCPRouter{},
+					Services: map[string]*dynamic.TCPService{},
+				},
+				HTTP: &dynamic.HTTPConfiguration{
+					Routers: map[string]*dynamic.Router{
+						"default-ingress-with-modsecurity-rule-0-path-0": {
+							EntryPoints: []string{"http"},
+							Rule:        `Host("hostname.localhost") && Path("/")`,
+							RuleSyntax:  "default",
+							Middlewares: []string{"default-ingress-with-modsecurity-rule-0-path-0-modsecurity", "default-ingress-with-modsecurity-rule-0-path-0-retry"},
+							Service:     "default-ingress-with-modsecurity-whoami-80",
+							Observability: &dynamic.RouterObservabilityConfig{
+								Metadata: &dynamic.ObservabilityMetadata{
+									Ingress: &dynamic.KubernetesIngressMetadata{
+										Namespace:   "default",
+										IngressName: "ingress-with-modsecurity",
+										ServiceName: "whoami",
+										ServicePort: "80",
+									},
+								},
+							},
+						},
+						"default-ingress-with-modsecurity-rule-0-path-0-tls": {
+							EntryPoints: []string{"https"},
+							Rule:        `Host("hostname.localhost") && Path("/")`,
+							RuleSyntax:  "default",
+							Middlewares: []string{"default-ingress-with-modsecurity-rule-0-path-0-tls-modsecurity", "default-ingress-with-modsecurity-rule-0-path-0-tls-retry"},
+							Service:     "default-ingress-with-modsecurity-whoami-80",
+							Observability: &dynamic.RouterObservabilityConfig{
+								Metadata: &dynamic.ObservabilityMetadata{
+									Ingress: &dynamic.KubernetesIngressMetadata{
+										Namespace:   "default",
+										IngressName: "ingress-with-modsecurity",
+										ServiceName: "whoami",
+										ServicePort: "80",
+									},
+								},
+							},
+							TLS: &dynamic.RouterTLSConfig{},
+						},
+					},
+					Middlewares: map[string]*dynamic.Middleware{
+						"default-ingress-with-modsecurity-rule-0-path-0-modsecurity": {
+							WAF: &dynamic.WAF{
+								RuleFiles: []string{
+									"/etc/modsecurity/modsecurity.conf",
+									"/etc/modsecurity/crs-setup.conf",
+									"/etc/modsecurity/rules/*.conf",
+								},
+								Rules:               "SecRuleEngine DetectionOnly\n",
+								MaxRequestBodyBytes: defaultProxyBodySize,
+								MemRequestBodyBytes: defaultClientBodyBufferSize,
+							},
+						},
+						"default-ingress-with-modsecurity-rule-0-path-0-tls-modsecurity": {
+							WAF: &dynamic.WAF{
+								RuleFiles: []string{
+									"/etc/modsecurity/modsecurity.conf",
+									"/etc/modsecurity/crs-setup.conf",
+									"/etc/modsecurity/rules/*.conf",
+								},
+								Rules:               "SecRuleEngine DetectionOnly\n",
+								MaxRequestBodyBytes: defaultProxyBodySize,
+								MemRequestBodyBytes: defaultClientBodyBufferSize,
+							},
+						},
+						"default-ingress-with-modsecurity-rule-0-path-0-retry": {
+							Retry: &dynamic.Retry{
+								Attempts:            3,
+								MaxRequestBodyBytes: new(defaultProxyBodySize),
+							},
+						},
+						"default-ingress-with-modsecurity-rule-0-path-0-tls-retry": {
+							Retry: &dynamic.Retry{
+								Attempts:            3,
+								MaxRequestBodyBytes: new(defaultProxyBodySize),
+							},
+						},
+					},
+					Services: map[string]*dynamic.Service{
+						"unavailable-service": {
+							LoadBalancer: &dynamic.ServersLoadBalancer{
+								Strategy:       "wrr",
+								PassHostHeader: new(true),
+								ResponseForwarding: &dynamic.ResponseForwarding{
+									FlushInterval: dynamic.DefaultFlushInterval,
+								},
+							},
+						},
+						"default-ingress-with-modsecurity-whoami-80": {
+							LoadBalancer: &dynamic.ServersLoadBalancer{
+								Servers: []dynamic.Server{
+									{
+										URL: "http://10.10.0.1:80",
+									},
+									{
+										URL: "http://10.10.0.2:80",
+									},
+								},
+								Strategy:       "wrr",
+								PassHostHeader: new(true),
+								ResponseForwarding: &dynamic.ResponseForwarding{
+									FlushInterval: dynamic.DefaultFlushInterval,
+								},
+								ServersTransport: "default-ingress-with-modsecurity",
+							},
+						},
+					},
+					ServersTransports: map[string]*dynamic.ServersTransport{
+						"default-ingress-with-modsecurity": {
+							ForwardingTimeouts: &dynamic.ForwardingTimeouts{
+								DialTimeout:     ptypes.Duration(60 * time.Second),
+								ReadTimeout:     ptypes.Duration(60 * time.Second),
+								WriteTimeout:    ptypes.Duration(60 * time.Second),
+								IdleConnTimeout: ptypes.Duration(60 * time.Second),
+							},
+						},
+					},
+				},
+				TLS: &dynamic.TLSConfiguration{},
+			},
+		},
+		{
+			desc: "ModSecurity snippet - allowSnippetAnnotations disabled",
+			paths: []string{
+				"services.yml",
+				"ingressclasses.yml",
+				"ingresses/ingress-with-modsecurity.yml",
+			},
+			expected: &dynamic.Configuration{
+				TCP: &dynamic.TCPConfiguration{
+					Routers:  map[string]*dynamic.TCPRouter{},
+					Services: map[string]*dynamic.TCPService{},
+				},
+				HTTP: &dynamic.HTTPConfiguration{
+					Routers:     map[string]*dynamic.Router{},
+					Middlewares: map[string]*dynamic.Middleware{},
+					Services: map[string]*dynamic.Service{
+						"unavailable-service": {
+							LoadBalancer: &dynamic.ServersLoadBalancer{
+								Strategy:       "wrr",
+								PassHostHeader: new(true),
+								ResponseForwarding: &dynamic.ResponseForwarding{
+									FlushInterval: dynamic.DefaultFlushInterval,
+								},
+							},
+						},
+					},
+					ServersTransports: map[string]*dynamic.ServersTransport{},
+				},
+				TLS: &dynamic.TLSConfiguration{},
+			},
+		},
 		{
 			desc:                  "Proxy request buffering off keeps network retry when no status codes are set",
 			proxyRequestBuffering: true,
@@ -17422,6 +17589,8 @@ func TestLoadIngresses(t *testing.T) {
 				AllowCrossNamespaceResources:   test.allowCrossNamespaceResources,
 				GlobalAuthURL:                  test.globalAuthURL,
 				ProxyRequestBuffering:          test.proxyRequestBuffering,
+				ModSecurityRuleFiles:           test.modSecurityRuleFiles,
+				OWASPCoreRuleFiles:             test.owaspCoreRuleFiles,
 			}
 			p.SetDefaults()
 			if test.strictValidatePathType != nil {
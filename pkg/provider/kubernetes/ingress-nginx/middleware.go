@@ -40,6 +40,7 @@ func (p *Provider) buildMiddlewares(ctx context.Context, loc *location, hostname
 	p.buildAuthTLSPassCert(loc)
 	p.buildCustomHeaders(loc)
 	p.buildSnippetAuth(loc)
+	p.buildModSecurity(ctx, loc)
 	p.buildRetry(ctx, loc, endpointCount)
 }
 
@@ -420,6 +421,73 @@ func (p *Provider) buildSnippetAuth(loc *location) {
 	loc.SnippetAuth = sa
 }
 
+func (p *Provider) buildModSecurity(ctx context.Context, loc *location) {
+	// The ModSecurity annotations are left to the external integration when one is set.
+	if p.applyMiddlewareFunc != nil {
+		return
+	}
+
+	logger := log.Ctx(ctx)
+
+	if !ptr.Deref(loc.Config.EnableModSecurity, false) {
+		if ptr.Deref(loc.Config.EnableOWASPCoreRules, false) ||
+			ptr.Deref(loc.Config.ModSecuritySnippet, "") != "" ||
+			ptr.Deref(loc.Config.ModSecurityTransactionID, "") != "" {
+			logger.Warn().Msg("mod-security annotations are ignored when enable-modsecurity is not set")
+		}
+		return
+	}
+
+	if ptr.Deref(loc.Config.ModSecurityTransactionID, "") != "" {
+		logger.Warn().Msg("modsecurity-transaction-id annotation is not supported")
+	}
+
+	ruleFiles := slices.Clone(p.ModSecurityRuleFiles)
+	if ptr.Deref(loc.Config.EnableOWASPCoreRules, false) {
+		if len(p.OWASPCoreRuleFiles) == 0 {
+			logger.Warn().Msg("enable-owasp-core-rules annotation requires owaspCoreRuleFiles to be set")
+		}
+		ruleFiles = append(ruleFiles, p.OWASPCoreRuleFiles...)
+	}
+
+	rules := ptr.Deref(loc.Config.ModSecuritySnippet, "")
+
+	if len(ruleFiles) == 0 && strings.TrimSpace(rules) == "" {
+		logger.Warn().Msg("enable-modsecurity annotation requires modSecurityRuleFiles or a modsecurity-snippet annotation")
+		return
+	}
+
+	waf := &dynamic.WAF{
+		RuleFiles:           ruleFiles,
+		Rules:               rules,
+		MemRequestBodyBytes: p.ClientBodyBufferSize,
+		MaxRequestBodyBytes: p.ProxyBodySize,
+	}
+
+	if s := ptr.Deref(loc.Config.ClientBodyBufferSize, ""); s != "" {
+		if v, err := nginxSizeToBytes(s); err != nil {
+			logger.Warn().Err(err).Msg("client-body-buffer-size invalid, using provider default")
+		} else {
+			waf.MemRequestBodyBytes = v
+		}
+	}
+	if s := ptr.Deref(loc.Config.ProxyBodySize, ""); s != "" {
+		if v, err := nginxSizeToBytes(s); err != nil {
+			logger.Warn().Err(err).Msg("proxy-body-size invalid, using provider default")
+		} else {
+			waf.MaxRequestBodyBytes = v
+		}
+	}
+
+	// The WAF engine supports request body limits up to 1Gi, and buffers in memory at most the limit.
+	waf.MaxRequestBodyBytes = min(waf.MaxRequestBodyBytes, 1<<30)
+	if waf.MaxRequestBodyBytes > 0 {
+		waf.MemRequestBodyBytes = min(waf.MemRequestBodyBytes, waf.MaxRequestBodyBytes)
+	}
+
+	loc.WAF = waf
+}
+
 func (p *Provider) buildRetry(ctx context.Context, loc *location, endpointCount int) {
 	attempts := ptr.Deref(loc.Config.ProxyNextUpstreamTries, p.ProxyNextUpstreamTries)
 	// Safeguard to deactivate retry when the value is less than 0.
@@ -214,6 +214,9 @@ type location struct {
 	// SnippetAuth, if non-nil, configures server/configuration snippets and forward auth.
 	SnippetAuth *dynamic.Snippet
 
+	// WAF, if non-nil, evaluates the ModSecurity rules against the requests.
+	WAF *dynamic.WAF
+
 	// Retry, if non-nil, configures request retry behavior.
 	Retry *dynamic.Retry
 
@@ -525,6 +525,12 @@ func (p *Provider) applyMiddlewares(mc *model, loc *location, routerKey string,
 		rt.Middlewares = append(rt.Middlewares, name)
 	}
 
+	if loc.WAF != nil {
+		name := routerKey + "-modsecurity"
+		conf.HTTP.Middlewares[name] = &dynamic.Middleware{WAF: loc.WAF}
+		rt.Middlewares = append(rt.Middlewares, name)
+	}
+
 	if loc.Retry != nil {
 		name := routerKey + "-retry"
 		conf.HTTP.Middlewares[name] = &dynamic.Middleware{Retry: loc.Retry}
@@ -535,11 +541,6 @@ func (p *Provider) applyMiddlewares(mc *model, loc *location, routerKey string,
 		if err := p.applyMiddlewareFunc(routerKey, rt, conf, loc.Config); err != nil {
 			log.Error().Err(err).Str("router", routerKey).Msg("Error in ApplyMiddlewareFunc")
 		}
-	} else if ptr.Deref(loc.Config.EnableModSecurity, false) ||
-		ptr.Deref(loc.Config.EnableOWASPCoreRules, false) ||
-		ptr.Deref(loc.Config.ModSecuritySnippet, "") != "" ||
-		ptr.Deref(loc.Config.ModSecurityTransactionID, "") != "" {
-		log.Error().Str("router", routerKey).Msg("mod-security annotations require ApplyMiddlewareFunc to be set")
 	}
 }
 
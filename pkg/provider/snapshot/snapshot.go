@@ -0,0 +1,214 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	gokitmetrics "github.com/go-kit/kit/metrics"
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/observability/logs"
+)
+
+const fileExtension = ".json"
+
+// Store persists the last configuration of the providers,
+// and serves it at startup until the providers provide a fresh configuration.
+type Store struct {
+	directory string
+	providers []string
+
+	mu        sync.RWMutex
+	snapshots map[string]*snapshot
+	gauge     gokitmetrics.Gauge
+}
+
+// Info describes the snapshot of a provider configuration.
+type Info struct {
+	Provider  string    `json:"provider"`
+	CreatedAt time.Time `json:"createdAt"`
+	Age       string    `json:"age"`
+	// Serving is true when the snapshot is served because the provider has not provided a fresh configuration yet.
+	Serving bool `json:"serving"`
+}
+
+// snapshot is the content of a snapshot file.
+type snapshot struct {
+	CreatedAt     time.Time              `json:"createdAt"`
+	Configuration *dynamic.Configuration `json:"configuration"`
+
+	serving bool
+}
+
+// NewStore creates a Store persisting the configuration of the given providers in the given directory.
+func NewStore(directory string, providers []string) (*Store, error) {
+	if directory == "" {
+		return nil, errors.New("directory is required")
+	}
+
+	if len(providers) == 0 {
+		return nil, errors.New("at least one provider is required")
+	}
+
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return nil, fmt.Errorf("creating snapshots directory: %w", err)
+	}
+
+	return &Store{
+		directory: directory,
+		providers: providers,
+		snapshots: make(map[string]*snapshot),
+	}, nil
+}
+
+// SetGauge sets the gauge reporting, for each provider, the creation timestamp of the snapshot being served.
+func (s *Store) SetGauge(gauge gokitmetrics.Gauge) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.gauge = gauge
+}
+
+// Load returns the configuration snapshots of the providers.
+// The returned configurations are served until the providers provide a fresh configuration.
+func (s *Store) Load(ctx context.Context) map[string]*dynamic.Configuration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	configurations := make(map[string]*dynamic.Configuration)
+	for _, providerName := range s.providers {
+		logger := log.Ctx(ctx).With().Str(logs.ProviderName, providerName).Logger()
+
+		data, err := os.ReadFile(s.path(providerName))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("Cannot read the configuration snapshot")
+			continue
+		}
+
+		snap := &snapshot{}
+		if err := json.Unmarshal(data, snap); err != nil || snap.Configuration == nil {
+			logger.Error().Err(err).Msg("Cannot decode the configuration snapshot")
+			continue
+		}
+
+		snap.serving = true
+		s.snapshots[providerName] = snap
+		s.setGauge(providerName, snap)
+
+		logger.Info().Msgf("Serving the configuration snapshot from %s until the provider is ready", snap.CreatedAt.Format(time.RFC3339))
+
+		configurations[providerName] = snap.Configuration.DeepCopy()
+	}
+
+	return configurations
+}
+
+// Update records the fresh configuration provided by the given provider,
+// and writes its snapshot when it differs from the previous one.
+func (s *Store) Update(ctx context.Context, providerName string, configuration *dynamic.Configuration) {
+	if !slices.Contains(s.providers, providerName) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous := s.snapshots[providerName]
+	if previous != nil && previous.serving {
+		log.Ctx(ctx).Info().Str(logs.ProviderName, providerName).Msg("The provider is ready, no longer serving the configuration snapshot")
+	}
+
+	if previous != nil && reflect.DeepEqual(previous.Configuration, configuration) {
+		previous.serving = false
+		s.setGauge(providerName, previous)
+		return
+	}
+
+	snap := &snapshot{
+		CreatedAt:     time.Now().UTC(),
+		Configuration: configuration.DeepCopy(),
+	}
+
+	if err := s.write(providerName, snap); err != nil {
+		log.Ctx(ctx).Error().Err(err).Str(logs.ProviderName, providerName).Msg("Cannot write the configuration snapshot")
+	}
+
+	s.snapshots[providerName] = snap
+	s.setGauge(providerName, snap)
+}
+
+// Snapshots returns the information of the snapshots, sorted by provider name.
+func (s *Store) Snapshots() []Info {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	infos := make([]Info, 0, len(s.snapshots))
+	for providerName, snap := range s.snapshots {
+		infos = append(infos, Info{
+			Provider:  providerName,
+			CreatedAt: snap.CreatedAt,
+			Age:       time.Since(snap.CreatedAt).Round(time.Second).String(),
+			Serving:   snap.serving,
+		})
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Provider < infos[j].Provider
+	})
+
+	return infos
+}
+
+// write replaces atomically the snapshot file of the given provider.
+func (s *Store) write(providerName string, snap *snapshot) error {
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(s.directory, providerName+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	if _, err = tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+
+	if err = tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), s.path(providerName))
+}
+
+func (s *Store) setGauge(providerName string, snap *snapshot) {
+	if s.gauge == nil {
+		return
+	}
+
+	var timestamp float64
+	if snap.serving {
+		timestamp = float64(snap.CreatedAt.Unix())
+	}
+
+	s.gauge.With("provider", providerName).Set(timestamp)
+}
+
+func (s *Store) path(providerName string) string {
+	return filepath.Join(s.directory, providerName+fileExtension)
+}
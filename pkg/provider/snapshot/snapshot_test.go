@@ -0,0 +1,121 @@
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestNewStore(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		directory string
+		providers []string
+		expectErr string
+	}{
+		{
+			desc:      "missing directory",
+			providers: []string{"consul"},
+			expectErr: "directory is required",
+		},
+		{
+			desc:      "missing providers",
+			directory: "snapshots",
+			expectErr: "at least one provider is required",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewStore(test.directory, test.providers)
+			assert.EqualError(t, err, test.expectErr)
+		})
+	}
+}
+
+func TestStore_LoadAndUpdate(t *testing.T) {
+	directory := filepath.Join(t.TempDir(), "snapshots")
+
+	configuration := &dynamic.Configuration{
+		HTTP: &dynamic.HTTPConfiguration{
+			Routers: map[string]*dynamic.Router{
+				"foo": {Service: "bar", Rule: "Host(`example.com`)"},
+			},
+		},
+	}
+
+	// First run: the provider configuration is snapshotted, the other providers are ignored.
+	store, err := NewStore(directory, []string{"consul"})
+	require.NoError(t, err)
+
+	store.Update(t.Context(), "consul", configuration)
+	store.Update(t.Context(), "docker", configuration)
+
+	assert.FileExists(t, filepath.Join(directory, "consul.json"))
+	assert.NoFileExists(t, filepath.Join(directory, "docker.json"))
+
+	infos := store.Snapshots()
+	require.Len(t, infos, 1)
+	assert.Equal(t, "consul", infos[0].Provider)
+	assert.False(t, infos[0].Serving)
+
+	// Second run: the snapshot is served until the provider provides a fresh configuration.
+	gauge := &gaugeMock{}
+
+	store, err = NewStore(directory, []string{"consul"})
+	require.NoError(t, err)
+	store.SetGauge(gauge)
+
+	configurations := store.Load(t.Context())
+	require.Contains(t, configurations, "consul")
+	assert.Equal(t, configuration.HTTP.Routers, configurations["consul"].HTTP.Routers)
+
+	infos = store.Snapshots()
+	require.Len(t, infos, 1)
+	assert.True(t, infos[0].Serving)
+	assert.Equal(t, []string{"provider", "consul"}, gauge.labels)
+	assert.InDelta(t, float64(infos[0].CreatedAt.Unix()), gauge.value, 0)
+
+	store.Update(t.Context(), "consul", configurations["consul"])
+
+	infos = store.Snapshots()
+	require.Len(t, infos, 1)
+	assert.False(t, infos[0].Serving)
+	assert.Zero(t, gauge.value)
+}
+
+func TestStore_Load_invalidSnapshot(t *testing.T) {
+	directory := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "consul.json"), []byte("{"), 0o600))
+
+	store, err := NewStore(directory, []string{"consul", "etcd"})
+	require.NoError(t, err)
+
+	assert.Empty(t, store.Load(t.Context()))
+	assert.Empty(t, store.Snapshots())
+}
+
+type gaugeMock struct {
+	labels []string
+	value  float64
+}
+
+func (g *gaugeMock) With(labelValues ...string) metrics.Gauge {
+	g.labels = labelValues
+	return g
+}
+
+func (g *gaugeMock) Set(value float64) {
+	g.value = value
+}
+
+func (g *gaugeMock) Add(delta float64) {
+	g.value += delta
+}
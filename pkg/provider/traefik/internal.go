@@ -81,6 +81,7 @@ func (i *Provider) createConfiguration(ctx context.Context) *dynamic.Configurati
 
 	i.apiConfiguration(cfg)
 	i.pingConfiguration(cfg)
+	i.cspReportConfiguration(cfg)
 	i.restConfiguration(cfg)
 	i.prometheusConfiguration(cfg)
 	i.entryPointModels(cfg)
@@ -359,6 +360,29 @@ func (i *Provider) pingConfiguration(cfg *dynamic.Configuration) {
 	cfg.HTTP.Services["ping"] = &dynamic.Service{}
 }
 
+func (i *Provider) cspReportConfiguration(cfg *dynamic.Configuration) {
+	if i.staticCfg.CSPReport == nil {
+		return
+	}
+
+	if !i.staticCfg.CSPReport.ManualRouting {
+		router := &dynamic.Router{
+			Service:  "csp-report@internal",
+			Priority: math.MaxInt,
+			Rule:     "Path(`" + i.staticCfg.CSPReport.Path + "`)",
+			// "default" stands for the default rule syntax in Traefik v3, i.e. the v3 syntax.
+			RuleSyntax: "default",
+		}
+		if i.staticCfg.CSPReport.EntryPoint != "" {
+			router.EntryPoints = []string{i.staticCfg.CSPReport.EntryPoint}
+		}
+
+		cfg.HTTP.Routers["csp-report"] = router
+	}
+
+	cfg.HTTP.Services["csp-report"] = &dynamic.Service{}
+}
+
 func (i *Provider) restConfiguration(cfg *dynamic.Configuration) {
 	if i.staticCfg.Providers == nil || i.staticCfg.Providers.Rest == nil {
 		return
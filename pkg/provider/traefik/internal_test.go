@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/traefik/traefik/v3/pkg/config/static"
+	"github.com/traefik/traefik/v3/pkg/cspreport"
 	otypes "github.com/traefik/traefik/v3/pkg/observability/types"
 	"github.com/traefik/traefik/v3/pkg/ping"
 	acmeprovider "github.com/traefik/traefik/v3/pkg/provider/acme"
@@ -127,6 +128,32 @@ func Test_createConfiguration(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "csp_report_simple.json",
+			staticCfg: static.Configuration{
+				CSPReport: &cspreport.Handler{
+					Path: "/csp-report",
+				},
+			},
+		},
+		{
+			desc: "csp_report_custom.json",
+			staticCfg: static.Configuration{
+				CSPReport: &cspreport.Handler{
+					EntryPoint: "websecure",
+					Path:       "/reports/csp",
+				},
+			},
+		},
+		{
+			desc: "csp_report_manual_routing.json",
+			staticCfg: static.Configuration{
+				CSPReport: &cspreport.Handler{
+					EntryPoint:    "websecure",
+					ManualRouting: true,
+				},
+			},
+		},
 		{
 			desc: "rest_insecure.json",
 			staticCfg: static.Configuration{
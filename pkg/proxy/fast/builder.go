@@ -11,6 +11,7 @@ import (
 
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
 	"github.com/traefik/traefik/v3/pkg/config/static"
+	"github.com/traefik/traefik/v3/pkg/observability/metrics"
 )
 
 // TransportManager manages transport used for backend communications.
@@ -21,8 +22,9 @@ type TransportManager interface {
 
 // ProxyBuilder handles the connection pools for the FastProxy proxies.
 type ProxyBuilder struct {
-	debug            bool
-	transportManager TransportManager
+	debug                  bool
+	transportManager       TransportManager
+	semConvMetricsRegistry *metrics.SemConvMetricsRegistry
 
 	// lock isn't needed because ProxyBuilder is not called concurrently.
 	pools map[string]map[string]*connPool
@@ -33,13 +35,14 @@ type ProxyBuilder struct {
 }
 
 // NewProxyBuilder creates a new ProxyBuilder.
-func NewProxyBuilder(transportManager TransportManager, config static.FastProxyConfig) *ProxyBuilder {
+func NewProxyBuilder(transportManager TransportManager, semConvMetricsRegistry *metrics.SemConvMetricsRegistry, config static.FastProxyConfig) *ProxyBuilder {
 	return &ProxyBuilder{
-		debug:            config.Debug,
-		transportManager: transportManager,
-		pools:            make(map[string]map[string]*connPool),
-		proxy:            http.ProxyFromEnvironment,
-		configs:          make(map[string]*dynamic.ServersTransport),
+		debug:                  config.Debug,
+		transportManager:       transportManager,
+		semConvMetricsRegistry: semConvMetricsRegistry,
+		pools:                  make(map[string]map[string]*connPool),
+		proxy:                  http.ProxyFromEnvironment,
+		configs:                make(map[string]*dynamic.ServersTransport),
 	}
 }
 
@@ -85,7 +88,14 @@ func (r *ProxyBuilder) Build(cfgName string, targetURL *url.URL, passHostHeader,
 	}
 
 	pool := r.getPool(cfgName, cfg, tlsConfig, targetURL, proxyURL)
-	return NewReverseProxy(targetURL, proxyURL, r.debug, passHostHeader, preservePath, pool)
+	proxy, err := NewReverseProxy(targetURL, proxyURL, r.debug, passHostHeader, preservePath, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrapping the proxy with the observability handler,
+	// to create, if necessary, the reverseProxy client span and the semConv client metric.
+	return newObservabilityHandler(r.semConvMetricsRegistry, proxy), nil
 }
 
 func (r *ProxyBuilder) getPool(cfgName string, config *dynamic.ServersTransport, tlsConfig *tls.Config, targetURL *url.URL, proxyURL *url.URL) *connPool {
@@ -23,6 +23,8 @@ type rwWithUpgrade struct {
 	ReqMethod string
 	RW        http.ResponseWriter
 	Upgrade   upgradeHandler
+	// GotFirstResponseByte, when not nil, is called when the first response headers are read.
+	GotFirstResponseByte func()
 }
 
 // conn is an enriched net.Conn.
@@ -36,6 +38,7 @@ type conn struct {
 
 	idleAt      time.Time // the last time it was marked as idle.
 	idleTimeout time.Duration
+	used        bool // whether the connection has already been used for a roundTrip.
 
 	responseHeaderTimeout time.Duration
 
@@ -154,6 +157,11 @@ func (c *conn) handleResponse(r rwWithUpgrade) error {
 			timer.Stop()
 		}
 
+		if r.GotFirstResponseByte != nil {
+			r.GotFirstResponseByte()
+			r.GotFirstResponseByte = nil
+		}
+
 		fixPragmaCacheControl(&res.Header)
 
 		resCode := res.StatusCode()
@@ -0,0 +1,123 @@
+package fast
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+	"github.com/traefik/traefik/v3/pkg/observability/metrics"
+	"github.com/traefik/traefik/v3/pkg/observability/tracing"
+	proxyhttputil "github.com/traefik/traefik/v3/pkg/proxy/httputil"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// observabilityHandler creates, if necessary, the reverse proxy client span and the semConv client metric,
+// as the httputil reverse proxy does with its observability round tripper.
+type observabilityHandler struct {
+	semConvMetricRegistry *metrics.SemConvMetricsRegistry
+	proxy                 *ReverseProxy
+}
+
+func newObservabilityHandler(semConvMetricRegistry *metrics.SemConvMetricsRegistry, proxy *ReverseProxy) http.Handler {
+	return &observabilityHandler{
+		semConvMetricRegistry: semConvMetricRegistry,
+		proxy:                 proxy,
+	}
+}
+
+func (o *observabilityHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	tracer := tracing.TracerFromContext(req.Context())
+	tracingEnabled := tracer != nil && observability.TracingEnabled(req.Context())
+	metricsEnabled := o.semConvMetricRegistry != nil && observability.SemConvMetricsEnabled(req.Context())
+	if !tracingEnabled && !metricsEnabled {
+		o.proxy.ServeHTTP(rw, req)
+		return
+	}
+
+	start := time.Now()
+
+	// The outgoing request is built by the proxy from the incoming one,
+	// so the tracing headers are injected into a copy of the incoming request,
+	// while the span and the metric describe the outgoing request.
+	proxyReq := req.Clone(req.Context())
+
+	outReq := proxyReq.WithContext(proxyReq.Context())
+	outReq.URL = o.proxy.outgoingURL(req)
+
+	var span trace.Span
+	if tracingEnabled {
+		var tracingCtx context.Context
+		tracingCtx, span = tracer.Start(req.Context(), "ReverseProxy", trace.WithSpanKind(trace.SpanKindClient))
+		defer span.End()
+
+		proxyReq = proxyReq.WithContext(tracingCtx)
+		outReq = outReq.WithContext(tracingCtx)
+
+		tracer.CaptureClientRequest(span, outReq)
+		tracing.InjectContextIntoCarrier(proxyReq)
+	}
+
+	recorder := &statusRecorder{ResponseWriter: rw}
+	o.proxy.ServeHTTP(recorder, proxyReq)
+
+	statusCode := recorder.status
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	if tracingEnabled {
+		tracer.CaptureResponse(span, recorder.Header(), statusCode, trace.SpanKindClient)
+	}
+
+	end := time.Now()
+
+	// Ending the span as soon as the response is handled because we want to use the same end time for the trace and the metric.
+	if span != nil {
+		span.End(trace.WithTimestamp(end))
+	}
+
+	if metricsEnabled {
+		proxyhttputil.RecordClientRequestDuration(o.semConvMetricRegistry, outReq, statusCode, end.Sub(start))
+	}
+}
+
+// statusRecorder records the status code of the response sent by the proxy.
+type statusRecorder struct {
+	http.ResponseWriter
+
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	// The informational responses are forwarded before the final one.
+	if s.status == 0 && (status >= http.StatusOK || status == http.StatusSwitchingProtocols) {
+		s.status = status
+	}
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+
+	return s.ResponseWriter.Write(b)
+}
+
+func (s *statusRecorder) Flush() {
+	if f, ok := s.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (s *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := s.ResponseWriter.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+
+	return nil, nil, fmt.Errorf("not a hijacker: %T", s.ResponseWriter)
+}
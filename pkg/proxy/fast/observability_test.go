@@ -0,0 +1,134 @@
+package fast
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ptypes "github.com/traefik/paerser/types"
+	"github.com/traefik/traefik/v3/pkg/config/static"
+	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+	"github.com/traefik/traefik/v3/pkg/observability/metrics"
+	otypes "github.com/traefik/traefik/v3/pkg/observability/types"
+	"github.com/traefik/traefik/v3/pkg/testhelpers"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestObservabilityHandler_metrics(t *testing.T) {
+	tests := []struct {
+		desc           string
+		statusCode     int
+		wantAttributes func(port int) attribute.Set
+	}{
+		{
+			desc:       "not found status",
+			statusCode: http.StatusNotFound,
+			wantAttributes: func(port int) attribute.Set {
+				return attribute.NewSet(
+					attribute.Key("error.type").String("404"),
+					attribute.Key("http.request.method").String("GET"),
+					attribute.Key("http.response.status_code").Int(404),
+					attribute.Key("network.protocol.name").String("http/1.1"),
+					attribute.Key("network.protocol.version").String("1.1"),
+					attribute.Key("server.address").String(fmt.Sprintf("127.0.0.1:%d", port)),
+					attribute.Key("server.port").Int(port),
+					attribute.Key("url.scheme").String("http"),
+				)
+			},
+		},
+		{
+			desc:       "created status",
+			statusCode: http.StatusCreated,
+			wantAttributes: func(port int) attribute.Set {
+				return attribute.NewSet(
+					attribute.Key("http.request.method").String("GET"),
+					attribute.Key("http.response.status_code").Int(201),
+					attribute.Key("network.protocol.name").String("http/1.1"),
+					attribute.Key("network.protocol.version").String("1.1"),
+					attribute.Key("server.address").String(fmt.Sprintf("127.0.0.1:%d", port)),
+					attribute.Key("server.port").Int(port),
+					attribute.Key("url.scheme").String("http"),
+				)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			var cfg otypes.OTLP
+			(&cfg).SetDefaults()
+			cfg.PushInterval = ptypes.Duration(10 * time.Millisecond)
+			rdr := sdkmetric.NewManualReader()
+
+			meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(rdr))
+			// force the meter provider with manual reader to collect metrics for the test.
+			metrics.SetMeterProvider(meterProvider)
+
+			semConvMetricRegistry, err := metrics.NewSemConvMetricRegistry(t.Context(), &cfg)
+			require.NoError(t, err)
+			require.NotNil(t, semConvMetricRegistry)
+
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+				rw.WriteHeader(test.statusCode)
+			}))
+			t.Cleanup(server.Close)
+
+			serverURL := testhelpers.MustParseURL(server.URL)
+			serverPort, err := strconv.Atoi(serverURL.Port())
+			require.NoError(t, err)
+
+			builder := NewProxyBuilder(&transportManagerMock{}, semConvMetricRegistry, static.FastProxyConfig{})
+			proxyHandler, err := builder.Build("", serverURL, true, false)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "http://www.test.com/search?q=Opentelemetry", http.NoBody)
+			req.Header.Set("X-Forwarded-Proto", "http")
+
+			// Injection of the observability variables in the request context.
+			req = req.WithContext(observability.WithObservability(req.Context(), observability.Observability{
+				SemConvMetricsEnabled: true,
+			}))
+
+			proxyHandler.ServeHTTP(httptest.NewRecorder(), req)
+
+			got := metricdata.ResourceMetrics{}
+			err = rdr.Collect(t.Context(), &got)
+			require.NoError(t, err)
+
+			require.Len(t, got.ScopeMetrics, 1)
+
+			var clientMetric *metricdata.Metrics
+			for _, m := range got.ScopeMetrics[0].Metrics {
+				if m.Name == "http.client.request.duration" {
+					clientMetric = &m
+				}
+			}
+			require.NotNil(t, clientMetric)
+
+			expected := metricdata.Metrics{
+				Name:        "http.client.request.duration",
+				Description: "Duration of HTTP client requests.",
+				Unit:        "s",
+				Data: metricdata.Histogram[float64]{
+					DataPoints: []metricdata.HistogramDataPoint[float64]{
+						{
+							Attributes: test.wantAttributes(serverPort),
+							Count:      1,
+							Bounds:     []float64{0.005, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2.5, 5, 7.5, 10},
+						},
+					},
+					Temporality: metricdata.CumulativeTemporality,
+				},
+			}
+
+			metricdatatest.AssertEqual[metricdata.Metrics](t, expected, *clientMetric, metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreValue())
+		})
+	}
+}
@@ -184,27 +184,7 @@ func (p *ReverseProxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		}
 	}
 
-	u2 := new(url.URL)
-	*u2 = *req.URL
-	u2.Scheme = p.targetURL.Scheme
-	u2.Host = p.targetURL.Host
-
-	u := req.URL
-	if req.RequestURI != "" {
-		parsedURL, err := url.ParseRequestURI(req.RequestURI)
-		if err == nil {
-			u = parsedURL
-		}
-	}
-
-	u2.Path = u.Path
-	u2.RawPath = u.RawPath
-
-	if p.preservePath {
-		u2.Path, u2.RawPath = proxyhttputil.JoinURLPath(p.targetURL, u)
-	}
-
-	u2.RawQuery = strings.ReplaceAll(u.RawQuery, ";", "&")
+	u2 := p.outgoingURL(req)
 
 	outReq.SetHost(u2.Host)
 	outReq.Header.SetHost(u2.Host)
@@ -241,6 +221,33 @@ func (p *ReverseProxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// outgoingURL returns the URL of the request forwarded to the target.
+func (p *ReverseProxy) outgoingURL(req *http.Request) *url.URL {
+	u2 := new(url.URL)
+	*u2 = *req.URL
+	u2.Scheme = p.targetURL.Scheme
+	u2.Host = p.targetURL.Host
+
+	u := req.URL
+	if req.RequestURI != "" {
+		parsedURL, err := url.ParseRequestURI(req.RequestURI)
+		if err == nil {
+			u = parsedURL
+		}
+	}
+
+	u2.Path = u.Path
+	u2.RawPath = u.RawPath
+
+	if p.preservePath {
+		u2.Path, u2.RawPath = proxyhttputil.JoinURLPath(p.targetURL, u)
+	}
+
+	u2.RawQuery = strings.ReplaceAll(u.RawQuery, ";", "&")
+
+	return u2
+}
+
 // Note that unlike the net/http RoundTrip:
 //   - we are not supporting "100 Continue" response to forward them as-is to the client.
 //   - we are not asking for compressed response automatically. That is because this will add an extra cost when the
@@ -259,12 +266,21 @@ func (p *ReverseProxy) roundTrip(rw http.ResponseWriter, req *http.Request, outR
 		default:
 		}
 
+		if trace != nil && trace.GetConn != nil {
+			trace.GetConn(req.URL.Host)
+		}
+
 		var err error
 		co, err = p.connPool.AcquireConn()
 		if err != nil {
 			return fmt.Errorf("acquire connection: %w", err)
 		}
 
+		if trace != nil && trace.GotConn != nil {
+			trace.GotConn(httptrace.GotConnInfo{Conn: co, Reused: co.used})
+		}
+		co.used = true
+
 		// Before writing the request,
 		// we mark the conn as expecting to handle a response.
 		co.expectedResponse.Store(true)
@@ -292,12 +308,17 @@ func (p *ReverseProxy) roundTrip(rw http.ResponseWriter, req *http.Request, outR
 		}
 	}
 
-	// Sending the responseWriter unlocks the connection readLoop, to handle the response.
-	co.RWCh <- rwWithUpgrade{
+	rwu := rwWithUpgrade{
 		ReqMethod: req.Method,
 		RW:        rw,
 		Upgrade:   upgradeResponseHandler(req.Context(), reqUpType),
 	}
+	if trace != nil {
+		rwu.GotFirstResponseByte = trace.GotFirstResponseByte
+	}
+
+	// Sending the responseWriter unlocks the connection readLoop, to handle the response.
+	co.RWCh <- rwu
 
 	if err := <-co.ErrCh; err != nil {
 		return err
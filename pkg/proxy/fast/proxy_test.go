@@ -9,6 +9,7 @@ import (
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/http/httptrace"
 	"net/http/httputil"
 	"net/url"
 	"testing"
@@ -234,7 +235,7 @@ func TestProxyFromEnvironment(t *testing.T) {
 				certPool.AddCert(backendServer.Certificate())
 			}
 
-			builder := NewProxyBuilder(&transportManagerMock{tlsConfig: &tls.Config{RootCAs: certPool}}, static.FastProxyConfig{})
+			builder := NewProxyBuilder(&transportManagerMock{tlsConfig: &tls.Config{RootCAs: certPool}}, nil, static.FastProxyConfig{})
 			builder.proxy = func(req *http.Request) (*url.URL, error) {
 				u, err := url.Parse(proxyURL)
 				if err != nil {
@@ -283,7 +284,7 @@ func TestPreservePath(t *testing.T) {
 	}))
 	t.Cleanup(server.Close)
 
-	builder := NewProxyBuilder(&transportManagerMock{}, static.FastProxyConfig{})
+	builder := NewProxyBuilder(&transportManagerMock{}, nil, static.FastProxyConfig{})
 
 	serverURL, err := url.JoinPath(server.URL, "base")
 	require.NoError(t, err)
@@ -311,7 +312,7 @@ func TestHeadRequest(t *testing.T) {
 	}))
 	t.Cleanup(server.Close)
 
-	builder := NewProxyBuilder(&transportManagerMock{}, static.FastProxyConfig{})
+	builder := NewProxyBuilder(&transportManagerMock{}, nil, static.FastProxyConfig{})
 
 	serverURL, err := url.JoinPath(server.URL)
 	require.NoError(t, err)
@@ -352,7 +353,7 @@ func TestNoContentLength(t *testing.T) {
 		}
 	}()
 
-	builder := NewProxyBuilder(&transportManagerMock{}, static.FastProxyConfig{})
+	builder := NewProxyBuilder(&transportManagerMock{}, nil, static.FastProxyConfig{})
 
 	serverURL := "http://" + backendListener.Addr().String()
 
@@ -382,7 +383,7 @@ func TestTransferEncodingChunked(t *testing.T) {
 	}))
 	t.Cleanup(backendServer.Close)
 
-	builder := NewProxyBuilder(&transportManagerMock{}, static.FastProxyConfig{})
+	builder := NewProxyBuilder(&transportManagerMock{}, nil, static.FastProxyConfig{})
 
 	proxyHandler, err := builder.Build("", testhelpers.MustParseURL(backendServer.URL), true, true)
 	require.NoError(t, err)
@@ -414,7 +415,7 @@ func TestConnectRequest(t *testing.T) {
 	}))
 	t.Cleanup(server.Close)
 
-	builder := NewProxyBuilder(&transportManagerMock{}, static.FastProxyConfig{})
+	builder := NewProxyBuilder(&transportManagerMock{}, nil, static.FastProxyConfig{})
 
 	serverURL, err := url.JoinPath(server.URL)
 	require.NoError(t, err)
@@ -483,7 +484,7 @@ func TestXForwardedFor(t *testing.T) {
 			}))
 			t.Cleanup(server.Close)
 
-			builder := NewProxyBuilder(&transportManagerMock{}, static.FastProxyConfig{})
+			builder := NewProxyBuilder(&transportManagerMock{}, nil, static.FastProxyConfig{})
 
 			proxyHandler, err := builder.Build("", testhelpers.MustParseURL(server.URL), true, false)
 			require.NoError(t, err)
@@ -526,3 +527,44 @@ func (r *transportManagerMock) GetTLSConfig(_ string) (*tls.Config, error) {
 func (r *transportManagerMock) Get(_ string) (*dynamic.ServersTransport, error) {
 	return &dynamic.ServersTransport{}, nil
 }
+
+func TestClientTrace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL := testhelpers.MustParseURL(server.URL)
+
+	pool := newConnPool(1, 0, 0, func() (net.Conn, error) {
+		return net.Dial("tcp", serverURL.Host)
+	})
+	t.Cleanup(pool.Close)
+
+	proxyHandler, err := NewReverseProxy(serverURL, nil, false, true, true, pool)
+	require.NoError(t, err)
+
+	var reused []bool
+	var gotFirstResponseByte int
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			reused = append(reused, info.Reused)
+		},
+		GotFirstResponseByte: func() {
+			gotFirstResponseByte++
+		},
+	}
+
+	for range 2 {
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+		res := httptest.NewRecorder()
+
+		proxyHandler.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+	}
+
+	assert.Equal(t, []bool{false, true}, reused)
+	assert.Equal(t, 2, gotFirstResponseByte)
+}
@@ -38,7 +38,7 @@ func TestRequestTrailersNotForwardedToBackend(t *testing.T) {
 
 	transportManager := &transportManagerMock{}
 
-	p, err := NewProxyBuilder(transportManager, static.FastProxyConfig{}).Build("default", backendURL, true, false)
+	p, err := NewProxyBuilder(transportManager, nil, static.FastProxyConfig{}).Build("default", backendURL, true, false)
 	require.NoError(t, err)
 
 	lb, err := roundrobin.New(p)
@@ -72,6 +72,13 @@ func (t *wrapper) RoundTrip(req *http.Request) (*http.Response, error) {
 		return response, err
 	}
 
+	RecordClientRequestDuration(t.semConvMetricRegistry, req, statusCode, end.Sub(start))
+
+	return response, err
+}
+
+// RecordClientRequestDuration records the semantic conventions client request duration metric for the given outgoing request.
+func RecordClientRequestDuration(semConvMetricRegistry *metrics.SemConvMetricsRegistry, req *http.Request, statusCode int, duration time.Duration) {
 	var attrs []attribute.KeyValue
 
 	if statusCode < 100 || statusCode >= 600 {
@@ -97,14 +104,12 @@ func (t *wrapper) RoundTrip(req *http.Request) (*http.Response, error) {
 			attrs = append(attrs, semconv.ServerPort(serverPort))
 		}
 	} else {
-		serverPort, _ := strconv.Atoi(port)
+		serverPort, _ = strconv.Atoi(port)
 		attrs = append(attrs, semconv.ServerPort(serverPort))
 	}
 
 	attrs = append(attrs, semconv.URLScheme(req.Header.Get("X-Forwarded-Proto")))
 
-	t.semConvMetricRegistry.HTTPClientRequestDuration().Record(req.Context(), end.Sub(start).Seconds(),
+	semConvMetricRegistry.HTTPClientRequestDuration().Record(req.Context(), duration.Seconds(),
 		httpconv.RequestMethodAttr(req.Method), req.URL.Host, serverPort, attrs...)
-
-	return response, err
 }
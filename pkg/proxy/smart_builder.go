@@ -9,6 +9,7 @@ import (
 
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
 	"github.com/traefik/traefik/v3/pkg/config/static"
+	"github.com/traefik/traefik/v3/pkg/observability/metrics"
 	"github.com/traefik/traefik/v3/pkg/proxy/fast"
 	"github.com/traefik/traefik/v3/pkg/proxy/httputil"
 	"github.com/traefik/traefik/v3/pkg/server/service"
@@ -31,9 +32,9 @@ type SmartBuilder struct {
 }
 
 // NewSmartBuilder creates and returns a new SmartBuilder instance.
-func NewSmartBuilder(transportManager TransportManager, proxyBuilder service.ProxyBuilder, fastProxyConfig static.FastProxyConfig) *SmartBuilder {
+func NewSmartBuilder(transportManager TransportManager, proxyBuilder service.ProxyBuilder, fastProxyConfig static.FastProxyConfig, semConvMetricsRegistry *metrics.SemConvMetricsRegistry) *SmartBuilder {
 	return &SmartBuilder{
-		fastProxyBuilder: fast.NewProxyBuilder(transportManager, fastProxyConfig),
+		fastProxyBuilder: fast.NewProxyBuilder(transportManager, semConvMetricsRegistry, fastProxyConfig),
 		proxyBuilder:     proxyBuilder,
 		transportManager: transportManager,
 	}
@@ -99,7 +99,7 @@ func TestSmartBuilder_Build(t *testing.T) {
 			transportManager.Update(serversTransports)
 
 			httpProxyBuilder := httputil.NewProxyBuilder(transportManager, nil)
-			proxyBuilder := NewSmartBuilder(transportManager, httpProxyBuilder, test.fastProxyConfig)
+			proxyBuilder := NewSmartBuilder(transportManager, httpProxyBuilder, test.fastProxyConfig, nil)
 
 			proxyHandler, err := proxyBuilder.Build("test", targetURL, false, false, time.Second)
 			require.NoError(t, err)
@@ -0,0 +1,132 @@
+package proxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/config/static"
+	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+	"github.com/traefik/traefik/v3/pkg/proxy/fast"
+	"github.com/traefik/traefik/v3/pkg/proxy/httputil"
+	"github.com/traefik/traefik/v3/pkg/server/service"
+	"github.com/traefik/traefik/v3/pkg/testhelpers"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestStreamObservability_webSocket(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	backend := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(rw, req, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			mt, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			if err := conn.WriteMessage(mt, message); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(backend.Close)
+
+	transportManager := service.NewTransportManager(nil)
+	transportManager.Update(map[string]*dynamic.ServersTransport{"test": {}})
+
+	tests := []struct {
+		desc  string
+		build func(t *testing.T) http.Handler
+	}{
+		{
+			desc: "httputil proxy",
+			build: func(t *testing.T) http.Handler {
+				t.Helper()
+
+				handler, err := httputil.NewProxyBuilder(transportManager, nil).Build("test", testhelpers.MustParseURL(backend.URL), true, false, time.Second)
+				require.NoError(t, err)
+
+				return handler
+			},
+		},
+		{
+			desc: "fast proxy",
+			build: func(t *testing.T) http.Handler {
+				t.Helper()
+
+				handler, err := fast.NewProxyBuilder(transportManager, nil, static.FastProxyConfig{}).Build("test", testhelpers.MustParseURL(backend.URL), true, false)
+				require.NoError(t, err)
+
+				return handler
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			spanRecorder := tracetest.NewSpanRecorder()
+			tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)).Tracer("test")
+
+			handler, err := observability.StreamHandler(t.Context(), nil)(test.build(t))
+			require.NoError(t, err)
+
+			done := make(chan struct{})
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				defer close(done)
+
+				ctx, span := tracer.Start(req.Context(), "entrypoint")
+				defer span.End()
+
+				ctx = observability.WithObservability(ctx, observability.Observability{TracingEnabled: true})
+				handler.ServeHTTP(rw, req.WithContext(ctx))
+			}))
+			t.Cleanup(server.Close)
+
+			conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+			require.NoError(t, err)
+
+			for _, message := range []string{"hello", "world", "!"} {
+				require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(message)))
+
+				_, got, err := conn.ReadMessage()
+				require.NoError(t, err)
+				assert.Equal(t, message, string(got))
+			}
+
+			require.NoError(t, conn.Close())
+
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("timeout waiting for the stream to end")
+			}
+
+			spans := spanRecorder.Ended()
+			require.Len(t, spans, 1)
+
+			attrs := attribute.NewSet(spans[0].Attributes()...)
+
+			streamType, _ := attrs.Value("traefik.stream.type")
+			assert.Equal(t, "websocket", streamType.AsString())
+
+			received, _ := attrs.Value("traefik.stream.messages.received")
+			assert.Equal(t, int64(3), received.AsInt64())
+
+			sent, _ := attrs.Value("traefik.stream.messages.sent")
+			assert.Equal(t, int64(3), sent.AsInt64())
+		})
+	}
+}
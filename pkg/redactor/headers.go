@@ -0,0 +1,61 @@
+package redactor
+
+import (
+	"net/http"
+	"strings"
+)
+
+// sensitiveHeaders are the headers whose values are always redacted.
+var sensitiveHeaders = map[string]struct{}{
+	"Authorization":               {},
+	"Proxy-Authorization":         {},
+	"Cookie":                      {},
+	"Set-Cookie":                  {},
+	"X-Api-Key":                   {},
+	"X-Auth-Token":                {},
+	"X-Csrf-Token":                {},
+	"X-Forwarded-Tls-Client-Cert": {},
+}
+
+// sensitiveHeaderParts are the header name fragments hinting at a secret value.
+var sensitiveHeaderParts = []string{"token", "secret", "password", "session"}
+
+// RedactHeaders returns a copy of the given headers, where the values of the sensitive headers are masked,
+// and the URLs found in the other values are redacted.
+func RedactHeaders(headers http.Header) http.Header {
+	if headers == nil {
+		return nil
+	}
+
+	redacted := make(http.Header, len(headers))
+	for name, values := range headers {
+		redactedValues := make([]string, len(values))
+		for i, value := range values {
+			if isSensitiveHeader(name) {
+				redactedValues[i] = maskShort
+				continue
+			}
+
+			redactedValues[i] = doOnJSON(value)
+		}
+
+		redacted[name] = redactedValues
+	}
+
+	return redacted
+}
+
+func isSensitiveHeader(name string) bool {
+	if _, ok := sensitiveHeaders[http.CanonicalHeaderKey(name)]; ok {
+		return true
+	}
+
+	lowerName := strings.ToLower(name)
+	for _, part := range sensitiveHeaderParts {
+		if strings.Contains(lowerName, part) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,30 @@
+package redactor
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{
+		"Authorization":   {"Bearer foo"},
+		"Cookie":          {"session=bar"},
+		"X-Session-Id":    {"baz"},
+		"Referer":         {"https://example.com/foo?bar=baz"},
+		"Accept-Encoding": {"gzip", "br"},
+	}
+
+	expected := http.Header{
+		"Authorization":   {maskShort},
+		"Cookie":          {maskShort},
+		"X-Session-Id":    {maskShort},
+		"Referer":         {maskLarge},
+		"Accept-Encoding": {"gzip", "br"},
+	}
+
+	assert.Equal(t, expected, RedactHeaders(headers))
+	// The original headers must be left untouched.
+	assert.Equal(t, "Bearer foo", headers.Get("Authorization"))
+}
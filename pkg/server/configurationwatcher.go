@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"reflect"
+	"sort"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/config/history"
 	"github.com/traefik/traefik/v3/pkg/observability/logs"
 	"github.com/traefik/traefik/v3/pkg/provider"
+	"github.com/traefik/traefik/v3/pkg/provider/snapshot"
 	"github.com/traefik/traefik/v3/pkg/safe"
 	"github.com/traefik/traefik/v3/pkg/tls"
 	"github.com/traefik/traefik/v3/pkg/types"
@@ -30,6 +33,9 @@ type ConfigurationWatcher struct {
 
 	configurationTransformers []func(context.Context, dynamic.Configurations) dynamic.Configurations
 
+	snapshots *snapshot.Store
+	history   *history.Store
+
 	routinesPool *safe.Pool
 }
 
@@ -73,6 +79,20 @@ func (c *ConfigurationWatcher) AddTransformer(transformer func(context.Context,
 	c.configurationTransformers = append(c.configurationTransformers, transformer)
 }
 
+// SetSnapshotStore sets the store persisting the provider configurations,
+// whose snapshots are served at startup until the providers provide a fresh configuration.
+// It must be called before starting the watcher.
+func (c *ConfigurationWatcher) SetSnapshotStore(store *snapshot.Store) {
+	c.snapshots = store
+}
+
+// SetHistory sets the store keeping the history of the applied configurations,
+// and holding the providers pinned to a previous configuration.
+// It must be called before starting the watcher.
+func (c *ConfigurationWatcher) SetHistory(store *history.Store) {
+	c.history = store
+}
+
 func (c *ConfigurationWatcher) startProviderAggregator() {
 	log.Info().Msgf("Starting provider aggregator %T", c.providerAggregator)
 
@@ -97,6 +117,22 @@ func (c *ConfigurationWatcher) receiveConfigurations(ctx context.Context) {
 
 	var output chan dynamic.Configurations
 
+	if c.snapshots != nil {
+		// The snapshots are served until the providers provide a fresh configuration.
+		for providerName, configuration := range c.snapshots.Load(ctx) {
+			newConfigurations[providerName] = configuration
+		}
+
+		if len(newConfigurations) > 0 {
+			transformedConfigurations = newConfigurations
+			for _, transform := range c.configurationTransformers {
+				transformedConfigurations = transform(ctx, transformedConfigurations.DeepCopy())
+			}
+
+			output = c.newConfigs
+		}
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -128,6 +164,10 @@ func (c *ConfigurationWatcher) receiveConfigurations(ctx context.Context) {
 
 				logConfiguration(logger, configMsg)
 
+				if c.snapshots != nil {
+					c.snapshots.Update(logger.WithContext(ctx), configMsg.ProviderName, configMsg.Configuration)
+				}
+
 				if reflect.DeepEqual(newConfigurations[configMsg.ProviderName], configMsg.Configuration) {
 					// no change, do nothing.
 					logger.Debug().Msg("Skipping unchanged configuration")
@@ -154,39 +194,85 @@ func (c *ConfigurationWatcher) receiveConfigurations(ctx context.Context) {
 // applyConfigurations receives the full set of configurations from
 // receiveConfigurations and applies them if they differ from the previous set.
 // It waits for the required provider's configuration before applying any configs.
+// When a provider is pinned or unpinned, the last received configurations are applied again.
 func (c *ConfigurationWatcher) applyConfigurations(ctx context.Context) {
-	var lastConfigurations dynamic.Configurations
+	var pinChanges <-chan struct{}
+	if c.history != nil {
+		pinChanges = c.history.PinChanges()
+	}
+
+	var lastReceived, lastConfigurations dynamic.Configurations
 	for {
+		var newConfigs dynamic.Configurations
+
 		select {
 		case <-ctx.Done():
 			return
-		case newConfigs, ok := <-c.newConfigs:
+		case <-pinChanges:
+			if lastReceived == nil {
+				continue
+			}
+
+			newConfigs = lastReceived
+		case configs, ok := <-c.newConfigs:
 			if !ok {
 				return
 			}
 
-			// We wait for first configuration of the required provider before applying configurations.
-			if _, ok := newConfigs[c.requiredProvider]; c.requiredProvider != "" && !ok {
-				continue
-			}
+			newConfigs = configs
+		}
 
-			if reflect.DeepEqual(newConfigs, lastConfigurations) {
-				continue
-			}
+		// We wait for first configuration of the required provider before applying configurations.
+		if _, ok := newConfigs[c.requiredProvider]; c.requiredProvider != "" && !ok {
+			continue
+		}
 
-			conf := mergeConfiguration(newConfigs.DeepCopy(), c.defaultEntryPoints)
-			conf = applyModel(conf)
-			if conf.HTTP != nil {
-				conf.HTTP.Routers = resolveHTTPTLSOptions(conf.HTTP.Routers)
-			}
+		lastReceived = newConfigs
+		if c.history != nil {
+			newConfigs = c.history.ApplyPins(newConfigs)
+		}
 
-			for _, listener := range c.configurationListeners {
-				listener(conf)
-			}
+		if reflect.DeepEqual(newConfigs, lastConfigurations) {
+			continue
+		}
+
+		conf := mergeConfiguration(newConfigs.DeepCopy(), c.defaultEntryPoints)
+		conf = applyModel(conf)
+		if conf.HTTP != nil {
+			conf.HTTP.Routers = resolveHTTPTLSOptions(conf.HTTP.Routers)
+		}
+
+		if c.history != nil {
+			// The listeners may modify the configuration, hence the copy.
+			c.history.Record(changedProviders(lastConfigurations, newConfigs), newConfigs, conf.DeepCopy())
+		}
+
+		for _, listener := range c.configurationListeners {
+			listener(conf)
+		}
+
+		lastConfigurations = newConfigs
+	}
+}
 
-			lastConfigurations = newConfigs
+// changedProviders returns the sorted names of the providers whose configuration differs between the two sets.
+func changedProviders(previous, current dynamic.Configurations) []string {
+	var providers []string
+	for providerName, configuration := range current {
+		if !reflect.DeepEqual(previous[providerName], configuration) {
+			providers = append(providers, providerName)
 		}
 	}
+
+	for providerName := range previous {
+		if _, ok := current[providerName]; !ok {
+			providers = append(providers, providerName)
+		}
+	}
+
+	sort.Strings(providers)
+
+	return providers
 }
 
 func logConfiguration(logger zerolog.Logger, configMsg dynamic.Message) {
@@ -251,7 +337,7 @@ func isEmptyConfiguration(conf *dynamic.Configuration) bool {
 		conf.UDP = &dynamic.UDPConfiguration{}
 	}
 
-	httpEmpty := conf.HTTP.Routers == nil && conf.HTTP.Services == nil && conf.HTTP.Middlewares == nil
+	httpEmpty := conf.HTTP.Routers == nil && conf.HTTP.Services == nil && conf.HTTP.Middlewares == nil && conf.HTTP.Overlays == nil
 	tlsEmpty := conf.TLS == nil || conf.TLS.Certificates == nil && conf.TLS.Stores == nil && conf.TLS.Options == nil
 	tcpEmpty := conf.TCP.Routers == nil && conf.TCP.Services == nil && conf.TCP.Middlewares == nil
 	udpEmpty := conf.UDP.Routers == nil && conf.UDP.Services == nil
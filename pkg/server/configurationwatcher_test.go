@@ -9,8 +9,11 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/config/history"
 	"github.com/traefik/traefik/v3/pkg/provider/aggregator"
+	"github.com/traefik/traefik/v3/pkg/provider/snapshot"
 	"github.com/traefik/traefik/v3/pkg/safe"
 	th "github.com/traefik/traefik/v3/pkg/testhelpers"
 	"github.com/traefik/traefik/v3/pkg/tls"
@@ -1041,3 +1044,135 @@ func TestEntryPointTLSResolvedOptions(t *testing.T) {
 
 	<-run
 }
+
+func TestConfigurationWatcher_ServesSnapshots(t *testing.T) {
+	routinesPool := safe.NewPool(t.Context())
+
+	directory := t.TempDir()
+
+	store, err := snapshot.NewStore(directory, []string{"snapshotted"})
+	require.NoError(t, err)
+
+	// Snapshot written by a previous run.
+	store.Update(t.Context(), "snapshotted", &dynamic.Configuration{
+		HTTP: th.BuildConfiguration(
+			th.WithRouters(th.WithRouter("foo", th.WithEntryPoints("ep"))),
+		),
+	})
+
+	store, err = snapshot.NewStore(directory, []string{"snapshotted"})
+	require.NoError(t, err)
+
+	pvd := &mockProvider{
+		messages: []dynamic.Message{{
+			ProviderName: "required",
+			Configuration: &dynamic.Configuration{
+				HTTP: th.BuildConfiguration(
+					th.WithRouters(th.WithRouter("bar", th.WithEntryPoints("ep"))),
+				),
+			},
+		}},
+	}
+
+	watcher := NewConfigurationWatcher(routinesPool, pvd, []string{}, "required")
+	watcher.SetSnapshotStore(store)
+
+	published := make(chan dynamic.Configuration, 10)
+	watcher.AddListener(func(conf dynamic.Configuration) {
+		published <- conf
+	})
+
+	watcher.Start()
+
+	t.Cleanup(watcher.Stop)
+	t.Cleanup(routinesPool.Stop)
+
+	select {
+	case conf := <-published:
+		assert.Contains(t, conf.HTTP.Routers, "foo@snapshotted")
+		assert.Contains(t, conf.HTTP.Routers, "bar@required")
+	case <-time.After(time.Second):
+		t.Fatal("timeout while waiting for the configuration")
+	}
+
+	infos := store.Snapshots()
+	require.Len(t, infos, 1)
+	assert.True(t, infos[0].Serving)
+}
+
+func TestConfigurationWatcher_History(t *testing.T) {
+	routinesPool := safe.NewPool(t.Context())
+
+	store, err := history.NewStore(10)
+	require.NoError(t, err)
+
+	pvd := &mockProvider{
+		first: make(chan struct{}),
+		messages: []dynamic.Message{
+			{
+				ProviderName: "mock",
+				Configuration: &dynamic.Configuration{
+					HTTP: th.BuildConfiguration(
+						th.WithRouters(th.WithRouter("foo", th.WithEntryPoints("ep"))),
+					),
+				},
+			},
+			{
+				ProviderName: "mock",
+				Configuration: &dynamic.Configuration{
+					HTTP: th.BuildConfiguration(
+						th.WithRouters(th.WithRouter("bar", th.WithEntryPoints("ep"))),
+					),
+				},
+			},
+		},
+	}
+
+	watcher := NewConfigurationWatcher(routinesPool, pvd, []string{}, "")
+	watcher.SetHistory(store)
+
+	published := make(chan dynamic.Configuration, 10)
+	watcher.AddListener(func(conf dynamic.Configuration) {
+		published <- conf
+	})
+
+	watcher.Start()
+
+	t.Cleanup(watcher.Stop)
+	t.Cleanup(routinesPool.Stop)
+
+	waitRouter := func(name string) {
+		t.Helper()
+
+		select {
+		case conf := <-published:
+			assert.Contains(t, conf.HTTP.Routers, name)
+		case <-time.After(time.Second):
+			t.Fatalf("timeout while waiting for the configuration with router %s", name)
+		}
+	}
+
+	waitRouter("foo@mock")
+
+	close(pvd.first)
+	waitRouter("bar@mock")
+
+	// Pinning the provider rolls back its configuration.
+	require.NoError(t, store.Pin("mock", 1))
+	waitRouter("foo@mock")
+
+	require.NoError(t, store.Unpin("mock"))
+	waitRouter("bar@mock")
+
+	entries := store.Entries()
+	require.Len(t, entries, 4)
+	assert.Equal(t, []string{"mock"}, entries[1].Providers)
+
+	changes, err := store.Diff(entries[1].ID)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "bar@mock", changes[0].Name)
+	assert.Equal(t, history.ActionRemoved, changes[0].Action)
+	assert.Equal(t, "foo@mock", changes[1].Name)
+	assert.Equal(t, history.ActionAdded, changes[1].Action)
+}
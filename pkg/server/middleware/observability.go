@@ -13,6 +13,7 @@ import (
 	"github.com/traefik/traefik/v3/pkg/middlewares/capture"
 	mmetrics "github.com/traefik/traefik/v3/pkg/middlewares/metrics"
 	"github.com/traefik/traefik/v3/pkg/middlewares/observability"
+	"github.com/traefik/traefik/v3/pkg/middlewares/tap"
 	"github.com/traefik/traefik/v3/pkg/observability/logs"
 	"github.com/traefik/traefik/v3/pkg/observability/metrics"
 	"github.com/traefik/traefik/v3/pkg/observability/tracing"
@@ -27,11 +28,12 @@ type ObservabilityMgr struct {
 	semConvMetricRegistry  *metrics.SemConvMetricsRegistry
 	tracer                 *tracing.Tracer
 	tracerCloser           io.Closer
+	tapHub                 *tap.Hub
 }
 
 // NewObservabilityMgr creates a new ObservabilityMgr.
 func NewObservabilityMgr(config static.Configuration, metricsRegistry metrics.Registry, semConvMetricRegistry *metrics.SemConvMetricsRegistry, accessLoggerMiddleware *accesslog.Handler, tracer *tracing.Tracer, tracerCloser io.Closer) *ObservabilityMgr {
-	return &ObservabilityMgr{
+	mgr := &ObservabilityMgr{
 		config:                 config,
 		metricsRegistry:        metricsRegistry,
 		semConvMetricRegistry:  semConvMetricRegistry,
@@ -39,6 +41,13 @@ func NewObservabilityMgr(config static.Configuration, metricsRegistry metrics.Re
 		tracer:                 tracer,
 		tracerCloser:           tracerCloser,
 	}
+
+	// The request tap feed is exposed by the API.
+	if config.API != nil {
+		mgr.tapHub = tap.NewHub()
+	}
+
+	return mgr
 }
 
 // BuildEPChain an observability middleware chain by entry point.
@@ -66,6 +75,12 @@ func (o *ObservabilityMgr) BuildEPChain(ctx context.Context, entryPointName stri
 
 	// Access log handlers.
 	chain = chain.Append(o.accessLoggerMiddleware.AliceConstructor())
+
+	// Request tap handler, which must be added before the entry point field handler to collect the entry point name.
+	if !internal && o.tapHub != nil {
+		chain = chain.Append(tap.WrapHandler(o.tapHub))
+	}
+
 	chain = chain.Append(func(next http.Handler) (http.Handler, error) {
 		return accesslog.NewFieldHandler(next, logs.EntryPointName, entryPointName, accesslog.InitServiceFields), nil
 	})
@@ -77,6 +92,9 @@ func (o *ObservabilityMgr) BuildEPChain(ctx context.Context, entryPointName stri
 	// Semantic convention server metrics handler.
 	chain = chain.Append(observability.SemConvServerMetricsHandler(ctx, o.semConvMetricRegistry))
 
+	// WebSocket and gRPC streams observability handler.
+	chain = chain.Append(observability.StreamHandler(ctx, o.semConvMetricRegistry))
+
 	return chain
 }
 
@@ -98,6 +116,15 @@ func (o *ObservabilityMgr) SemConvMetricsRegistry() *metrics.SemConvMetricsRegis
 	return o.semConvMetricRegistry
 }
 
+// TapHub is an accessor to the request tap hub.
+func (o *ObservabilityMgr) TapHub() *tap.Hub {
+	if o == nil {
+		return nil
+	}
+
+	return o.tapHub
+}
+
 // Close closes the accessLogger and tracer.
 func (o *ObservabilityMgr) Close() {
 	if o == nil {
@@ -0,0 +1,199 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+	"github.com/traefik/traefik/v3/pkg/observability/logs"
+	"github.com/traefik/traefik/v3/pkg/server/provider"
+	traefiktls "github.com/traefik/traefik/v3/pkg/tls"
+)
+
+type providerOverlay struct {
+	providerName string
+	name         string
+	rank         int
+	overlay      *dynamic.Overlay
+}
+
+// NewOverlayTransformer returns a configuration transformer applying the HTTP overlays of every provider
+// on top of the matching routers, whatever their provider.
+// The overlays are applied from the lowest to the highest precedence provider,
+// so that the middlewares of the highest precedence overlays come first,
+// and their TLS configuration wins.
+func NewOverlayTransformer(providersPrecedence, defaultEntryPoints []string) func(context.Context, dynamic.Configurations) dynamic.Configurations {
+	return func(ctx context.Context, configurations dynamic.Configurations) dynamic.Configurations {
+		overlays := collectOverlays(configurations, providersPrecedence)
+		if len(overlays) == 0 {
+			return configurations
+		}
+
+		for _, po := range overlays {
+			logger := log.Ctx(ctx).With().Str(logs.ProviderName, po.providerName).Str("overlayName", po.name).Logger()
+
+			if err := validateOverlaySelector(po.overlay.Selector); err != nil {
+				logger.Error().Err(err).Msg("Invalid overlay selector, skipping the overlay")
+				continue
+			}
+
+			for providerName, configuration := range configurations {
+				// The internal routers (API, dashboard, ping, ...) are never modified.
+				if providerName == "internal" || configuration.HTTP == nil {
+					continue
+				}
+
+				for routerName, router := range configuration.HTTP.Routers {
+					// Only root routers can have overlays applied.
+					if router.ParentRefs != nil {
+						continue
+					}
+
+					if !matchOverlay(po.overlay.Selector, providerName, routerName, router, defaultEntryPoints) {
+						continue
+					}
+
+					applyOverlay(po, router)
+
+					logger.Debug().Str(logs.RouterName, provider.MakeQualifiedName(providerName, routerName)).Msg("Overlay applied")
+				}
+			}
+		}
+
+		return configurations
+	}
+}
+
+// collectOverlays returns the overlays of all the providers, in the order they have to be applied.
+func collectOverlays(configurations dynamic.Configurations, providersPrecedence []string) []providerOverlay {
+	var overlays []providerOverlay
+	for providerName, configuration := range configurations {
+		if configuration.HTTP == nil {
+			continue
+		}
+
+		rank := slices.Index(providersPrecedence, providerName)
+		if rank < 0 {
+			// Providers absent from the precedence list lose to any listed provider.
+			rank = len(providersPrecedence)
+		}
+
+		for name, overlay := range configuration.HTTP.Overlays {
+			if overlay == nil {
+				continue
+			}
+
+			overlays = append(overlays, providerOverlay{
+				providerName: providerName,
+				name:         name,
+				rank:         rank,
+				overlay:      overlay,
+			})
+		}
+	}
+
+	sort.Slice(overlays, func(i, j int) bool {
+		if overlays[i].rank != overlays[j].rank {
+			return overlays[i].rank > overlays[j].rank
+		}
+		if overlays[i].providerName != overlays[j].providerName {
+			return overlays[i].providerName < overlays[j].providerName
+		}
+		return overlays[i].name < overlays[j].name
+	})
+
+	return overlays
+}
+
+func validateOverlaySelector(selector dynamic.OverlaySelector) error {
+	for _, pattern := range selector.Routers {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid router name pattern %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// matchOverlay returns whether the router matches all the criteria of the overlay selector.
+func matchOverlay(selector dynamic.OverlaySelector, providerName, routerName string, router *dynamic.Router, defaultEntryPoints []string) bool {
+	if len(selector.Providers) > 0 && !slices.Contains(selector.Providers, providerName) {
+		return false
+	}
+
+	if len(selector.Routers) > 0 && !slices.ContainsFunc(selector.Routers, func(pattern string) bool {
+		match, _ := path.Match(pattern, routerName)
+		return match
+	}) {
+		return false
+	}
+
+	if len(selector.EntryPoints) > 0 {
+		entryPoints := router.EntryPoints
+		if len(entryPoints) == 0 {
+			entryPoints = defaultEntryPoints
+		}
+
+		if !slices.ContainsFunc(entryPoints, func(ep string) bool { return slices.Contains(selector.EntryPoints, ep) }) {
+			return false
+		}
+	}
+
+	for key, value := range selector.Labels {
+		if v, ok := router.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// applyOverlay applies the overlay on the router.
+// The resources referenced by the overlay are qualified with the overlay provider,
+// as the router can come from another provider.
+func applyOverlay(po providerOverlay, router *dynamic.Router) {
+	var middlewares []string
+	for _, middleware := range po.overlay.Middlewares {
+		middleware = qualifyOverlayReference(po.providerName, middleware)
+		if !slices.Contains(router.Middlewares, middleware) {
+			middlewares = append(middlewares, middleware)
+		}
+	}
+	router.Middlewares = append(middlewares, router.Middlewares...)
+
+	if po.overlay.TLS == nil {
+		return
+	}
+
+	if router.TLS == nil {
+		router.TLS = &dynamic.RouterTLSConfig{}
+	}
+
+	if po.overlay.TLS.Options != "" {
+		router.TLS.Options = po.overlay.TLS.Options
+		if router.TLS.Options != traefiktls.DefaultTLSConfigName {
+			router.TLS.Options = qualifyOverlayReference(po.providerName, router.TLS.Options)
+		}
+	}
+
+	if po.overlay.TLS.CertResolver != "" {
+		router.TLS.CertResolver = po.overlay.TLS.CertResolver
+	}
+
+	if len(po.overlay.TLS.Domains) > 0 {
+		router.TLS.Domains = slices.Clone(po.overlay.TLS.Domains)
+	}
+}
+
+func qualifyOverlayReference(providerName, name string) string {
+	if strings.Contains(name, "@") {
+		return name
+	}
+
+	return provider.MakeQualifiedName(providerName, name)
+}
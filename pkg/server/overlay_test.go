@@ -0,0 +1,160 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traefik/traefik/v3/pkg/config/dynamic"
+)
+
+func TestOverlayTransformer(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		precedence []string
+		defaultEPs []string
+		conf       dynamic.Configurations
+		expRouters map[string]map[string]*dynamic.Router
+	}{
+		{
+			desc: "no overlays",
+			conf: dynamic.Configurations{
+				"docker": {HTTP: &dynamic.HTTPConfiguration{Routers: map[string]*dynamic.Router{
+					"app": {Middlewares: []string{"auth"}},
+				}}},
+			},
+			expRouters: map[string]map[string]*dynamic.Router{
+				"docker": {"app": {Middlewares: []string{"auth"}}},
+			},
+		},
+		{
+			desc: "overlay applied on the routers of the selected provider",
+			conf: dynamic.Configurations{
+				"file": {HTTP: &dynamic.HTTPConfiguration{
+					Routers: map[string]*dynamic.Router{"local": {}},
+					Overlays: map[string]*dynamic.Overlay{
+						"security": {
+							Selector:    dynamic.OverlaySelector{Providers: []string{"docker"}},
+							Middlewares: []string{"headers", "ratelimit@kv"},
+							TLS:         &dynamic.RouterTLSConfig{Options: "modern"},
+						},
+					},
+				}},
+				"docker": {HTTP: &dynamic.HTTPConfiguration{Routers: map[string]*dynamic.Router{
+					"app":   {Middlewares: []string{"auth"}},
+					"child": {ParentRefs: []string{"app"}},
+				}}},
+			},
+			expRouters: map[string]map[string]*dynamic.Router{
+				"file": {"local": {}},
+				"docker": {
+					"app": {
+						Middlewares: []string{"headers@file", "ratelimit@kv", "auth"},
+						TLS:         &dynamic.RouterTLSConfig{Options: "modern@file"},
+					},
+					"child": {ParentRefs: []string{"app"}},
+				},
+			},
+		},
+		{
+			desc: "overlay selecting routers by name, entry point and labels",
+			conf: dynamic.Configurations{
+				"file": {HTTP: &dynamic.HTTPConfiguration{
+					Overlays: map[string]*dynamic.Overlay{
+						"security": {
+							Selector: dynamic.OverlaySelector{
+								Routers:     []string{"api-*"},
+								EntryPoints: []string{"websecure"},
+								Labels:      map[string]string{"team": "payments"},
+							},
+							Middlewares: []string{"headers"},
+							TLS:         &dynamic.RouterTLSConfig{Options: "default", CertResolver: "le"},
+						},
+					},
+				}},
+				"docker": {HTTP: &dynamic.HTTPConfiguration{Routers: map[string]*dynamic.Router{
+					"api-payments": {Labels: map[string]string{"team": "payments"}},
+					"api-other":    {Labels: map[string]string{"team": "other"}},
+					"api-web":      {EntryPoints: []string{"web"}, Labels: map[string]string{"team": "payments"}},
+					"front":        {Labels: map[string]string{"team": "payments"}},
+				}}},
+				"internal": {HTTP: &dynamic.HTTPConfiguration{Routers: map[string]*dynamic.Router{
+					"api-internal": {Labels: map[string]string{"team": "payments"}},
+				}}},
+			},
+			defaultEPs: []string{"websecure"},
+			expRouters: map[string]map[string]*dynamic.Router{
+				"docker": {
+					"api-payments": {
+						Labels:      map[string]string{"team": "payments"},
+						Middlewares: []string{"headers@file"},
+						TLS:         &dynamic.RouterTLSConfig{Options: "default", CertResolver: "le"},
+					},
+					"api-other": {Labels: map[string]string{"team": "other"}},
+					"api-web":   {EntryPoints: []string{"web"}, Labels: map[string]string{"team": "payments"}},
+					"front":     {Labels: map[string]string{"team": "payments"}},
+				},
+				"internal": {"api-internal": {Labels: map[string]string{"team": "payments"}}},
+			},
+		},
+		{
+			desc:       "overlays applied in the providers precedence order",
+			precedence: []string{"kubernetescrd", "file"},
+			conf: dynamic.Configurations{
+				"file": {HTTP: &dynamic.HTTPConfiguration{
+					Overlays: map[string]*dynamic.Overlay{
+						"b": {Middlewares: []string{"file-b"}, TLS: &dynamic.RouterTLSConfig{Options: "file"}},
+						"a": {Middlewares: []string{"file-a"}},
+					},
+				}},
+				"kubernetescrd": {HTTP: &dynamic.HTTPConfiguration{
+					Overlays: map[string]*dynamic.Overlay{
+						"crd": {Middlewares: []string{"crd"}, TLS: &dynamic.RouterTLSConfig{Options: "crd"}},
+					},
+				}},
+				"consul": {HTTP: &dynamic.HTTPConfiguration{
+					Routers: map[string]*dynamic.Router{"app": {Middlewares: []string{"file-a@file"}}},
+					Overlays: map[string]*dynamic.Overlay{
+						"consul": {Middlewares: []string{"consul"}},
+					},
+				}},
+			},
+			expRouters: map[string]map[string]*dynamic.Router{
+				"consul": {
+					"app": {
+						Middlewares: []string{"crd@kubernetescrd", "file-b@file", "consul@consul", "file-a@file"},
+						TLS:         &dynamic.RouterTLSConfig{Options: "crd@kubernetescrd"},
+					},
+				},
+			},
+		},
+		{
+			desc: "invalid selector",
+			conf: dynamic.Configurations{
+				"file": {HTTP: &dynamic.HTTPConfiguration{
+					Overlays: map[string]*dynamic.Overlay{
+						"invalid": {Selector: dynamic.OverlaySelector{Routers: []string{"["}}, Middlewares: []string{"headers"}},
+					},
+				}},
+				"docker": {HTTP: &dynamic.HTTPConfiguration{Routers: map[string]*dynamic.Router{
+					"app": {},
+				}}},
+			},
+			expRouters: map[string]map[string]*dynamic.Router{
+				"docker": {"app": {}},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			transformer := NewOverlayTransformer(test.precedence, test.defaultEPs)
+			conf := transformer(t.Context(), test.conf)
+
+			for providerName, routers := range test.expRouters {
+				assert.Equal(t, routers, conf[providerName].HTTP.Routers)
+			}
+		})
+	}
+}
@@ -35,6 +35,7 @@ type Manager struct {
 	tlsManager          *traefiktls.Manager
 	conf                *runtime.Configuration
 	providersPrecedence []string
+	muxerOptions        []tcpmuxer.Option
 }
 
 // NewManager Creates a new Manager.
@@ -45,6 +46,7 @@ func NewManager(conf *runtime.Configuration,
 	httpsHandlers map[string]http.Handler,
 	tlsManager *traefiktls.Manager,
 	providersPrecedence []string,
+	muxerOptions ...tcpmuxer.Option,
 ) *Manager {
 	return &Manager{
 		serviceManager:      serviceManager,
@@ -54,6 +56,7 @@ func NewManager(conf *runtime.Configuration,
 		tlsManager:          tlsManager,
 		conf:                conf,
 		providersPrecedence: providersPrecedence,
+		muxerOptions:        muxerOptions,
 	}
 }
 
@@ -97,7 +100,7 @@ func (m *Manager) getHTTPRouters(ctx context.Context, entryPoints []string, tls
 
 func (m *Manager) buildEntryPointHandler(ctx context.Context, configs map[string]*runtime.TCPRouterInfo, configsHTTP map[string]*runtime.RouterInfo, handlerHTTP, handlerHTTPS http.Handler) (*Router, error) {
 	// Build a new Router.
-	router, err := NewRouter(m.providersPrecedence)
+	router, err := NewRouter(m.providersPrecedence, m.muxerOptions...)
 	if err != nil {
 		return nil, err
 	}
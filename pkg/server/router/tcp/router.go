@@ -14,6 +14,7 @@ import (
 
 	"github.com/go-acme/lego/v5/challenge/tlsalpn01"
 	"github.com/rs/zerolog/log"
+	"github.com/traefik/traefik/v3/pkg/fingerprint"
 	tcpmuxer "github.com/traefik/traefik/v3/pkg/muxer/tcp"
 	"github.com/traefik/traefik/v3/pkg/tcp"
 	traefiktls "github.com/traefik/traefik/v3/pkg/tls"
@@ -57,18 +58,18 @@ type Router struct {
 }
 
 // NewRouter returns a new TCP router.
-func NewRouter(providersPrecedence []string) (*Router, error) {
-	muxTCP, err := tcpmuxer.NewMuxer(providersPrecedence)
+func NewRouter(providersPrecedence []string, muxerOptions ...tcpmuxer.Option) (*Router, error) {
+	muxTCP, err := tcpmuxer.NewMuxer(providersPrecedence, muxerOptions...)
 	if err != nil {
 		return nil, err
 	}
 
-	muxTCPTLS, err := tcpmuxer.NewMuxer(providersPrecedence)
+	muxTCPTLS, err := tcpmuxer.NewMuxer(providersPrecedence, muxerOptions...)
 	if err != nil {
 		return nil, err
 	}
 
-	muxHTTPS, err := tcpmuxer.NewMuxer(providersPrecedence)
+	muxHTTPS, err := tcpmuxer.NewMuxer(providersPrecedence, muxerOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -171,6 +172,8 @@ func (r *Router) ServeTCP(conn tcp.WriteCloser) {
 		log.Error().Err(err).Msg("Error while setting deadline")
 	}
 
+	pConn.fingerprints = hello.fingerprints
+
 	connData, err := tcpmuxer.NewConnData(hello.serverName, pConn.RemoteAddr(), hello.protos)
 	if err != nil {
 		log.Error().Err(err).Msg("Error while reading TCP connection data")
@@ -363,6 +366,8 @@ type peekConn struct {
 
 	peeked []byte
 	reader *bufio.Reader
+
+	fingerprints *fingerprint.Fingerprints
 }
 
 func newPeekConn(conn tcp.WriteCloser) *peekConn {
@@ -372,6 +377,11 @@ func newPeekConn(conn tcp.WriteCloser) *peekConn {
 	}
 }
 
+// Fingerprints returns the fingerprints of the client, computed from its TLS ClientHello.
+func (c *peekConn) Fingerprints() *fingerprint.Fingerprints {
+	return c.fingerprints
+}
+
 // Peek allows peeking into the connection without consuming bytes, by using the bufio.Reader's Peek method.
 func (c *peekConn) Peek(n int) ([]byte, error) {
 	return c.reader.Peek(n)
@@ -402,9 +412,10 @@ func (c *peekConn) Read(p []byte) (int, error) {
 }
 
 type clientHello struct {
-	serverName string   // SNI server name
-	protos     []string // ALPN protocols list
-	isTLS      bool     // whether we are a TLS handshake
+	serverName   string                    // SNI server name
+	protos       []string                  // ALPN protocols list
+	isTLS        bool                      // whether we are a TLS handshake
+	fingerprints *fingerprint.Fingerprints // JA3 and JA4 fingerprints, nil if they could not be computed
 }
 
 // clientHelloInfo returns various data from the clientHello handshake,
@@ -435,6 +446,8 @@ func clientHelloInfo(conn *peekConn) (*clientHello, error) {
 		sni    string
 		protos []string
 	)
+	// The records read during the handshake are kept in the peeked buffer, after the bytes already consumed.
+	start := len(conn.peeked)
 	server := tls.Server(readOnlyConn{conn: conn}, &tls.Config{
 		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
 			sni = hello.ServerName
@@ -448,10 +461,16 @@ func clientHelloInfo(conn *peekConn) (*clientHello, error) {
 		return nil, fmt.Errorf("reading client hello: %w", handshakeErr)
 	}
 
+	fingerprints, err := fingerprint.FromClientHello(conn.peeked[start:])
+	if err != nil {
+		log.Debug().Err(err).Msg("Error while computing the client fingerprints")
+	}
+
 	return &clientHello{
-		serverName: sni,
-		isTLS:      true,
-		protos:     protos,
+		serverName:   sni,
+		isTLS:        true,
+		protos:       protos,
+		fingerprints: fingerprints,
 	}, nil
 }
 
@@ -7,7 +7,9 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/traefik/traefik/v3/pkg/config/runtime"
 	"github.com/traefik/traefik/v3/pkg/config/static"
+	"github.com/traefik/traefik/v3/pkg/geoip"
 	httpmuxer "github.com/traefik/traefik/v3/pkg/muxer/http"
+	tcpmuxer "github.com/traefik/traefik/v3/pkg/muxer/tcp"
 	"github.com/traefik/traefik/v3/pkg/server/middleware"
 	tcpmiddleware "github.com/traefik/traefik/v3/pkg/server/middleware/tcp"
 	"github.com/traefik/traefik/v3/pkg/server/router"
@@ -41,11 +43,14 @@ type RouterFactory struct {
 
 	parser              httpmuxer.SyntaxParser
 	providersPrecedence []string
+
+	geoIP           *geoip.GeoIP
+	tcpMuxerOptions []tcpmuxer.Option
 }
 
 // NewRouterFactory creates a new RouterFactory.
 func NewRouterFactory(staticConfiguration static.Configuration, managerFactory *service.ManagerFactory, tlsManager *tls.Manager,
-	observabilityMgr *middleware.ObservabilityMgr, pluginBuilder middleware.PluginsBuilder, dialerManager *tcp.DialerManager,
+	observabilityMgr *middleware.ObservabilityMgr, pluginBuilder middleware.PluginsBuilder, dialerManager *tcp.DialerManager, geoIP *geoip.GeoIP,
 ) (*RouterFactory, error) {
 	handlesTLSChallenge := false
 	for _, resolver := range staticConfiguration.CertificatesResolvers {
@@ -73,7 +78,14 @@ func NewRouterFactory(staticConfiguration static.Configuration, managerFactory *
 		}
 	}
 
-	parser, err := httpmuxer.NewSyntaxParser()
+	var parserOptions []httpmuxer.Options
+	var tcpMuxerOptions []tcpmuxer.Option
+	if geoIP != nil {
+		parserOptions = append(parserOptions, httpmuxer.WithGeoIP(geoIP))
+		tcpMuxerOptions = append(tcpMuxerOptions, tcpmuxer.WithGeoIP(geoIP))
+	}
+
+	parser, err := httpmuxer.NewSyntaxParser(parserOptions...)
 	if err != nil {
 		return nil, fmt.Errorf("creating parser: %w", err)
 	}
@@ -94,6 +106,8 @@ func NewRouterFactory(staticConfiguration static.Configuration, managerFactory *
 		allowACMEByPass:     allowACMEByPass,
 		parser:              parser,
 		providersPrecedence: providersPrecedence,
+		geoIP:               geoIP,
+		tcpMuxerOptions:     tcpMuxerOptions,
 	}, nil
 }
 
@@ -110,6 +124,7 @@ func (f *RouterFactory) CreateRouters(rtConf *runtime.Configuration) (map[string
 	serviceManager := f.managerFactory.Build(rtConf)
 
 	middlewaresBuilder := middleware.NewBuilder(rtConf.Middlewares, serviceManager, f.pluginBuilder)
+	middlewaresBuilder.SetGeoIP(f.geoIP)
 
 	serviceManager.SetMiddlewareChainBuilder(middlewaresBuilder)
 
@@ -120,6 +135,16 @@ func (f *RouterFactory) CreateRouters(rtConf *runtime.Configuration) (map[string
 	handlersNonTLS := routerManager.BuildHandlers(ctx, f.entryPointsTCP, false)
 	handlersTLS := routerManager.BuildHandlers(ctx, f.entryPointsTCP, true)
 
+	if f.geoIP != nil {
+		// The client location is looked up once per request, for the router rules, the middlewares and the access logs.
+		for ep, handler := range handlersNonTLS {
+			handlersNonTLS[ep] = f.geoIP.Handler(handler)
+		}
+		for ep, handler := range handlersTLS {
+			handlersTLS[ep] = f.geoIP.Handler(handler)
+		}
+	}
+
 	serviceManager.LaunchHealthCheck(ctx)
 
 	// TCP
@@ -127,7 +152,7 @@ func (f *RouterFactory) CreateRouters(rtConf *runtime.Configuration) (map[string
 
 	middlewaresTCPBuilder := tcpmiddleware.NewBuilder(rtConf.TCPMiddlewares)
 
-	rtTCPManager := tcprouter.NewManager(rtConf, svcTCPManager, middlewaresTCPBuilder, handlersNonTLS, handlersTLS, f.tlsManager, f.providersPrecedence)
+	rtTCPManager := tcprouter.NewManager(rtConf, svcTCPManager, middlewaresTCPBuilder, handlersNonTLS, handlersTLS, f.tlsManager, f.providersPrecedence, f.tcpMuxerOptions...)
 	routersTCP := rtTCPManager.BuildHandlers(ctx, f.entryPointsTCP)
 
 	for ep, r := range routersTCP {
@@ -23,6 +23,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/traefik/traefik/v3/pkg/config/static"
+	"github.com/traefik/traefik/v3/pkg/fingerprint"
 	"github.com/traefik/traefik/v3/pkg/ip"
 	"github.com/traefik/traefik/v3/pkg/middlewares"
 	"github.com/traefik/traefik/v3/pkg/middlewares/contenttype"
@@ -35,6 +36,7 @@ import (
 	"github.com/traefik/traefik/v3/pkg/server/service"
 	"github.com/traefik/traefik/v3/pkg/tcp"
 	"github.com/traefik/traefik/v3/pkg/types"
+	"golang.org/x/net/http2"
 )
 
 type key string
@@ -688,6 +690,11 @@ func newHTTPServer(ctx context.Context, ln net.Listener, configuration *static.E
 		return nil, fmt.Errorf("invalid underscoreHeadersStrategy value %q", configuration.HTTP.UnderscoreHeadersStrategy)
 	}
 
+	clientFingerprint := configuration.HTTP.ClientFingerprint
+	if clientFingerprint != nil && clientFingerprint.ForwardHeaders {
+		handler = forwardFingerprints(handler)
+	}
+
 	var connContext multipleConnContext
 	connContext.AddConnContextFunc(func(ctx context.Context, c net.Conn) context.Context {
 		// This adds an empty struct in order to store a RoundTripper in the ConnContext in case of Kerberos or NTLM.
@@ -695,7 +702,12 @@ func newHTTPServer(ctx context.Context, ln net.Listener, configuration *static.E
 
 		if tlsConn, ok := c.(*tls.Conn); ok {
 			if tlsConnWithOptionsName, ok := tlsConn.NetConn().(tcp.TLSConn); ok {
-				return tcp.AddTLSOptionsNameInContext(ctx, tlsConnWithOptionsName.TLSOptionsName)
+				ctx = tcp.AddTLSOptionsNameInContext(ctx, tlsConnWithOptionsName.TLSOptionsName)
+
+				// The fingerprints are computed by the TCP router from the ClientHello.
+				if conn, ok := tlsConnWithOptionsName.WriteCloser.(fingerprint.Conn); ok && conn.Fingerprints() != nil {
+					ctx = fingerprint.NewContext(ctx, conn.Fingerprints())
+				}
 			}
 		}
 
@@ -743,6 +755,12 @@ func newHTTPServer(ctx context.Context, ln net.Listener, configuration *static.E
 		ConnState:   connState,
 	}
 
+	if clientFingerprint != nil && clientFingerprint.HTTP2 {
+		if err := fingerprintHTTP2(serverHTTP); err != nil {
+			return nil, err
+		}
+	}
+
 	listener := newHTTPForwarder(ln)
 	go func() {
 		err := serverHTTP.Serve(listener)
@@ -757,6 +775,114 @@ func newHTTPServer(ctx context.Context, ln net.Listener, configuration *static.E
 	}, nil
 }
 
+// fingerprintHTTP2 makes the server read the HTTP/2 connections through a connection computing their HTTP/2 fingerprint.
+// As the net/http HTTP/2 server only accepts *tls.Conn connections,
+// the HTTP/2 connections are handed over to the golang.org/x/net/http2 server instead.
+func fingerprintHTTP2(serverHTTP *http.Server) error {
+	h2Server := &http2.Server{
+		MaxConcurrentStreams:      uint32(serverHTTP.HTTP2.MaxConcurrentStreams),
+		MaxDecoderHeaderTableSize: uint32(serverHTTP.HTTP2.MaxDecoderHeaderTableSize),
+		MaxEncoderHeaderTableSize: uint32(serverHTTP.HTTP2.MaxEncoderHeaderTableSize),
+	}
+
+	// The HTTP/2 server is configured with a copy of the HTTP server,
+	// as configuring the HTTP server itself would make it serve HTTP/2 without going through its TLSNextProto functions.
+	// The configured server applies the HTTP/2 options of the entryPoint,
+	// and sends a GOAWAY frame to the HTTP/2 connections on graceful shutdown.
+	baseServer := &http.Server{
+		ReadTimeout:    serverHTTP.ReadTimeout,
+		WriteTimeout:   serverHTTP.WriteTimeout,
+		IdleTimeout:    serverHTTP.IdleTimeout,
+		MaxHeaderBytes: serverHTTP.MaxHeaderBytes,
+		ErrorLog:       serverHTTP.ErrorLog,
+		HTTP2:          serverHTTP.HTTP2,
+	}
+
+	if err := http2.ConfigureServer(baseServer, h2Server); err != nil {
+		return fmt.Errorf("configuring HTTP/2 server: %w", err)
+	}
+
+	serverHTTP.RegisterOnShutdown(func() {
+		// The base server has no listener nor connection of its own, so it only notifies the HTTP/2 connections.
+		_ = baseServer.Shutdown(context.Background())
+	})
+
+	serveConn := func(conn net.Conn, handler http.Handler, sawClientPreface bool) {
+		// The net/http package passes the connection context to the TLSNextProto functions
+		// through an unadvertised BaseContext method on the handler.
+		ctx := context.Background()
+		if baseContexter, ok := handler.(interface{ BaseContext() context.Context }); ok {
+			ctx = baseContexter.BaseContext()
+		}
+
+		fingerprints := fingerprint.FromContext(ctx)
+		if fingerprints == nil {
+			fingerprints = &fingerprint.Fingerprints{}
+			ctx = fingerprint.NewContext(ctx, fingerprints)
+		}
+
+		h2Server.ServeConn(fingerprint.NewHTTP2Conn(conn, fingerprints, sawClientPreface), &http2.ServeConnOpts{
+			Context:          ctx,
+			Handler:          handler,
+			SawClientPreface: sawClientPreface,
+		})
+	}
+
+	if serverHTTP.TLSNextProto == nil {
+		serverHTTP.TLSNextProto = make(map[string]func(*http.Server, *tls.Conn, http.Handler))
+	}
+
+	serverHTTP.TLSNextProto[http2.NextProtoTLS] = func(_ *http.Server, conn *tls.Conn, handler http.Handler) {
+		serveConn(conn, handler, false)
+	}
+
+	// The unencrypted HTTP/2 connections are passed in a *tls.Conn wrapping the connection,
+	// after their connection preface has been read.
+	serverHTTP.TLSNextProto["unencrypted_http2"] = func(_ *http.Server, conn *tls.Conn, handler http.Handler) {
+		unencryptedConn, ok := conn.NetConn().(interface{ UnencryptedNetConn() net.Conn })
+		if !ok {
+			log.Error().Msg("Unexpected TLS connection in unencrypted HTTP/2 handoff")
+			_ = conn.NetConn().Close()
+			return
+		}
+
+		serveConn(unencryptedConn.UnencryptedNetConn(), handler, true)
+	}
+
+	return nil
+}
+
+// Headers carrying the client fingerprints forwarded to the services.
+const (
+	ja3Header              = "X-Forwarded-Tls-Client-Ja3"
+	ja4Header              = "X-Forwarded-Tls-Client-Ja4"
+	http2FingerprintHeader = "X-Forwarded-Http2-Fingerprint"
+)
+
+// forwardFingerprints sets the client fingerprints in the request headers,
+// and removes the fingerprint headers sent by the client.
+func forwardFingerprints(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.Header.Del(ja3Header)
+		req.Header.Del(ja4Header)
+		req.Header.Del(http2FingerprintHeader)
+
+		if fingerprints := fingerprint.FromContext(req.Context()); fingerprints != nil {
+			setHeaderIfNotEmpty(req.Header, ja3Header, fingerprints.JA3)
+			setHeaderIfNotEmpty(req.Header, ja4Header, fingerprints.JA4)
+			setHeaderIfNotEmpty(req.Header, http2FingerprintHeader, fingerprints.HTTP2())
+		}
+
+		h.ServeHTTP(rw, req)
+	})
+}
+
+func setHeaderIfNotEmpty(header http.Header, name, value string) {
+	if value != "" {
+		header.Set(name, value)
+	}
+}
+
 func getConnKey(conn net.Conn) string {
 	return fmt.Sprintf("%s => %s", conn.RemoteAddr(), conn.LocalAddr())
 }
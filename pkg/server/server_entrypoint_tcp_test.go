@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 	ptypes "github.com/traefik/paerser/types"
 	"github.com/traefik/traefik/v3/pkg/config/static"
+	"github.com/traefik/traefik/v3/pkg/fingerprint"
 	"github.com/traefik/traefik/v3/pkg/middlewares/requestdecorator"
 	tcprouter "github.com/traefik/traefik/v3/pkg/server/router/tcp"
 	"github.com/traefik/traefik/v3/pkg/tcp"
@@ -423,6 +424,186 @@ func Test_denyFragment(t *testing.T) {
 	}
 }
 
+func TestClientFingerprint(t *testing.T) {
+	certContent, err := localhostCert.Read()
+	require.NoError(t, err)
+
+	keyContent, err := localhostKey.Read()
+	require.NoError(t, err)
+
+	tlsCert, err := tls.X509KeyPair(certContent, keyContent)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc      string
+		scheme    string
+		expectTLS bool
+	}{
+		{
+			desc:      "HTTP/2 over TLS",
+			scheme:    "https",
+			expectTLS: true,
+		},
+		{
+			desc:   "unencrypted HTTP/2",
+			scheme: "http",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			epConfig := &static.EntryPointsTransport{}
+			epConfig.SetDefaults()
+
+			entryPoint, err := NewTCPEntryPoint(t.Context(), "", &static.EntryPoint{
+				Address:          "127.0.0.1:0",
+				Transport:        epConfig,
+				ForwardedHeaders: &static.ForwardedHeaders{},
+				HTTP2:            &static.HTTP2Config{},
+				HTTP: static.HTTPConfig{
+					ClientFingerprint: &static.ClientFingerprint{
+						HTTP2:          true,
+						ForwardHeaders: true,
+					},
+				},
+			}, nil, nil)
+			require.NoError(t, err)
+
+			router, err := tcprouter.NewRouter(nil)
+			require.NoError(t, err)
+
+			handler := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, test.expectTLS, req.TLS != nil)
+
+				for _, name := range []string{ja3Header, ja4Header, http2FingerprintHeader} {
+					rw.Header().Set(name, req.Header.Get(name))
+				}
+				rw.WriteHeader(http.StatusOK)
+			})
+
+			router.SetHTTPHandler(handler)
+			router.SetHTTPSHandler(handler, &tls.Config{
+				Certificates: []tls.Certificate{tlsCert},
+				NextProtos:   []string{http2.NextProtoTLS},
+			})
+
+			go entryPoint.Start(t.Context())
+			entryPoint.SwitchRouter(router)
+
+			client := &http.Client{Transport: &http2.Transport{
+				AllowHTTP: true,
+				DialTLSContext: func(ctx context.Context, network, addr string, cfg *tls.Config) (net.Conn, error) {
+					if test.scheme == "http" {
+						return net.Dial(network, addr)
+					}
+
+					return tls.Dial(network, addr, &tls.Config{InsecureSkipVerify: true, NextProtos: []string{http2.NextProtoTLS}})
+				},
+			}}
+
+			req, err := http.NewRequest(http.MethodGet, test.scheme+"://"+entryPoint.listener.Addr().String(), http.NoBody)
+			require.NoError(t, err)
+			req.Header.Set(ja3Header, "spoofed")
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, 2, resp.ProtoMajor)
+			assert.Regexp(t, `^[^|]*\|\d+\|0\|[amps](,[amps]){3}$`, resp.Header.Get(http2FingerprintHeader))
+
+			if test.expectTLS {
+				assert.Regexp(t, `^[0-9a-f]{32}$`, resp.Header.Get(ja3Header))
+				assert.Regexp(t, `^t13i\d{4}h2_[0-9a-f]{12}_[0-9a-f]{12}$`, resp.Header.Get(ja4Header))
+			} else {
+				assert.Empty(t, resp.Header.Get(ja3Header))
+				assert.Empty(t, resp.Header.Get(ja4Header))
+			}
+		})
+	}
+}
+
+func TestClientFingerprint_HTTP2Server(t *testing.T) {
+	epConfig := &static.EntryPointsTransport{}
+	epConfig.SetDefaults()
+	epConfig.LifeCycle.GraceTimeOut = ptypes.Duration(5 * time.Second)
+
+	entryPoint, err := NewTCPEntryPoint(t.Context(), "", &static.EntryPoint{
+		Address:          "127.0.0.1:0",
+		Transport:        epConfig,
+		ForwardedHeaders: &static.ForwardedHeaders{},
+		HTTP2:            &static.HTTP2Config{MaxConcurrentStreams: 42},
+		HTTP: static.HTTPConfig{
+			ClientFingerprint: &static.ClientFingerprint{HTTP2: true},
+		},
+	}, nil, nil)
+	require.NoError(t, err)
+
+	router, err := tcprouter.NewRouter(nil)
+	require.NoError(t, err)
+
+	router.SetHTTPHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+
+	go entryPoint.Start(t.Context())
+	entryPoint.SwitchRouter(router)
+
+	conn, err := net.Dial("tcp", entryPoint.listener.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	require.NoError(t, conn.SetDeadline(time.Now().Add(10*time.Second)))
+
+	_, err = conn.Write([]byte(http2.ClientPreface))
+	require.NoError(t, err)
+
+	framer := http2.NewFramer(conn, conn)
+	require.NoError(t, framer.WriteSettings())
+
+	// The HTTP/2 options of the entryPoint are applied.
+	frame, err := framer.ReadFrame()
+	require.NoError(t, err)
+
+	settings, ok := frame.(*http2.SettingsFrame)
+	require.True(t, ok)
+
+	maxConcurrentStreams, ok := settings.Value(http2.SettingMaxConcurrentStreams)
+	require.True(t, ok)
+	assert.Equal(t, uint32(42), maxConcurrentStreams)
+
+	// The connections are sent a GOAWAY frame on graceful shutdown.
+	go entryPoint.Shutdown(t.Context())
+
+	for {
+		frame, err := framer.ReadFrame()
+		require.NoError(t, err)
+
+		if _, ok := frame.(*http2.GoAwayFrame); ok {
+			return
+		}
+	}
+}
+
+func Test_forwardFingerprints(t *testing.T) {
+	fingerprints := &fingerprint.Fingerprints{JA4: "t13d1516h2_8daaf6152771_e5627efa2ab1"}
+	fingerprints.SetHTTP2("1:65536;2:0;4:6291456;6:262144|15663105|0|m,a,s,p")
+
+	handler := forwardFingerprints(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Empty(t, req.Header.Values(ja3Header))
+		assert.Equal(t, []string{"t13d1516h2_8daaf6152771_e5627efa2ab1"}, req.Header.Values(ja4Header))
+		assert.Equal(t, []string{"1:65536;2:0;4:6291456;6:262144|15663105|0|m,a,s,p"}, req.Header.Values(http2FingerprintHeader))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com", http.NoBody)
+	req.Header.Set(ja3Header, "spoofed")
+	req.Header.Set(ja4Header, "spoofed")
+	req = req.WithContext(fingerprint.NewContext(req.Context(), fingerprints))
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+}
+
 func TestSanitizePath(t *testing.T) {
 	tests := []struct {
 		path     string
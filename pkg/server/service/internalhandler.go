@@ -15,17 +15,19 @@ type InternalHandlers struct {
 	rest       http.Handler
 	prometheus http.Handler
 	ping       http.Handler
+	cspReport  http.Handler
 	acmeHTTP   http.Handler
 }
 
 // NewInternalHandlers creates a new InternalHandlers.
-func NewInternalHandlers(apiHandler, rest, metricsHandler, pingHandler, dashboard, acmeHTTP http.Handler) *InternalHandlers {
+func NewInternalHandlers(apiHandler, rest, metricsHandler, pingHandler, cspReportHandler, dashboard, acmeHTTP http.Handler) *InternalHandlers {
 	return &InternalHandlers{
 		api:        apiHandler,
 		dashboard:  dashboard,
 		rest:       rest,
 		prometheus: metricsHandler,
 		ping:       pingHandler,
+		cspReport:  cspReportHandler,
 		acmeHTTP:   acmeHTTP,
 	}
 }
@@ -81,6 +83,12 @@ func (m *InternalHandlers) get(serviceName string) (http.Handler, error) {
 		}
 		return m.ping, nil
 
+	case "csp-report@internal":
+		if m.cspReport == nil {
+			return nil, errors.New("CSP report is not enabled")
+		}
+		return m.cspReport, nil
+
 	case "prometheus@internal":
 		if m.prometheus == nil {
 			return nil, errors.New("prometheus is not enabled")
@@ -77,6 +77,8 @@ func New(stickyConfig *dynamic.Sticky, wantsHealthCheck bool) *Balancer {
 	}
 	if stickyConfig != nil && stickyConfig.Cookie != nil {
 		balancer.sticky = loadbalancer.NewSticky(*stickyConfig.Cookie)
+	} else if stickyConfig != nil && stickyConfig.Header != nil {
+		balancer.sticky = loadbalancer.NewHeaderSticky(*stickyConfig.Header)
 	}
 
 	return balancer
@@ -32,6 +32,8 @@ type stickyCookie struct {
 	expires  time.Time
 	path     string
 	domain   string
+	// idleTimeout is the number of seconds of inactivity after which the cookie expires.
+	idleTimeout int
 }
 
 // Sticky ensures that client consistently interacts with the same HTTP handler by adding a sticky cookie to the response.
@@ -40,6 +42,8 @@ type stickyCookie struct {
 type Sticky struct {
 	// cookie is the sticky cookie configuration.
 	cookie *stickyCookie
+	// header is the sticky header name, used in place of the cookie when defined.
+	header string
 
 	// References all the handlers by name and also by the hashed value of the name.
 	handlersMu             sync.RWMutex
@@ -59,6 +63,11 @@ func NewSticky(cookieConfig dynamic.Cookie) *Sticky {
 		path:     "/",
 		domain:   cookieConfig.Domain,
 	}
+	if cookieConfig.IdleTimeout > 0 {
+		// The idle timeout is implemented by refreshing the cookie max age on every response.
+		cookie.idleTimeout = cookieConfig.IdleTimeout
+		cookie.maxAge = cookieConfig.IdleTimeout
+	}
 	if cookieConfig.Path != nil {
 		cookie.path = *cookieConfig.Path
 	}
@@ -74,6 +83,16 @@ func NewSticky(cookieConfig dynamic.Cookie) *Sticky {
 	}
 }
 
+// NewHeaderSticky creates a new Sticky instance relying on a header instead of a cookie.
+func NewHeaderSticky(headerConfig dynamic.StickyHeader) *Sticky {
+	return &Sticky{
+		header:                 headerConfig.Name,
+		hashMap:                make(map[string]string),
+		stickyMap:              make(map[string]*NamedHandler),
+		compatibilityStickyMap: make(map[string]*NamedHandler),
+	}
+}
+
 // AddHandler adds a http.Handler to the sticky pool.
 func (s *Sticky) AddHandler(name string, h http.Handler) {
 	s.handlersMu.Lock()
@@ -100,32 +119,47 @@ func (s *Sticky) AddHandler(name string, h http.Handler) {
 }
 
 // StickyHandler returns the NamedHandler corresponding to the sticky cookie if one.
-// It also returns a boolean which indicates if the sticky cookie has to be overwritten because it uses a deprecated hash algorithm.
+// It also returns a boolean which indicates if the sticky cookie has to be overwritten,
+// because it uses a deprecated hash algorithm or because its expiration has to be refreshed.
 func (s *Sticky) StickyHandler(req *http.Request) (*NamedHandler, bool, error) {
-	cookie, err := req.Cookie(s.cookie.name)
-	if err != nil && errors.Is(err, http.ErrNoCookie) {
-		return nil, false, nil
-	}
-	if err != nil {
-		return nil, false, fmt.Errorf("reading cookie: %w", err)
+	value, err := s.stickyValue(req)
+	if err != nil || value == "" {
+		return nil, false, err
 	}
 
 	s.handlersMu.RLock()
-	handler, ok := s.stickyMap[cookie.Value]
+	handler, ok := s.stickyMap[value]
 	s.handlersMu.RUnlock()
 
 	if ok && handler != nil {
-		return handler, false, nil
+		return handler, s.cookie != nil && s.cookie.idleTimeout > 0, nil
 	}
 
 	s.handlersMu.RLock()
-	handler, ok = s.compatibilityStickyMap[cookie.Value]
+	handler, ok = s.compatibilityStickyMap[value]
 	s.handlersMu.RUnlock()
 
 	return handler, ok, nil
 }
 
-// WriteStickyCookie writes a sticky cookie to the response to stick the client to the given handler name.
+// stickyValue returns the sticky value sent by the client, or an empty string if none.
+func (s *Sticky) stickyValue(req *http.Request) (string, error) {
+	if s.header != "" {
+		return req.Header.Get(s.header), nil
+	}
+
+	cookie, err := req.Cookie(s.cookie.name)
+	if err != nil && errors.Is(err, http.ErrNoCookie) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading cookie: %w", err)
+	}
+
+	return cookie.Value, nil
+}
+
+// WriteStickyCookie writes a sticky cookie, or the sticky header, to the response to stick the client to the given handler name.
 func (s *Sticky) WriteStickyCookie(rw http.ResponseWriter, name string) error {
 	s.handlersMu.RLock()
 	hash, ok := s.hashMap[name]
@@ -134,6 +168,11 @@ func (s *Sticky) WriteStickyCookie(rw http.ResponseWriter, name string) error {
 		return fmt.Errorf("no hash found for handler named %s", name)
 	}
 
+	if s.header != "" {
+		rw.Header().Set(s.header, hash)
+		return nil
+	}
+
 	cookie := &http.Cookie{
 		Name:     s.cookie.name,
 		Value:    hash,
@@ -139,6 +139,54 @@ func TestSticky_WriteStickyCookie(t *testing.T) {
 	assert.Equal(t, "foo.com", cookie.Domain)
 }
 
+func TestSticky_IdleTimeout(t *testing.T) {
+	sticky := NewSticky(dynamic.Cookie{Name: "test", MaxAge: 42, IdleTimeout: 60})
+	sticky.AddHandler("first", http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	req.AddCookie(&http.Cookie{Name: "test", Value: sha256Hash("first")})
+
+	// Should ask for the cookie to be refreshed on every sticky request.
+	handler, rewrite, err := sticky.StickyHandler(req)
+	require.NoError(t, err)
+	require.NotNil(t, handler)
+	assert.Equal(t, "first", handler.Name)
+	assert.True(t, rewrite)
+
+	res := httptest.NewRecorder()
+	require.NoError(t, sticky.WriteStickyCookie(res, "first"))
+
+	require.Len(t, res.Result().Cookies(), 1)
+	assert.Equal(t, 60, res.Result().Cookies()[0].MaxAge)
+}
+
+func TestSticky_Header(t *testing.T) {
+	sticky := NewHeaderSticky(dynamic.StickyHeader{Name: "X-Session"})
+	sticky.AddHandler("first", http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+
+	// Should not find any handler without the header.
+	handler, rewrite, err := sticky.StickyHandler(httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+	require.NoError(t, err)
+	assert.Nil(t, handler)
+	assert.False(t, rewrite)
+
+	// Should write the sticky header instead of a cookie.
+	res := httptest.NewRecorder()
+	require.NoError(t, sticky.WriteStickyCookie(res, "first"))
+
+	assert.Empty(t, res.Result().Cookies())
+	assert.Equal(t, sha256Hash("first"), res.Header().Get("X-Session"))
+
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	req.Header.Set("X-Session", res.Header().Get("X-Session"))
+
+	handler, rewrite, err = sticky.StickyHandler(req)
+	require.NoError(t, err)
+	require.NotNil(t, handler)
+	assert.Equal(t, "first", handler.Name)
+	assert.False(t, rewrite)
+}
+
 func TestConvertSameSite_CaseInsensitive(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -60,6 +60,8 @@ func New(sticky *dynamic.Sticky, wantsHealthCheck bool) *Balancer {
 	}
 	if sticky != nil && sticky.Cookie != nil {
 		balancer.sticky = loadbalancer.NewSticky(*sticky.Cookie)
+	} else if sticky != nil && sticky.Header != nil {
+		balancer.sticky = loadbalancer.NewHeaderSticky(*sticky.Header)
 	}
 
 	return balancer
@@ -300,6 +300,9 @@ func (m *Manager) getWRRServiceHandler(ctx context.Context, serviceName string,
 	if config.Sticky != nil && config.Sticky.Cookie != nil {
 		config.Sticky.Cookie.Name = cookie.GetName(config.Sticky.Cookie.Name, serviceName)
 	}
+	if config.Sticky != nil && config.Sticky.Header != nil {
+		config.Sticky.Header.Name = cookie.GetName(config.Sticky.Header.Name, serviceName)
+	}
 
 	balancer := wrr.New(config.Sticky, config.HealthCheck != nil)
 	for _, service := range shuffle(config.Services, m.rand) {
@@ -426,6 +429,9 @@ func (m *Manager) getLoadBalancerServiceHandler(ctx context.Context, serviceName
 	if service.Sticky != nil && service.Sticky.Cookie != nil {
 		service.Sticky.Cookie.Name = cookie.GetName(service.Sticky.Cookie.Name, serviceName)
 	}
+	if service.Sticky != nil && service.Sticky.Header != nil {
+		service.Sticky.Header.Name = cookie.GetName(service.Sticky.Header.Name, serviceName)
+	}
 
 	// We make sure that the PassHostHeader value is defined to avoid panics.
 	passHostHeader := dynamic.DefaultPassHostHeader
@@ -496,8 +502,12 @@ func (m *Manager) getLoadBalancerServiceHandler(ctx context.Context, serviceName
 		metricsHandler := metricsMiddle.ServiceMetricsHandler(ctx, m.observabilityMgr.MetricsRegistry(), qualifiedSvcName)
 		metricsHandler = observability.WrapMiddleware(ctx, metricsHandler)
 
+		serverMetricsHandler := metricsMiddle.ServerMetricsHandler(ctx, m.observabilityMgr.MetricsRegistry(), qualifiedSvcName, target.String())
+		serverMetricsHandler = observability.WrapMiddleware(ctx, serverMetricsHandler)
+
 		proxy, err = alice.New().
 			Append(metricsHandler).
+			Append(serverMetricsHandler).
 			Then(proxy)
 		if err != nil {
 			return nil, fmt.Errorf("error wrapping metrics handler: %w", err)